@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"click-service/internal/seed"
+	"click-service/internal/store"
+)
+
+func main() {
+	fixturePath := flag.String("fixture", "default", `fixture file, or "default" for the bundled fixture`)
+	codesPath := flag.String("codes", "", "manifest written by qr-service's seed -manifest")
+	codeIDs := flag.String("code", "", "comma-separated QR code IDs to generate clicks for")
+	seedValue := flag.Int64("seed", 1, "random seed; the same seed yields the same history")
+	databaseURL := flag.String("database-url", os.Getenv("DATABASE_URL"), "postgres or sqlite:// URL; empty does a dry run against memory")
+	flag.Parse()
+
+	fixture, err := seed.LoadFile(*fixturePath)
+	if err != nil {
+		log.Fatalf("load fixture: %v", err)
+	}
+
+	var codes []string
+	if *codesPath != "" {
+		fh, err := os.Open(*codesPath)
+		if err != nil {
+			log.Fatalf("open manifest: %v", err)
+		}
+		codes, err = seed.LoadManifest(fh)
+		_ = fh.Close()
+		if err != nil {
+			log.Fatalf("read manifest: %v", err)
+		}
+	}
+	for _, id := range strings.Split(*codeIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			codes = append(codes, id)
+		}
+	}
+
+	var st store.Store
+	if dbURL := strings.TrimSpace(*databaseURL); dbURL != "" {
+		db, err := store.Open(context.Background(), dbURL)
+		if err != nil {
+			log.Fatalf("%s init failed: %v", store.BackendName(dbURL), err)
+		}
+		defer db.Close()
+		st = db
+	} else {
+		log.Printf("DATABASE_URL not set; seeding in-memory store (dry run)")
+		st = store.NewMemoryStore()
+	}
+
+	result, err := seed.Apply(context.Background(), st, fixture, seed.Options{Seed: *seedValue, Codes: codes})
+	if err != nil {
+		log.Fatalf("seed failed: %v", err)
+	}
+	fmt.Printf("Recorded %d clicks across %d QR codes over %d days (%d codes already had clicks)\n", result.Events, result.Codes, fixture.Days, result.Skipped)
+}
@@ -6,13 +6,20 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/apitoken"
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
 	"click-service/internal/httpapi"
 	"click-service/internal/middleware"
 	"click-service/internal/qrclient"
+	"click-service/internal/qrgrpc"
+	"click-service/internal/redirectcache"
+	"click-service/internal/seed"
 	"click-service/internal/store"
 )
 
@@ -27,25 +34,102 @@ func main() {
 	var st store.Store
 	var closeStore func()
 	if databaseURL != "" {
-		pg, err := store.NewPostgresStore(ctx, databaseURL)
+		db, err := store.Open(ctx, databaseURL)
 		if err != nil {
-			log.Fatalf("postgres init failed: %v", err)
+			log.Fatalf("%s init failed: %v", store.BackendName(databaseURL), err)
 		}
-		st = pg
-		closeStore = func() { _ = pg.Close() }
-		log.Printf("click-service using postgres storage")
+		st = db
+		closeStore = func() { _ = db.Close() }
+		log.Printf("click-service using %s storage", store.BackendName(databaseURL))
 	} else {
 		st = store.NewMemoryStore()
 		closeStore = func() {}
 		log.Printf("click-service using in-memory storage (set DATABASE_URL to persist)")
 	}
-	qr := qrclient.New(qrBaseURL)
+	// QR_SERVICE_GRPC_ADDR switches redirect lookups to qr-service's internal
+	// gRPC API (one round trip per scan instead of up to two HTTP calls).
+	// Over HTTP qr-service hides every owned code from callers without its
+	// admin key, so lookups there can't work without QR_SERVICE_ADMIN_KEY.
+	httpQr := qrclient.New(qrBaseURL)
+	httpQr.AdminKey = envOr("QR_SERVICE_ADMIN_KEY", "")
+	grpcAddr := envOr("QR_SERVICE_GRPC_ADDR", "")
+	if grpcAddr == "" && httpQr.AdminKey == "" {
+		log.Fatalf("QR_SERVICE_ADMIN_KEY is required to resolve redirects over HTTP (or set QR_SERVICE_GRPC_ADDR)")
+	}
+	var qr httpapi.QrResolver = httpQr
+	if grpcAddr != "" {
+		gc, err := qrgrpc.New(grpcAddr)
+		if err != nil {
+			log.Fatalf("qr-service grpc client init failed: %v", err)
+		}
+		defer gc.Close()
+		qr = gc
+		log.Printf("click-service resolving redirects via gRPC at %s", grpcAddr)
+	}
 
-	router := httpapi.NewRouter(httpapi.Server{Store: st, QrClient: qr})
+	// Resolved codes are cached in memory (REDIRECT_CACHE_SIZE=0 turns it
+	// off); entries are dropped as qr-service's change feed reports codes
+	// changing, and the TTLs bound staleness while the feed is unreachable.
+	var cache *redirectcache.Cache
+	if size, _ := strconv.Atoi(envOr("REDIRECT_CACHE_SIZE", "10000")); size > 0 {
+		cache = redirectcache.New(qr, redirectcache.Options{
+			Size:        size,
+			TTL:         durationEnv("REDIRECT_CACHE_TTL", time.Minute),
+			NegativeTTL: durationEnv("REDIRECT_CACHE_NEGATIVE_TTL", 10*time.Second),
+		})
+		qr = cache
+		log.Printf("click-service caching up to %d redirects", size)
+	}
+
+	// SEED_FIXTURE ("default" or a file path) generates click history at
+	// startup for the codes listed in the SEED_CODES manifest.
+	if fixturePath := envOr("SEED_FIXTURE", ""); fixturePath != "" {
+		if err := seedStore(st, fixturePath, envOr("SEED_CODES", ""), envOr("SEED", "1")); err != nil {
+			log.Fatalf("seed failed: %v", err)
+		}
+	}
+
+	// Raw click events are kept for CLICK_EVENT_RETENTION_DAYS (0 keeps
+	// them forever); daily stats are kept regardless.
+	jobs, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+	// Every instance follows qr-service's change feed, which needs its admin
+	// key; qr-service's own invalidation calls reach only one instance.
+	if cache != nil && httpQr.AdminKey != "" {
+		go cache.Follow(jobs, httpQr)
+		log.Printf("click-service following qr-service's change feed")
+	} else if cache != nil {
+		log.Printf("QR_SERVICE_ADMIN_KEY unset: cached redirects only change on invalidation calls or expiry")
+	}
+	if days, err := strconv.Atoi(envOr("CLICK_EVENT_RETENTION_DAYS", "90")); err != nil || days < 0 {
+		log.Fatalf("CLICK_EVENT_RETENTION_DAYS must be a whole number of days, got %q", os.Getenv("CLICK_EVENT_RETENTION_DAYS"))
+	} else if days > 0 {
+		go purgeClickEvents(jobs, st, time.Duration(days)*24*time.Hour)
+		log.Printf("click-service keeping raw click events for %d days", days)
+	}
+
+	apiServer := httpapi.Server{Store: st, QrClient: qr, AdminAPIKey: envOr("ADMIN_API_KEY", "")}
+	if cache != nil {
+		apiServer.RedirectCache = cache
+	}
+	// Stats on workspace codes need the caller's role from user-service,
+	// which also verifies API tokens and login sessions.
+	var tokens, sessions apitoken.Verifier
+	if userServiceURL := envOr("USER_SERVICE_BASE_URL", ""); userServiceURL != "" {
+		userServiceKey := envOr("USER_SERVICE_ADMIN_KEY", "")
+		apiServer.Workspaces = workspace.NewClient(userServiceURL, userServiceKey)
+		tokens = apitoken.NewClient(userServiceURL, userServiceKey)
+		sessions = apitoken.NewSessionClient(userServiceURL)
+	}
+	router := httpapi.NewRouter(apiServer)
 
 	// Apply middleware layers (order matters!)
 	var handler http.Handler = router
 
+	// 0. API tokens (Authorization: Bearer) and login sessions become the
+	// caller's identity; X-User-Id and X-User-Type from clients are dropped.
+	handler = apitoken.Middleware(tokens, sessions, httpapi.RequiredTokenScope)(handler)
+
 	// 1. CORS (outermost)
 	handler = httpapi.NewCorsMiddleware(httpapi.CorsOptions{
 		AllowedOrigins:   allowedOrigins,
@@ -75,6 +159,7 @@ func main() {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
+	stopJobs()
 	closeStore()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -82,6 +167,53 @@ func main() {
 	_ = srv.Shutdown(ctx)
 }
 
+func seedStore(st store.Store, fixturePath, codesPath, seedRaw string) error {
+	fixture, err := seed.LoadFile(fixturePath)
+	if err != nil {
+		return err
+	}
+	var codes []string
+	if codesPath != "" {
+		fh, err := os.Open(codesPath)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		if codes, err = seed.LoadManifest(fh); err != nil {
+			return err
+		}
+	}
+	seedValue, _ := strconv.ParseInt(seedRaw, 10, 64)
+	result, err := seed.Apply(context.Background(), st, fixture, seed.Options{Seed: seedValue, Codes: codes})
+	if err != nil {
+		return err
+	}
+	log.Printf("seeded %d clicks across %d QR codes (%d already had clicks)", result.Events, result.Codes, result.Skipped)
+	return nil
+}
+
+// purgeInterval is how often raw click events past retention are deleted.
+const purgeInterval = time.Hour
+
+// purgeClickEvents deletes raw click events older than retention at startup
+// and then every purgeInterval, until ctx ends. A failed run is retried on
+// the next tick.
+func purgeClickEvents(ctx context.Context, st store.Store, retention time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		cutoff := time.Now().UTC().Add(-retention)
+		if err := st.PurgeClicks(ctx, cutoff); err != nil && ctx.Err() == nil {
+			log.Printf("click event purge failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func envOr(key, fallback string) string {
 	v := strings.TrimSpace(os.Getenv(key))
 	if v == "" {
@@ -90,6 +222,16 @@ func envOr(key, fallback string) string {
 	return v
 }
 
+// durationEnv parses key as a time.Duration ("90s", "5m"), falling back when
+// it's unset or invalid.
+func durationEnv(key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(envOr(key, ""))
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
+
 func splitCSV(raw string) []string {
 	parts := strings.Split(raw, ",")
 	out := make([]string, 0, len(parts))
@@ -0,0 +1,108 @@
+package httpapi
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+type platform int
+
+const (
+	platformDesktop platform = iota
+	platformIOS
+	platformAndroid
+)
+
+// detectPlatform classifies the scanning device from its User-Agent. iPadOS
+// reports a desktop Safari UA by default, so those scans take the web route.
+func detectPlatform(userAgent string) platform {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(ua, "android"):
+		return platformAndroid
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ipod"):
+		return platformIOS
+	default:
+		return platformDesktop
+	}
+}
+
+// appRoute is where an app-link scan should go.
+type appRoute struct {
+	Route string
+	URL   string
+	// Fallback is set when URL uses a custom scheme: the browser can't tell us
+	// whether the app is installed, so an interstitial tries URL and then
+	// navigates here.
+	Fallback string
+}
+
+func routeAppLink(link qrclient.AppLink, webURL string, p platform) appRoute {
+	var appURL, storeURL, appRouteName, storeRouteName string
+	switch p {
+	case platformIOS:
+		appURL, storeURL = link.IOSURL, link.AppStoreURL
+		appRouteName, storeRouteName = store.RouteIOSApp, store.RouteIOSStore
+	case platformAndroid:
+		appURL, storeURL = link.AndroidURL, link.PlayStoreURL
+		appRouteName, storeRouteName = store.RouteAndroidApp, store.RouteAndroidStore
+	default:
+		return appRoute{Route: store.RouteWeb, URL: webURL}
+	}
+
+	appURL = strings.TrimSpace(appURL)
+	storeURL = strings.TrimSpace(storeURL)
+	switch {
+	case appURL != "" && strings.HasPrefix(strings.ToLower(appURL), "https://"):
+		// Universal/app links open the app when installed and the site otherwise.
+		return appRoute{Route: appRouteName, URL: appURL}
+	case appURL != "":
+		fallback := storeURL
+		if fallback == "" {
+			fallback = webURL
+		}
+		return appRoute{Route: appRouteName, URL: appURL, Fallback: fallback}
+	case storeURL != "":
+		return appRoute{Route: storeRouteName, URL: storeURL}
+	default:
+		return appRoute{Route: store.RouteWeb, URL: webURL}
+	}
+}
+
+var appLinkPage = template.Must(template.New("applink").Parse(`<!doctype html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Opening app…</title>
+</head>
+<body>
+<p>Opening the app… If nothing happens, <a href="{{.Fallback}}">continue here</a>.</p>
+<script>
+(function () {
+  var fallback = {{.Fallback}};
+  var timer = setTimeout(function () { window.location.replace(fallback); }, 1500);
+  document.addEventListener("visibilitychange", function () {
+    if (document.hidden) { clearTimeout(timer); }
+  });
+  window.location.href = {{.AppURL}};
+})();
+</script>
+</body>
+</html>
+`))
+
+func writeAppLinkPage(w http.ResponseWriter, route appRoute) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_ = appLinkPage.Execute(w, struct {
+		AppURL   string
+		Fallback string
+	}{AppURL: route.URL, Fallback: route.Fallback})
+}
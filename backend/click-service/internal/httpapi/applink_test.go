@@ -0,0 +1,90 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+const (
+	uaIPhone  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+	uaAndroid = "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Mobile Safari/537.36"
+	uaDesktop = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36"
+)
+
+func TestRedirect_AppLinkRoutes(t *testing.T) {
+	link := &qrclient.AppLink{
+		IOSURL:       "https://links.example.com/promo",
+		AndroidURL:   "exampleapp://promo",
+		AppStoreURL:  "https://apps.apple.com/app/id123",
+		PlayStoreURL: "https://play.google.com/store/apps/details?id=com.example",
+	}
+
+	cases := []struct {
+		name     string
+		ua       string
+		status   int
+		location string
+		route    string
+	}{
+		{name: "ios universal link", ua: uaIPhone, status: http.StatusFound, location: "https://links.example.com/promo?utm_source=qr", route: store.RouteIOSApp},
+		{name: "android custom scheme", ua: uaAndroid, status: http.StatusOK, route: store.RouteAndroidApp},
+		{name: "desktop web", ua: uaDesktop, status: http.StatusFound, location: "https://example.com/web?utm_source=qr", route: store.RouteWeb},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spy := &storeSpy{ch: make(chan store.ClickEvent, 1)}
+			qrSpy := &qrClientSpy{resp: qrclient.QrCode{
+				ID: "abc123", URL: "https://example.com/web", Active: true,
+				DestinationType: qrclient.DestinationAppLink, AppLink: link,
+				Utm: &qrclient.Utm{Source: "qr"},
+			}}
+			router := NewRouter(Server{Store: spy, QrClient: qrSpy})
+
+			req := httptest.NewRequest(http.MethodGet, "/r/abc123", nil)
+			req.Header.Set("User-Agent", tc.ua)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tc.status {
+				t.Fatalf("expected %d, got %d", tc.status, w.Code)
+			}
+			if tc.location != "" && w.Header().Get("Location") != tc.location {
+				t.Fatalf("expected Location %q, got %q", tc.location, w.Header().Get("Location"))
+			}
+			if tc.status == http.StatusOK {
+				body := w.Body.String()
+				if !strings.Contains(body, `"exampleapp://promo?utm_source=qr"`) || !strings.Contains(body, "referrer=utm_source") {
+					t.Fatalf("expected interstitial with app and store urls, got %s", body)
+				}
+			}
+
+			select {
+			case ev := <-spy.ch:
+				if ev.Route != tc.route {
+					t.Fatalf("expected route %q, got %q", tc.route, ev.Route)
+				}
+			case <-time.After(150 * time.Millisecond):
+				t.Fatalf("expected click to be recorded")
+			}
+		})
+	}
+}
+
+func TestRouteAppLink_FallsBackToStoreThenWeb(t *testing.T) {
+	got := routeAppLink(qrclient.AppLink{AppStoreURL: "https://apps.apple.com/app/id1"}, "https://example.com", platformIOS)
+	if got.Route != store.RouteIOSStore || got.URL != "https://apps.apple.com/app/id1" {
+		t.Fatalf("unexpected route %+v", got)
+	}
+
+	got = routeAppLink(qrclient.AppLink{IOSURL: "https://links.example.com"}, "https://example.com", platformAndroid)
+	if got.Route != store.RouteWeb || got.URL != "https://example.com" {
+		t.Fatalf("unexpected route %+v", got)
+	}
+}
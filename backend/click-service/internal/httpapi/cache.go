@@ -0,0 +1,66 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// RedirectCache is the part of redirectcache.Cache the invalidation endpoint
+// drives.
+type RedirectCache interface {
+	Invalidate(ids ...string)
+	Purge()
+}
+
+// maxInvalidateIDs bounds one invalidation request; qr-service sends at most
+// a bulk operation's worth.
+const maxInvalidateIDs = 1000
+
+type invalidateRequest struct {
+	IDs []string `json:"ids,omitempty"`
+	// All drops every cached code, e.g. after a settings change.
+	All bool `json:"all,omitempty"`
+}
+
+// cacheInvalidateHandler serves POST /api/admin/redirect-cache/invalidate,
+// which qr-service calls after codes or settings change so the next scan
+// sees the change. Without a cache configured it succeeds and does nothing.
+func (srv Server) cacheInvalidateHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.AdminAPIKey == "" || r.Header.Get("X-Admin-Key") != srv.AdminAPIKey {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return
+	}
+	var req invalidateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	ids := make([]string, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if !req.All && len(ids) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "ids_or_all_required"})
+		return
+	}
+	if len(ids) > maxInvalidateIDs {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "too_many_ids"})
+		return
+	}
+
+	if srv.RedirectCache != nil {
+		if req.All {
+			srv.RedirectCache.Purge()
+		} else {
+			srv.RedirectCache.Invalidate(ids...)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
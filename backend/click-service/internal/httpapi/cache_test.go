@@ -0,0 +1,72 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/redirectcache"
+	"click-service/internal/store"
+)
+
+func invalidateCall(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/redirect-cache/invalidate", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Key", "k")
+	return req
+}
+
+func TestCacheInvalidate_NextScanSeesChange(t *testing.T) {
+	qr := &qrClientSpy{resp: qrclient.QrCode{ID: "abc", URL: "https://example.com/old", Active: true}}
+	cache := redirectcache.New(qr, redirectcache.Options{})
+	router := NewRouter(Server{Store: store.NewMemoryStore(), QrClient: cache, RedirectCache: cache, AdminAPIKey: "k"})
+
+	scan := func() string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/r/abc", nil))
+		return w.Header().Get("Location")
+	}
+	if loc := scan(); loc != "https://example.com/old" {
+		t.Fatalf("unexpected Location %q", loc)
+	}
+	qr.resp.URL = "https://example.com/new"
+	if loc := scan(); loc != "https://example.com/old" {
+		t.Fatalf("expected the cached destination, got %q", loc)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, invalidateCall(`{"ids":["abc"]}`))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("invalidate: expected %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+	if loc := scan(); loc != "https://example.com/new" {
+		t.Fatalf("expected the new destination after invalidation, got %q", loc)
+	}
+}
+
+func TestCacheInvalidate_Validation(t *testing.T) {
+	router := NewRouter(Server{Store: store.NewMemoryStore(), AdminAPIKey: "k"})
+
+	req := invalidateCall(`{"all":true}`)
+	req.Header.Del("X-Admin-Key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("no key: expected %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+
+	for body, want := range map[string]int{
+		`{"all":true}`:  http.StatusNoContent, // no cache configured
+		`{"ids":[" "]}`: http.StatusBadRequest,
+		`not json`:      http.StatusBadRequest,
+		`{"ids":["` + strings.Repeat(`a","`, maxInvalidateIDs) + `a"]}`: http.StatusRequestEntityTooLarge,
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, invalidateCall(body))
+		if w.Code != want {
+			t.Fatalf("%.40s: expected %d, got %d", body, want, w.Code)
+		}
+	}
+}
@@ -20,7 +20,7 @@ func NewCorsMiddleware(opts CorsOptions) func(http.Handler) http.Handler {
 	}
 	allowedHeaders := opts.AllowedHeaders
 	if len(allowedHeaders) == 0 {
-		allowedHeaders = []string{"Content-Type", "Authorization"}
+		allowedHeaders = []string{"Content-Type", "Authorization", "X-Workspace-Id"}
 	}
 
 	return func(next http.Handler) http.Handler {
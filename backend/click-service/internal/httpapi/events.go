@@ -0,0 +1,94 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"click-service/internal/store"
+)
+
+const (
+	defaultEventsLimit = 50
+	maxEventsLimit     = 500
+)
+
+type clickEventsResponse struct {
+	Events []store.ClickEvent `json:"events"`
+	// Next is the cursor to pass as before for the following page; empty
+	// when this page reached the oldest event kept.
+	Next string `json:"next,omitempty"`
+}
+
+// formatCursor and parseCursor encode a store.ClickCursor as
+// "<unix microseconds>_<id>". Callers treat it as opaque.
+func formatCursor(e store.ClickEvent) string {
+	return fmt.Sprintf("%d_%d", e.At.UnixMicro(), e.ID)
+}
+
+func parseCursor(raw string) (store.ClickCursor, bool) {
+	at, id, ok := strings.Cut(raw, "_")
+	if !ok {
+		return store.ClickCursor{}, false
+	}
+	micros, err := strconv.ParseInt(at, 10, 64)
+	if err != nil || micros <= 0 {
+		return store.ClickCursor{}, false
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n < 0 {
+		return store.ClickCursor{}, false
+	}
+	return store.ClickCursor{At: time.UnixMicro(micros).UTC(), ID: n}, true
+}
+
+// clickEventsHandler serves GET /api/clicks/events?qrId=, a code's raw scans
+// newest first, as far back as the retention period keeps them, to the
+// callers allowEvents lets through.
+func (srv Server) clickEventsHandler(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	qrID := strings.TrimSpace(qs.Get("qrId"))
+	if qrID == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "qrId_required"})
+		return
+	}
+	limit := defaultEventsLimit
+	if v := qs.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxEventsLimit {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "limit_invalid"})
+			return
+		}
+		limit = n
+	}
+	var cursor store.ClickCursor
+	if v := qs.Get("before"); v != "" {
+		c, ok := parseCursor(v)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "before_invalid"})
+			return
+		}
+		cursor = c
+	}
+	if !srv.allowEvents(w, r, qrID) {
+		return
+	}
+
+	// One extra tells whether another page follows.
+	events, err := srv.Store.ListClicks(r.Context(), qrID, cursor, limit+1)
+	if err != nil {
+		writeStoreError(w, err, "events_failed")
+		return
+	}
+	resp := clickEventsResponse{Events: events}
+	if len(events) > limit {
+		resp.Events = events[:limit]
+		resp.Next = formatCursor(resp.Events[limit-1])
+	}
+	if resp.Events == nil {
+		resp.Events = []store.ClickEvent{}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
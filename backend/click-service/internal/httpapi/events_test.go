@@ -0,0 +1,135 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+func TestClickEvents_Paging(t *testing.T) {
+	st := store.NewMemoryStore()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		ev := store.ClickEvent{QrCodeID: "abc", At: start.Add(time.Duration(i) * time.Minute), IP: "203.0.113.7", UserAgent: "Mozilla/5.0"}
+		if err := st.RecordClick(context.Background(), ev); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+	r := NewRouter(Server{Store: st, QrClient: &qrClientSpy{resp: qrclient.QrCode{ID: "abc", OwnerID: "alice"}}})
+
+	var seen []string
+	next := ""
+	for page := 0; ; page++ {
+		path := "/api/clicks/events?qrId=abc&limit=2"
+		if next != "" {
+			path += "&before=" + url.QueryEscape(next)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, statsRequest(path, "alice"))
+		var resp clickEventsResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || w.Code != http.StatusOK {
+			t.Fatalf("page %d: unexpected response %d %s", page, w.Code, w.Body.String())
+		}
+		for _, e := range resp.Events {
+			seen = append(seen, e.AtIso)
+		}
+		if next = resp.Next; next == "" {
+			break
+		}
+	}
+	want := []string{"2026-03-02T09:04:00Z", "2026-03-02T09:03:00Z", "2026-03-02T09:02:00Z", "2026-03-02T09:01:00Z", "2026-03-02T09:00:00Z"}
+	if len(seen) != len(want) {
+		t.Fatalf("expected %v, got %v", want, seen)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("expected %v newest first, got %v", want, seen)
+		}
+	}
+
+	// A code with no scans has an empty list, not 404.
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, statsRequest("/api/clicks/events?qrId=none", "alice"))
+	if w.Code != http.StatusOK || w.Body.String() != "{\"events\":[]}\n" {
+		t.Fatalf("expected an empty page, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestClickEvents_Validation(t *testing.T) {
+	r := NewRouter(Server{Store: store.NewMemoryStore(), QrClient: &qrClientSpy{resp: qrclient.QrCode{ID: "abc", OwnerID: "alice"}}})
+	for path, code := range map[string]string{
+		"/api/clicks/events":                     "qrId_required",
+		"/api/clicks/events?qrId=abc&limit=0":    "limit_invalid",
+		"/api/clicks/events?qrId=abc&limit=501":  "limit_invalid",
+		"/api/clicks/events?qrId=abc&before=x":   "before_invalid",
+		"/api/clicks/events?qrId=abc&before=1_x": "before_invalid",
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, statsRequest(path, "alice"))
+		var body map[string]string
+		_ = json.Unmarshal(w.Body.Bytes(), &body)
+		if w.Code != http.StatusBadRequest || body["error"] != code {
+			t.Fatalf("%s: expected 400 %s, got %d %s", path, code, w.Code, w.Body.String())
+		}
+	}
+
+	w := httptest.NewRecorder()
+	NewRouter(Server{Store: &storeSpy{err: context.DeadlineExceeded}, QrClient: &qrClientSpy{resp: qrclient.QrCode{ID: "abc", OwnerID: "alice"}}}).ServeHTTP(w, statsRequest("/api/clicks/events?qrId=abc", "alice"))
+	if w.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected 504, got %d", w.Code)
+	}
+}
+
+func TestClickEvents_Access(t *testing.T) {
+	personal := qrclient.QrCode{ID: "abc", OwnerID: "alice", URL: "https://example.com", Active: true}
+	team := qrclient.QrCode{ID: "abc", OwnerID: "olga", WorkspaceID: "ws1", URL: "https://example.com", Active: true}
+	roles := fakeRoles{"vic": workspace.RoleViewer}
+	path := "/api/clicks/events?qrId=abc"
+
+	cases := []struct {
+		name  string
+		code  qrclient.QrCode
+		qrErr error
+		user  string
+		admin bool
+		want  int
+	}{
+		{"owner", personal, nil, "alice", false, http.StatusOK},
+		{"someone else", personal, nil, "mallory", false, http.StatusNotFound},
+		{"anonymous", personal, nil, "", false, http.StatusNotFound},
+		{"admin key", personal, nil, "", true, http.StatusOK},
+		{"workspace viewer", team, nil, "vic", false, http.StatusOK},
+		{"workspace non-member", team, nil, "olga-not-member", false, http.StatusNotFound},
+		{"unknown code", qrclient.QrCode{}, qrclient.ErrNotFound, "alice", false, http.StatusNotFound},
+		{"unknown code, admin key", qrclient.QrCode{}, qrclient.ErrNotFound, "", true, http.StatusNotFound},
+		{"qr-service down", qrclient.QrCode{}, errors.New("down"), "alice", false, http.StatusBadGateway},
+	}
+	for _, tc := range cases {
+		router := newStatsRouter(t, tc.code, tc.qrErr, roles)
+		req := statsRequest(path, tc.user)
+		if tc.admin {
+			req.Header.Set("X-Admin-Key", "k")
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.want {
+			t.Fatalf("%s: expected %d, got %d %s", tc.name, tc.want, w.Code, w.Body.String())
+		}
+	}
+
+	w := httptest.NewRecorder()
+	NewRouter(Server{Store: store.NewMemoryStore(), AdminAPIKey: "k"}).ServeHTTP(w, statsRequest(path, "alice"))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("without qr-service: expected %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
@@ -0,0 +1,99 @@
+package httpapi
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"click-service/internal/qrclient"
+)
+
+// landingReason supplies the title and message of a page whose owner left
+// them empty.
+type landingReason struct {
+	title   string
+	message string
+}
+
+var (
+	landingInactive = landingReason{"This code is no longer active", "The owner of this QR code has turned it off."}
+	landingDisabled = landingReason{"This code has been disabled", "This QR code was disabled by the site's moderators."}
+	landingUnknown  = landingReason{"QR code not found", "This QR code doesn't exist or has been deleted."}
+)
+
+const landingDefaultButton = "Continue"
+
+// landingCSP only lets the page load https images and its own inline styles:
+// owner-supplied text can't bring in scripts, frames or forms.
+const landingCSP = "default-src 'none'; img-src https:; style-src 'unsafe-inline'; base-uri 'none'; form-action 'none'; frame-ancestors 'none'"
+
+var landingPage = template.Must(template.New("landing").Parse(`<!doctype html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; font-family: system-ui, sans-serif; color: #222; background: #f6f6f6; }
+main { max-width: 28rem; margin: 15vh auto 0; padding: 2rem; text-align: center; background: #fff; border-radius: 8px; }
+img { max-width: 8rem; max-height: 8rem; }
+p { white-space: pre-line; }
+a { display: inline-block; margin-top: 1rem; padding: .75rem 1.5rem; color: #fff; background: #222; border-radius: 4px; text-decoration: none; }
+</style>
+</head>
+<body>
+<main>
+{{if .LogoURL}}<img src="{{.LogoURL}}" alt="">{{end}}
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+{{if .ButtonURL}}<a href="{{.ButtonURL}}" rel="noopener noreferrer">{{.ButtonText}}</a>{{end}}
+</main>
+</body>
+</html>
+`))
+
+// writeLandingPage answers a scan that can't redirect with page, the owner's
+// landing page, or a generic page for reason when page is nil. The status
+// stays 404 so the scan still reads as a dead link to anything but a person.
+// html/template escapes the owner's text, and URLs that aren't https are
+// dropped.
+func writeLandingPage(w http.ResponseWriter, page *qrclient.LandingPage, reason landingReason) {
+	var p qrclient.LandingPage
+	if page != nil {
+		p = *page
+	}
+	p.Title = strings.TrimSpace(p.Title)
+	if p.Title == "" {
+		p.Title = reason.title
+	}
+	p.Message = strings.TrimSpace(p.Message)
+	if p.Message == "" && page == nil {
+		p.Message = reason.message
+	}
+	p.LogoURL = httpsOnly(p.LogoURL)
+	p.ButtonURL = httpsOnly(p.ButtonURL)
+	p.ButtonText = strings.TrimSpace(p.ButtonText)
+	if p.ButtonText == "" {
+		p.ButtonText = landingDefaultButton
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Security-Policy", landingCSP)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(http.StatusNotFound)
+	_ = landingPage.Execute(w, p)
+}
+
+// httpsOnly returns raw if it's an absolute https URL, or "" otherwise.
+func httpsOnly(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ""
+	}
+	return raw
+}
@@ -0,0 +1,99 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+func TestRedirect_InactivePrecedence(t *testing.T) {
+	page := &qrclient.LandingPage{Title: "Closed for winter"}
+	for _, tc := range []struct {
+		name     string
+		qr       qrclient.QrCode
+		settings qrclient.Settings
+		wantCode int
+		wantLoc  string
+		wantBody string
+	}{
+		{
+			name:     "code fallback beats everything",
+			qr:       qrclient.QrCode{FallbackURL: "https://example.com/closed", LandingPage: page},
+			settings: qrclient.Settings{DefaultRedirectURL: "https://example.com"},
+			wantCode: http.StatusFound,
+			wantLoc:  "https://example.com/closed",
+		},
+		{
+			name:     "landing page beats the owner default",
+			qr:       qrclient.QrCode{LandingPage: page},
+			settings: qrclient.Settings{DefaultRedirectURL: "https://example.com"},
+			wantCode: http.StatusNotFound,
+			wantBody: "Closed for winter",
+		},
+		{
+			name:     "owner default",
+			settings: qrclient.Settings{DefaultRedirectURL: "https://example.com"},
+			wantCode: http.StatusFound,
+			wantLoc:  "https://example.com",
+		},
+		{
+			name:     "generic page",
+			wantCode: http.StatusNotFound,
+			wantBody: landingInactive.title,
+		},
+		{
+			name:     "moderated code ignores the owner's choices",
+			qr:       qrclient.QrCode{FallbackURL: "https://phish.test/closed", LandingPage: &qrclient.LandingPage{Title: "Log in here", ButtonURL: "https://phish.test"}, DisabledReason: "Phishing"},
+			settings: qrclient.Settings{DefaultRedirectURL: "https://phish.test"},
+			wantCode: http.StatusNotFound,
+			wantBody: landingDisabled.title,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			spy := &storeSpy{ch: make(chan store.ClickEvent, 1)}
+			tc.qr.ID, tc.qr.URL = "abc", "https://example.com/live"
+			router := NewRouter(Server{Store: spy, QrClient: &qrClientSpy{resp: tc.qr, settings: tc.settings}})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/r/abc", nil))
+			if w.Code != tc.wantCode || w.Header().Get("Location") != tc.wantLoc || !strings.Contains(w.Body.String(), tc.wantBody) {
+				t.Fatalf("unexpected %d %q %s", w.Code, w.Header().Get("Location"), w.Body.String())
+			}
+			if len(spy.ch) != 0 {
+				t.Fatal("inactive scan recorded a click")
+			}
+		})
+	}
+}
+
+func TestLandingPage_EscapesOwnerContent(t *testing.T) {
+	w := httptest.NewRecorder()
+	writeLandingPage(w, &qrclient.LandingPage{
+		Title:      `<script>alert(1)</script>`,
+		Message:    "Back\nsoon",
+		LogoURL:    "http://example.com/logo.png",
+		ButtonText: "Visit",
+		ButtonURL:  "javascript:alert(1)",
+	}, landingInactive)
+	body := w.Body.String()
+	if strings.Contains(body, "<script>") || !strings.Contains(body, "&lt;script&gt;") {
+		t.Fatalf("title not escaped: %s", body)
+	}
+	if strings.Contains(body, "<img") || strings.Contains(body, "javascript:") || strings.Contains(body, "Visit") {
+		t.Fatalf("unsafe URLs rendered: %s", body)
+	}
+	if w.Header().Get("Content-Security-Policy") == "" || w.Header().Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Fatalf("missing headers: %v", w.Header())
+	}
+
+	w = httptest.NewRecorder()
+	writeLandingPage(w, &qrclient.LandingPage{LogoURL: "https://example.com/logo.png", ButtonURL: "https://example.com/shop"}, landingInactive)
+	body = w.Body.String()
+	if !strings.Contains(body, `<img src="https://example.com/logo.png"`) || !strings.Contains(body, `href="https://example.com/shop"`) || !strings.Contains(body, landingDefaultButton) {
+		t.Fatalf("expected logo and button: %s", body)
+	}
+}
@@ -0,0 +1,177 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+func init() {
+	openapi3filter.RegisterBodyDecoder("text/html", openapi3filter.PlainBodyDecoder)
+}
+
+// specRouter loads openapi.yaml and fails the test if the document is invalid.
+func specRouter(t *testing.T) routers.Router {
+	t.Helper()
+	doc, err := openapi3.NewLoader().LoadFromData(openAPISpec)
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("invalid spec: %v", err)
+	}
+	// Match requests regardless of host.
+	doc.Servers = nil
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("router: %v", err)
+	}
+	return router
+}
+
+// serveValidated runs req through h and checks both sides of the exchange
+// against the spec.
+func serveValidated(t *testing.T, spec routers.Router, h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	route, params, err := spec.FindRoute(req)
+	if err != nil {
+		t.Fatalf("%s %s: not in spec: %v", req.Method, req.URL.Path, err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	in := &openapi3filter.RequestValidationInput{
+		Request: req, PathParams: params, Route: route,
+		// Bearer tokens are checked by apitoken.Middleware, not here.
+		Options: &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}
+	if err := openapi3filter.ValidateRequest(req.Context(), in); err != nil {
+		t.Fatalf("%s %s: request does not match spec: %v", req.Method, req.URL.Path, err)
+	}
+	out := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: in,
+		Status:                 w.Code,
+		Header:                 w.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(w.Body.Bytes())),
+	}
+	if err := openapi3filter.ValidateResponse(req.Context(), out); err != nil {
+		t.Fatalf("%s %s: %d response does not match spec: %v", req.Method, req.URL.Path, w.Code, err)
+	}
+	return w
+}
+
+func TestOpenAPI_TrafficMatchesSpec(t *testing.T) {
+	spec := specRouter(t)
+	st := store.NewMemoryStore()
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	_ = st.RecordClick(context.Background(), store.ClickEvent{At: day.Add(9 * time.Hour), QrCodeID: "abc", Country: "US"})
+	qr := &qrClientSpy{resp: qrclient.QrCode{ID: "abc", URL: "https://example.com", Active: true}}
+	h := NewRouter(Server{Store: st, QrClient: qr})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		return serveValidated(t, spec, h, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	get("/healthz")
+	get("/openapi.yaml")
+	if w := get("/r/abc"); w.Code != http.StatusFound {
+		t.Fatalf("redirect: expected %d, got %d", http.StatusFound, w.Code)
+	}
+
+	qr.resp = qrclient.QrCode{
+		ID: "app", URL: "https://example.com", Active: true, DestinationType: qrclient.DestinationAppLink,
+		AppLink: &qrclient.AppLink{IOSURL: "myapp://open", AppStoreURL: "https://apps.apple.com/app/id1"},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/r/app", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X)")
+	if w := serveValidated(t, spec, h, req); w.Code != http.StatusOK {
+		t.Fatalf("app link: expected %d, got %d", http.StatusOK, w.Code)
+	}
+
+	qr.resp = qrclient.QrCode{ID: "off", URL: "https://example.com", LandingPage: &qrclient.LandingPage{Title: "Closed"}}
+	if w := get("/r/off"); w.Code != http.StatusNotFound || w.Header().Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Fatalf("landing page: expected %d html, got %d %s", http.StatusNotFound, w.Code, w.Header().Get("Content-Type"))
+	}
+	qr.resp.FallbackURL = "https://example.com/closed"
+	if w := get("/r/off"); w.Code != http.StatusFound {
+		t.Fatalf("fallback: expected %d, got %d", http.StatusFound, w.Code)
+	}
+
+	qr.err = qrclient.ErrNotFound
+	get("/r/missing")
+
+	get("/api/clicks/stats?qrId=missing")
+	get("/api/clicks/events?qrId=missing")
+	qr.err, qr.resp = nil, qrclient.QrCode{ID: "abc", OwnerID: "alice", URL: "https://example.com", Active: true}
+	asOwner := func(path string) *httptest.ResponseRecorder {
+		return serveValidated(t, spec, h, statsRequest(path, "alice"))
+	}
+	if w := asOwner("/api/clicks/stats?qrId=abc"); w.Code != http.StatusOK {
+		t.Fatalf("stats: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	get("/api/clicks/stats?qrId=abc")
+	if w := serveValidated(t, spec, NewRouter(Server{Store: &storeSpy{err: context.DeadlineExceeded}, QrClient: qr}), statsRequest("/api/clicks/stats?qrId=abc", "alice")); w.Code != http.StatusGatewayTimeout {
+		t.Fatalf("stats deadline: expected %d, got %d", http.StatusGatewayTimeout, w.Code)
+	}
+	if w := asOwner("/api/clicks/daily?qrId=abc&day=2026-03-02"); w.Code != http.StatusOK {
+		t.Fatalf("daily: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	asOwner("/api/clicks/daily-batch?qrId=abc&days=2026-03-01,2026-03-02")
+	w := asOwner("/api/clicks/events?qrId=abc&limit=1")
+	var page clickEventsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &page); err != nil || w.Code != http.StatusOK || len(page.Events) != 1 {
+		t.Fatalf("events: expected one event, got %d %s", w.Code, w.Body.String())
+	}
+	if page.Next != "" {
+		asOwner("/api/clicks/events?qrId=abc&before=" + page.Next)
+	}
+	asOwner("/api/clicks/events?qrId=abc&before=yesterday")
+	asOwner("/api/clicks/abc")
+	asOwner("/api/clicks/abc/daily?date=2026-03-02")
+	asOwner("/api/clicks/abc/daily-batch?days=2026-03-02")
+
+	h = NewRouter(Server{Store: st, QrClient: qr, AdminAPIKey: "k"})
+	for _, body := range []string{`{"ids":["abc"]}`, `{"all":true}`, `{}`} {
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/redirect-cache/invalidate", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Admin-Key", "k")
+		serveValidated(t, spec, h, req)
+	}
+	h = NewRouter(Server{Store: st, QrClient: qr})
+
+	// Workspace codes: non-members, a missing user-service, a broken qr-service.
+	qr.err, qr.resp = nil, qrclient.QrCode{ID: "abc", URL: "https://example.com", Active: true, WorkspaceID: "ws1"}
+	if w := get("/api/clicks/stats?qrId=abc"); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("no user-service: expected %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+	h = NewRouter(Server{Store: st, QrClient: qr, Workspaces: fakeRoles{"alice": workspace.RoleViewer}})
+	if w := get("/api/clicks/daily-batch?qrId=abc&days=2026-03-02"); w.Code != http.StatusNotFound {
+		t.Fatalf("non-member: expected %d, got %d", http.StatusNotFound, w.Code)
+	}
+	qr.err = errors.New("boom")
+	if w := get("/api/clicks/abc/daily"); w.Code != http.StatusBadGateway {
+		t.Fatalf("qr-service down: expected %d, got %d", http.StatusBadGateway, w.Code)
+	}
+}
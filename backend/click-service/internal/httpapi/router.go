@@ -14,12 +14,24 @@ import (
 	"click-service/internal/store"
 )
 
+// QrResolver looks up a code for the redirect handler; qrclient.Client
+// (HTTP) and qrgrpc.Client both implement it.
+type QrResolver interface {
+	ResolveRedirect(ctx context.Context, id string) (qrclient.Redirect, error)
+}
+
 type Server struct {
 	Store    store.Store
-	QrClient interface {
-		GetQrCode(ctx context.Context, id string) (qrclient.QrCode, error)
-		GetSettings(ctx context.Context) (qrclient.Settings, error)
-	}
+	QrClient QrResolver
+	// Workspaces looks up team roles for stats on workspace codes; nil makes
+	// those stats unavailable to everyone but admins.
+	Workspaces WorkspaceRoles
+	// AdminAPIKey, sent as X-Admin-Key, reads any code's stats and
+	// invalidates cached redirects.
+	AdminAPIKey string
+	// RedirectCache is the cache in front of QrClient, if any, so qr-service
+	// can invalidate it.
+	RedirectCache RedirectCache
 }
 
 func NewRouter(srv Server) http.Handler {
@@ -45,36 +57,47 @@ func NewRouter(srv Server) http.Handler {
 		id := strings.TrimPrefix(r.URL.Path, "/r/")
 		id = strings.Trim(id, "/")
 		if id == "" {
-			w.WriteHeader(http.StatusNotFound)
+			writeLandingPage(w, nil, landingUnknown)
 			return
 		}
 
 		ctx := r.Context()
 		if srv.QrClient == nil {
-			w.WriteHeader(http.StatusNotFound)
+			writeLandingPage(w, nil, landingUnknown)
 			return
 		}
-		qr, err := srv.QrClient.GetQrCode(ctx, id)
+		resolved, err := srv.QrClient.ResolveRedirect(ctx, id)
 		if err != nil {
 			if errors.Is(err, qrclient.ErrNotFound) {
-				w.WriteHeader(http.StatusNotFound)
+				writeLandingPage(w, nil, landingUnknown)
 				return
 			}
 			w.WriteHeader(http.StatusBadGateway)
 			return
 		}
 
-		// If inactive, check for global default redirect URL
+		qr, settings := resolved.QrCode, resolved.Settings
+
+		// Inactive codes don't record a click. They go to the code's own
+		// fallback URL, else show its landing page, else go to the owner's
+		// default URL, else show a generic page. Codes an admin disabled
+		// always get the generic page: the owner's destinations are what
+		// got them disabled.
+		if !qr.Active && qr.DisabledReason != "" {
+			writeLandingPage(w, nil, landingDisabled)
+			return
+		}
 		if !qr.Active {
-			settings, err := srv.QrClient.GetSettings(ctx)
-			if err == nil && strings.TrimSpace(settings.DefaultRedirectURL) != "" {
-				// Redirect to global default URL without recording click
+			fallback := strings.TrimSpace(qr.FallbackURL)
+			if fallback == "" && qr.LandingPage == nil {
+				fallback = strings.TrimSpace(settings.DefaultRedirectURL)
+			}
+			if fallback != "" {
 				w.Header().Set("Cache-Control", "no-store")
-				http.Redirect(w, r, strings.TrimSpace(settings.DefaultRedirectURL), http.StatusFound)
+				http.Redirect(w, r, fallback, http.StatusFound)
 				return
 			}
-			// No default URL, return 404
-			w.WriteHeader(http.StatusNotFound)
+			writeLandingPage(w, qr.LandingPage, landingInactive)
 			return
 		}
 
@@ -84,26 +107,50 @@ func NewRouter(srv Server) http.Handler {
 			return
 		}
 
+		now := time.Now().UTC()
+		country := countryFromHeaders(r)
+
+		if qr.ID == "" {
+			qr.ID = id
+		}
+		utm, vars := resolveUtm(qr, settings), utmVars(qr, country, now)
+		targetURL = applyUtm(targetURL, utm, vars)
+
 		// Build the click event now, but record it asynchronously so the redirect is as fast as possible.
 		event := store.ClickEvent{
-			At:         time.Now().UTC(),
+			At:         now,
 			QrCodeID:   id,
 			TargetURL:  targetURL,
 			IP:         clientIP(r),
 			UserAgent:  strings.TrimSpace(r.UserAgent()),
 			Referer:    strings.TrimSpace(r.Referer()),
-			Country:    countryFromHeaders(r),
+			Country:    country,
 			RequestID:  strings.TrimSpace(w.Header().Get("X-Request-Id")),
 			AcceptLang: strings.TrimSpace(r.Header.Get("Accept-Language")),
 		}
 
-		w.Header().Set("Cache-Control", "no-store")
-		http.Redirect(w, r, targetURL, http.StatusFound)
+		if qr.DestinationType == qrclient.DestinationAppLink && qr.AppLink != nil {
+			link := applyUtmToAppLink(*qr.AppLink, utm, vars)
+			route := routeAppLink(link, targetURL, detectPlatform(event.UserAgent))
+			event.Route = route.Route
+			event.TargetURL = route.URL
+			if route.Fallback != "" {
+				writeAppLinkPage(w, route)
+			} else {
+				w.Header().Set("Cache-Control", "no-store")
+				http.Redirect(w, r, route.URL, http.StatusFound)
+			}
+		} else {
+			w.Header().Set("Cache-Control", "no-store")
+			http.Redirect(w, r, targetURL, http.StatusFound)
+		}
 
-		go func(ev store.ClickEvent) {
+		// The write outlives the request, so it drops the request's
+		// cancellation; the store's own deadline still bounds it.
+		go func(ctx context.Context, ev store.ClickEvent) {
 			defer func() { _ = recover() }()
-			_ = srv.Store.RecordClick(ev)
-		}(event)
+			_ = srv.Store.RecordClick(ctx, ev)
+		}(context.WithoutCancel(ctx), event)
 	})
 
 	clicksHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -114,6 +161,15 @@ func NewRouter(srv Server) http.Handler {
 
 		rest := strings.TrimPrefix(r.URL.Path, "/api/clicks/")
 		rest = strings.Trim(rest, "/")
+		if rest == "events" {
+			// /api/clicks/events?qrId=xxx&limit=50&before=cursor, which
+			// checks access itself.
+			srv.clickEventsHandler(w, r)
+			return
+		}
+		if !srv.allowStats(w, r, statsQrID(r, rest)) {
+			return
+		}
 
 		// Check for query-based endpoints first
 		if rest == "stats" {
@@ -123,13 +179,13 @@ func NewRouter(srv Server) http.Handler {
 				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "qrId_required"})
 				return
 			}
-			st, err := srv.Store.GetStats(qrID)
+			st, err := srv.Store.GetStats(r.Context(), qrID)
 			if err != nil {
 				if errors.Is(err, store.ErrNotFound) {
 					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 					return
 				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "stats_failed"})
+				writeStoreError(w, err, "stats_failed")
 				return
 			}
 			writeJSON(w, http.StatusOK, st)
@@ -167,13 +223,13 @@ func NewRouter(srv Server) http.Handler {
 				day = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC)
 			}
 
-			ds, err := srv.Store.GetDaily(qrID, day)
+			ds, err := srv.Store.GetDaily(r.Context(), qrID, day)
 			if err != nil {
 				if errors.Is(err, store.ErrNotFound) {
 					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 					return
 				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "daily_failed"})
+				writeStoreError(w, err, "daily_failed")
 				return
 			}
 			writeJSON(w, http.StatusOK, ds)
@@ -214,9 +270,9 @@ func NewRouter(srv Server) http.Handler {
 				return
 			}
 
-			result, err := srv.Store.GetDailyBatch(qrID, days)
+			result, err := srv.Store.GetDailyBatch(r.Context(), qrID, days)
 			if err != nil {
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "batch_failed"})
+				writeStoreError(w, err, "batch_failed")
 				return
 			}
 			writeJSON(w, http.StatusOK, result)
@@ -233,13 +289,13 @@ func NewRouter(srv Server) http.Handler {
 		if len(parts) == 1 {
 			// /api/clicks/{qrId}
 			qrID := parts[0]
-			st, err := srv.Store.GetStats(qrID)
+			st, err := srv.Store.GetStats(r.Context(), qrID)
 			if err != nil {
 				if errors.Is(err, store.ErrNotFound) {
 					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 					return
 				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "stats_failed"})
+				writeStoreError(w, err, "stats_failed")
 				return
 			}
 			writeJSON(w, http.StatusOK, st)
@@ -277,13 +333,13 @@ func NewRouter(srv Server) http.Handler {
 				day = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC)
 			}
 
-			ds, err := srv.Store.GetDaily(qrID, day)
+			ds, err := srv.Store.GetDaily(r.Context(), qrID, day)
 			if err != nil {
 				if errors.Is(err, store.ErrNotFound) {
 					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 					return
 				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "daily_failed"})
+				writeStoreError(w, err, "daily_failed")
 				return
 			}
 			writeJSON(w, http.StatusOK, ds)
@@ -319,9 +375,9 @@ func NewRouter(srv Server) http.Handler {
 				return
 			}
 
-			result, err := srv.Store.GetDailyBatch(qrID, days)
+			result, err := srv.Store.GetDailyBatch(r.Context(), qrID, days)
 			if err != nil {
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "batch_failed"})
+				writeStoreError(w, err, "batch_failed")
 				return
 			}
 			writeJSON(w, http.StatusOK, result)
@@ -332,8 +388,10 @@ func NewRouter(srv Server) http.Handler {
 	})
 
 	mux.Handle("/healthz", wrapAPI(healthHandler))
+	mux.Handle("/openapi.yaml", wrapAPI(http.HandlerFunc(openAPIHandler)))
 	mux.Handle("/r/", wrapAny(redirectHandler))
 	mux.Handle("/api/clicks/", wrapAPI(clicksHandler))
+	mux.Handle("/api/admin/redirect-cache/invalidate", wrapAPI(http.HandlerFunc(srv.cacheInvalidateHandler)))
 
 	return mux
 }
@@ -344,6 +402,26 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 	_ = json.NewEncoder(w).Encode(payload)
 }
 
+// storeErrorStatus is the status for a failed store call: 504 when the
+// operation hit its deadline, 503 when it was cancelled (the client went away
+// or the server is shutting down), and 500 otherwise.
+func storeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeStoreError answers a failed store call with code, at the status
+// storeErrorStatus picks for err.
+func writeStoreError(w http.ResponseWriter, err error, code string) {
+	writeJSON(w, storeErrorStatus(err), map[string]string{"error": code})
+}
+
 func clientIP(r *http.Request) string {
 	// Prefer proxy headers if present.
 	xff := strings.TrimSpace(r.Header.Get("X-Forwarded-For"))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,9 +14,14 @@ import (
 
 type storeSpy struct {
 	ch chan store.ClickEvent
+	// err, when set, fails every read.
+	err error
+	// recordCtxErr is RecordClick's ctx.Err(), read after receiving from ch.
+	recordCtxErr error
 }
 
-func (s *storeSpy) RecordClick(ev store.ClickEvent) error {
+func (s *storeSpy) RecordClick(ctx context.Context, ev store.ClickEvent) error {
+	s.recordCtxErr = ctx.Err()
 	select {
 	case s.ch <- ev:
 	default:
@@ -23,33 +29,50 @@ func (s *storeSpy) RecordClick(ev store.ClickEvent) error {
 	return nil
 }
 
-func (s *storeSpy) GetStats(qrCodeID string) (store.ClickStats, error) {
+func (s *storeSpy) GetStats(_ context.Context, qrCodeID string) (store.ClickStats, error) {
+	if s.err != nil {
+		return store.ClickStats{}, s.err
+	}
 	return store.ClickStats{}, store.ErrNotFound
 }
 
-func (s *storeSpy) GetDaily(qrCodeID string, day time.Time) (store.DailyClickStats, error) {
+func (s *storeSpy) GetDaily(_ context.Context, qrCodeID string, day time.Time) (store.DailyClickStats, error) {
+	if s.err != nil {
+		return store.DailyClickStats{}, s.err
+	}
 	return store.DailyClickStats{}, store.ErrNotFound
 }
 
-func (s *storeSpy) GetDailyBatch(qrCodeID string, days []time.Time) (map[string]store.DailyClickStats, error) {
+func (s *storeSpy) GetDailyBatch(_ context.Context, qrCodeID string, days []time.Time) (map[string]store.DailyClickStats, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return nil, store.ErrNotFound
 }
 
+func (s *storeSpy) ListClicks(_ context.Context, qrCodeID string, cursor store.ClickCursor, limit int) ([]store.ClickEvent, error) {
+	return nil, s.err
+}
+
+func (s *storeSpy) PurgeClicks(_ context.Context, cutoff time.Time) error {
+	return s.err
+}
+
 type qrClientSpy struct {
-	called bool
-	gotID  string
-	resp   qrclient.QrCode
-	err    error
+	called   bool
+	gotID    string
+	resp     qrclient.QrCode
+	err      error
+	settings qrclient.Settings
 }
 
-func (q *qrClientSpy) GetQrCode(_ context.Context, id string) (qrclient.QrCode, error) {
+func (q *qrClientSpy) ResolveRedirect(_ context.Context, id string) (qrclient.Redirect, error) {
 	q.called = true
 	q.gotID = id
-	return q.resp, q.err
-}
-
-func (q *qrClientSpy) GetSettings(_ context.Context) (qrclient.Settings, error) {
-	return qrclient.Settings{}, nil
+	if q.err != nil {
+		return qrclient.Redirect{}, q.err
+	}
+	return qrclient.Redirect{QrCode: q.resp, Settings: q.settings}, nil
 }
 
 func TestRedirect_UsesDbUrlAndChecksActive(t *testing.T) {
@@ -85,3 +108,47 @@ func TestRedirect_UsesDbUrlAndChecksActive(t *testing.T) {
 		// ok
 	}
 }
+
+func TestRedirect_RecordsClickAfterRequestEnds(t *testing.T) {
+	spy := &storeSpy{ch: make(chan store.ClickEvent, 1)}
+	router := NewRouter(Server{Store: spy, QrClient: &qrClientSpy{resp: qrclient.QrCode{ID: "abc", URL: "https://example.com", Active: true}}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/r/abc", nil).WithContext(ctx))
+	if w.Code != http.StatusFound {
+		t.Fatalf("expected %d, got %d", http.StatusFound, w.Code)
+	}
+	select {
+	case <-spy.ch:
+		if spy.recordCtxErr != nil {
+			t.Fatalf("click recorded with an ended context: %v", spy.recordCtxErr)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("click not recorded")
+	}
+}
+
+func TestClicks_StoreContextErrors(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		status int
+	}{
+		{context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{context.Canceled, http.StatusServiceUnavailable},
+	} {
+		router := NewRouter(Server{Store: &storeSpy{err: tc.err}, QrClient: &qrClientSpy{resp: qrclient.QrCode{ID: "abc", OwnerID: "alice"}}})
+		for path, code := range map[string]string{
+			"/api/clicks/stats?qrId=abc":                       "stats_failed",
+			"/api/clicks/abc/daily?day=2026-01-02":             "daily_failed",
+			"/api/clicks/daily-batch?qrId=abc&days=2026-01-02": "batch_failed",
+		} {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, statsRequest(path, "alice"))
+			if w.Code != tc.status || !strings.Contains(w.Body.String(), code) {
+				t.Fatalf("%s with %v: expected %d %s, got %d %s", path, tc.err, tc.status, code, w.Code, w.Body.String())
+			}
+		}
+	}
+}
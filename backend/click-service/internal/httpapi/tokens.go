@@ -0,0 +1,17 @@
+package httpapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/apitoken"
+)
+
+// RequiredTokenScope is the scope an API token needs for r: clicks:read for
+// stats. Redirects are public and need none.
+func RequiredTokenScope(r *http.Request) string {
+	if strings.HasPrefix(r.URL.Path, "/api/clicks/") {
+		return apitoken.ScopeClicksRead
+	}
+	return ""
+}
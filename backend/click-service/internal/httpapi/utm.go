@@ -0,0 +1,139 @@
+package httpapi
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"click-service/internal/qrclient"
+)
+
+// resolveUtm layers a code's own template over its campaign's template, field by field.
+func resolveUtm(qr qrclient.QrCode, settings qrclient.Settings) qrclient.Utm {
+	var out qrclient.Utm
+	if qr.Campaign != "" {
+		out = settings.CampaignUtm[qr.Campaign]
+	}
+	if qr.Utm == nil {
+		return out
+	}
+	own := *qr.Utm
+	if own.Source != "" {
+		out.Source = own.Source
+	}
+	if own.Medium != "" {
+		out.Medium = own.Medium
+	}
+	if own.Campaign != "" {
+		out.Campaign = own.Campaign
+	}
+	if own.Content != "" {
+		out.Content = own.Content
+	}
+	if own.Term != "" {
+		out.Term = own.Term
+	}
+	return out
+}
+
+// applyUtm appends the template's parameters to target, leaving any parameter
+// already present in the destination untouched. The existing raw query is kept
+// as-is so signed or order-sensitive URLs survive the merge.
+func applyUtm(target string, tmpl qrclient.Utm, vars map[string]string) string {
+	if tmpl == (qrclient.Utm{}) {
+		return target
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+	extra := utmParams(tmpl, vars, u.Query())
+	if len(extra) == 0 {
+		return target
+	}
+	appendQuery(u, strings.Join(extra, "&"))
+	return u.String()
+}
+
+// utmParams expands the template's non-empty fields into escaped key=value
+// pairs, skipping keys already in existing.
+func utmParams(tmpl qrclient.Utm, vars map[string]string, existing url.Values) []string {
+	fields := tmpl.Fields()
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if existing.Has(f.Key) {
+			continue
+		}
+		v := strings.TrimSpace(expandUtm(f.Value, vars))
+		if v == "" {
+			continue
+		}
+		out = append(out, url.QueryEscape(f.Key)+"="+url.QueryEscape(v))
+	}
+	return out
+}
+
+func appendQuery(u *url.URL, extra string) {
+	if u.RawQuery == "" {
+		u.RawQuery = extra
+	} else {
+		u.RawQuery += "&" + extra
+	}
+}
+
+// applyUtmToAppLink tags every app-link destination the way applyUtm tags the
+// web URL. Google Play ignores utm_* parameters on a listing and passes on
+// only `referrer` to the installed app, so Play Store URLs get the parameters
+// packed into it instead, unless the URL already has one.
+func applyUtmToAppLink(link qrclient.AppLink, tmpl qrclient.Utm, vars map[string]string) qrclient.AppLink {
+	if tmpl == (qrclient.Utm{}) {
+		return link
+	}
+	for _, f := range []*string{&link.IOSURL, &link.AndroidURL, &link.AppStoreURL} {
+		if *f != "" {
+			*f = applyUtm(*f, tmpl, vars)
+		}
+	}
+	link.PlayStoreURL = applyPlayReferrer(link.PlayStoreURL, tmpl, vars)
+	return link
+}
+
+func applyPlayReferrer(target string, tmpl qrclient.Utm, vars map[string]string) string {
+	u, err := url.Parse(target)
+	if err != nil || target == "" {
+		return target
+	}
+	if !strings.EqualFold(u.Hostname(), "play.google.com") {
+		return applyUtm(target, tmpl, vars)
+	}
+	if u.Query().Has("referrer") {
+		return target
+	}
+	params := utmParams(tmpl, vars, nil)
+	if len(params) == 0 {
+		return target
+	}
+	appendQuery(u, "referrer="+url.QueryEscape(strings.Join(params, "&")))
+	return u.String()
+}
+
+func expandUtm(value string, vars map[string]string) string {
+	if !strings.Contains(value, "{") {
+		return value
+	}
+	pairs := make([]string, 0, len(vars)*2)
+	for k, v := range vars {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	return strings.NewReplacer(pairs...).Replace(value)
+}
+
+func utmVars(qr qrclient.QrCode, country string, at time.Time) map[string]string {
+	return map[string]string{
+		"qr_id":    qr.ID,
+		"label":    qr.Label,
+		"campaign": qr.Campaign,
+		"country":  country,
+		"date":     at.UTC().Format("2006-01-02"),
+	}
+}
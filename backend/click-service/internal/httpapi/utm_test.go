@@ -0,0 +1,94 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+func TestApplyUtm_KeepsExistingParams(t *testing.T) {
+	tmpl := qrclient.Utm{Source: "qr", Campaign: "summer", Content: "{qr_id}"}
+	got := applyUtm("https://example.com/p?utm_source=print&z=1&a=2", tmpl, map[string]string{"qr_id": "abc"})
+	want := "https://example.com/p?utm_source=print&z=1&a=2&utm_campaign=summer&utm_content=abc"
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestApplyUtm_SkipsEmptyExpansions(t *testing.T) {
+	tmpl := qrclient.Utm{Source: "qr", Term: "{country}"}
+	got := applyUtm("https://example.com/", tmpl, map[string]string{"country": ""})
+	if got != "https://example.com/?utm_source=qr" {
+		t.Fatalf("unexpected url %q", got)
+	}
+}
+
+func TestResolveUtm_CodeOverridesCampaign(t *testing.T) {
+	qr := qrclient.QrCode{Campaign: "spring", Utm: &qrclient.Utm{Medium: "poster"}}
+	settings := qrclient.Settings{CampaignUtm: map[string]qrclient.Utm{
+		"spring": {Source: "qr", Medium: "flyer", Campaign: "{campaign}"},
+	}}
+	got := resolveUtm(qr, settings)
+	want := qrclient.Utm{Source: "qr", Medium: "poster", Campaign: "{campaign}"}
+	if got != want {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestRedirect_AppliesCampaignUtm(t *testing.T) {
+	spy := &storeSpy{ch: make(chan store.ClickEvent, 1)}
+	qrSpy := &qrClientSpy{
+		resp: qrclient.QrCode{ID: "abc123", URL: "https://example.com/db?ref=x", Active: true, Campaign: "spring"},
+		settings: qrclient.Settings{CampaignUtm: map[string]qrclient.Utm{
+			"spring": {Source: "qr", Campaign: "{campaign}", Term: "{country}"},
+		}},
+	}
+	router := NewRouter(Server{Store: spy, QrClient: qrSpy})
+
+	req := httptest.NewRequest(http.MethodGet, "/r/abc123", nil)
+	req.Header.Set("CF-IPCountry", "DE")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	want := "https://example.com/db?ref=x&utm_source=qr&utm_campaign=spring&utm_term=DE"
+	if loc := w.Header().Get("Location"); loc != want {
+		t.Fatalf("expected Location %q, got %q", want, loc)
+	}
+
+	select {
+	case ev := <-spy.ch:
+		if ev.TargetURL != want {
+			t.Fatalf("expected targetUrl %q, got %q", want, ev.TargetURL)
+		}
+	case <-time.After(150 * time.Millisecond):
+	}
+}
+
+func TestApplyUtmToAppLink(t *testing.T) {
+	link := applyUtmToAppLink(qrclient.AppLink{
+		IOSURL:       "https://links.example.com/promo",
+		AndroidURL:   "exampleapp://promo?x=1",
+		AppStoreURL:  "https://apps.apple.com/app/id123",
+		PlayStoreURL: "https://play.google.com/store/apps/details?id=com.example",
+	}, qrclient.Utm{Source: "qr", Content: "{qr_id}"}, map[string]string{"qr_id": "abc"})
+
+	want := qrclient.AppLink{
+		IOSURL:       "https://links.example.com/promo?utm_source=qr&utm_content=abc",
+		AndroidURL:   "exampleapp://promo?x=1&utm_source=qr&utm_content=abc",
+		AppStoreURL:  "https://apps.apple.com/app/id123?utm_source=qr&utm_content=abc",
+		PlayStoreURL: "https://play.google.com/store/apps/details?id=com.example&referrer=utm_source%3Dqr%26utm_content%3Dabc",
+	}
+	if link != want {
+		t.Fatalf("expected %+v, got %+v", want, link)
+	}
+
+	// A referrer the owner set themselves is left alone.
+	own := "https://play.google.com/store/apps/details?id=com.example&referrer=partner"
+	if got := applyPlayReferrer(own, qrclient.Utm{Source: "qr"}, nil); got != own {
+		t.Fatalf("expected %q, got %q", own, got)
+	}
+}
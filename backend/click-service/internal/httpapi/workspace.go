@@ -0,0 +1,90 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"click-service/internal/qrclient"
+)
+
+// WorkspaceRoles resolves a user's role in a team workspace;
+// workspace.Client implements it against user-service.
+type WorkspaceRoles interface {
+	Role(ctx context.Context, workspaceID, userID string) (workspace.Role, error)
+}
+
+// statsQrID returns the code a /api/clicks/ request reads, from ?qrId= or
+// the legacy /api/clicks/{qrId}/... path.
+func statsQrID(r *http.Request, rest string) string {
+	switch rest {
+	case "stats", "daily", "daily-batch":
+		return strings.TrimSpace(r.URL.Query().Get("qrId"))
+	}
+	id, _, _ := strings.Cut(rest, "/")
+	return id
+}
+
+// allowStats reports whether the caller may read qrID's stats, writing the
+// error response when not. The rules are those of allowEvents; a request
+// without a qrId passes so the handler can reject it as a bad request.
+func (srv Server) allowStats(w http.ResponseWriter, r *http.Request, qrID string) bool {
+	return qrID == "" || srv.allowEvents(w, r, qrID)
+}
+
+// allowEvents reports whether the caller may read qrID's raw scans or stats,
+// writing the error response when not. It takes the code's owner, at least
+// the viewer role in its workspace, or the admin key. Unknown codes and codes
+// the caller can't see both get 404.
+func (srv Server) allowEvents(w http.ResponseWriter, r *http.Request, qrID string) bool {
+	if srv.QrClient == nil {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "qr_service_unavailable"})
+		return false
+	}
+	resolved, err := srv.QrClient.ResolveRedirect(r.Context(), qrID)
+	if errors.Is(err, qrclient.ErrNotFound) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return false
+	}
+	if err != nil {
+		writeJSON(w, http.StatusBadGateway, map[string]string{"error": "qr_service_unavailable"})
+		return false
+	}
+	if srv.AdminAPIKey != "" && r.Header.Get("X-Admin-Key") == srv.AdminAPIKey {
+		return true
+	}
+	q := resolved.QrCode
+	if q.WorkspaceID != "" {
+		return srv.allowWorkspace(w, r, q.WorkspaceID)
+	}
+	if userID := strings.TrimSpace(r.Header.Get("X-User-Id")); userID == "" || userID != q.OwnerID {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return false
+	}
+	return true
+}
+
+// allowWorkspace reports whether the caller holds at least the viewer role in
+// workspaceID, writing the error response when not.
+func (srv Server) allowWorkspace(w http.ResponseWriter, r *http.Request, workspaceID string) bool {
+	if srv.Workspaces == nil {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "workspaces_unavailable"})
+		return false
+	}
+	role, err := srv.Workspaces.Role(r.Context(), workspaceID, strings.TrimSpace(r.Header.Get("X-User-Id")))
+	switch {
+	case errors.Is(err, workspace.ErrNotMember):
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return false
+	case err != nil:
+		writeJSON(w, http.StatusBadGateway, map[string]string{"error": "workspace_lookup_failed"})
+		return false
+	case !role.AtLeast(workspace.RoleViewer):
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "forbidden"})
+		return false
+	}
+	return true
+}
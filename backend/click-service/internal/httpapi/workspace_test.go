@@ -0,0 +1,146 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"click-service/internal/qrclient"
+	"click-service/internal/store"
+)
+
+type fakeRoles map[string]workspace.Role
+
+func (f fakeRoles) Role(_ context.Context, workspaceID, userID string) (workspace.Role, error) {
+	if workspaceID == "" {
+		return "", workspace.ErrNotMember
+	}
+	if userID == "broken" {
+		return "", errors.New("user-service down")
+	}
+	role, ok := f[userID]
+	if !ok {
+		return "", workspace.ErrNotMember
+	}
+	return role, nil
+}
+
+func newStatsRouter(t *testing.T, code qrclient.QrCode, qrErr error, roles WorkspaceRoles) http.Handler {
+	t.Helper()
+	st := store.NewMemoryStore()
+	if err := st.RecordClick(context.Background(), store.ClickEvent{At: time.Now().UTC(), QrCodeID: "team1"}); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	return NewRouter(Server{
+		Store:       st,
+		QrClient:    &qrClientSpy{resp: code, err: qrErr},
+		Workspaces:  roles,
+		AdminAPIKey: "k",
+	})
+}
+
+func statsRequest(path, userID string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if userID != "" {
+		req.Header.Set("X-User-Id", userID)
+	}
+	return req
+}
+
+func TestStats_WorkspaceCodeRequiresMembership(t *testing.T) {
+	code := qrclient.QrCode{ID: "team1", URL: "https://example.com", Active: true, WorkspaceID: "ws1"}
+	router := newStatsRouter(t, code, nil, fakeRoles{"alice": workspace.RoleViewer})
+	today := time.Now().UTC().Format("2006-01-02")
+
+	paths := []string{
+		"/api/clicks/stats?qrId=team1",
+		"/api/clicks/daily?qrId=team1&day=" + today,
+		"/api/clicks/daily-batch?qrId=team1&days=" + today,
+		"/api/clicks/team1",
+		"/api/clicks/team1/daily?day=" + today,
+		"/api/clicks/team1/daily-batch?days=" + today,
+	}
+	for _, path := range paths {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, statsRequest(path, "alice"))
+		if rr.Code != http.StatusOK {
+			t.Errorf("viewer %s: status = %d body=%s", path, rr.Code, rr.Body.String())
+		}
+
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, statsRequest(path, "mallory"))
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("non-member %s: status = %d, want 404", path, rr.Code)
+		}
+	}
+
+	adminReq := statsRequest("/api/clicks/stats?qrId=team1", "")
+	adminReq.Header.Set("X-Admin-Key", "k")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, adminReq)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("admin: status = %d", rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, statsRequest("/api/clicks/stats?qrId=team1", "broken"))
+	if rr.Code != http.StatusBadGateway {
+		t.Fatalf("lookup failure: status = %d, want 502", rr.Code)
+	}
+}
+
+func TestStats_PersonalCodeNeedsItsOwner(t *testing.T) {
+	personal := qrclient.QrCode{ID: "team1", URL: "https://example.com", Active: true, OwnerID: "alice"}
+	router := newStatsRouter(t, personal, nil, nil)
+	for user, want := range map[string]int{"alice": http.StatusOK, "mallory": http.StatusNotFound, "": http.StatusNotFound} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, statsRequest("/api/clicks/team1/daily-batch?days=2026-03-02", user))
+		if rr.Code != want {
+			t.Errorf("user %q: status = %d, want %d", user, rr.Code, want)
+		}
+	}
+
+	adminReq := statsRequest("/api/clicks/stats?qrId=team1", "")
+	adminReq.Header.Set("X-Admin-Key", "k")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, adminReq)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("admin: status = %d", rr.Code)
+	}
+}
+
+func TestStats_UnknownCodesAreHidden(t *testing.T) {
+	// Without QR_SERVICE_ADMIN_KEY qr-service hides every owned code, so the
+	// lookup says not found; that must not open the stats up.
+	rr := httptest.NewRecorder()
+	newStatsRouter(t, qrclient.QrCode{}, qrclient.ErrNotFound, nil).ServeHTTP(rr, statsRequest("/api/clicks/stats?qrId=team1", "alice"))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("unknown code: status = %d, want 404", rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	newStatsRouter(t, qrclient.QrCode{}, errors.New("boom"), nil).ServeHTTP(rr, statsRequest("/api/clicks/stats?qrId=team1", "alice"))
+	if rr.Code != http.StatusBadGateway {
+		t.Fatalf("qr-service down: status = %d, want 502", rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	NewRouter(Server{Store: store.NewMemoryStore()}).ServeHTTP(rr, statsRequest("/api/clicks/stats?qrId=team1", "alice"))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("no qr-service: status = %d, want 503", rr.Code)
+	}
+}
+
+func TestStats_WorkspaceCodeWithoutUserService(t *testing.T) {
+	code := qrclient.QrCode{ID: "team1", URL: "https://example.com", Active: true, WorkspaceID: "ws1"}
+	rr := httptest.NewRecorder()
+	newStatsRouter(t, code, nil, nil).ServeHTTP(rr, statsRequest("/api/clicks/stats?qrId=team1", "alice"))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rr.Code)
+	}
+}
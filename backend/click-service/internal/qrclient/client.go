@@ -1,92 +1,261 @@
+// Package qrclient resolves codes for redirects over qr-service's HTTP API,
+// narrowing the generated qrapi types to what click-service uses.
 package qrclient
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/qrapi"
 )
 
 var ErrNotFound = errors.New("not found")
 
 type QrCode struct {
-	ID     string `json:"id"`
-	URL    string `json:"url"`
-	Active bool   `json:"active"`
+	ID       string `json:"id"`
+	OwnerID  string `json:"ownerId,omitempty"`
+	Label    string `json:"label"`
+	URL      string `json:"url"`
+	Active   bool   `json:"active"`
+	Campaign string `json:"campaign,omitempty"`
+	Utm      *Utm   `json:"utm,omitempty"`
+
+	DestinationType string   `json:"destinationType,omitempty"`
+	AppLink         *AppLink `json:"appLink,omitempty"`
+	WorkspaceID     string   `json:"workspaceId,omitempty"`
+
+	FallbackURL string       `json:"fallbackUrl,omitempty"`
+	LandingPage *LandingPage `json:"landingPage,omitempty"`
+
+	// DisabledReason is set while an admin has disabled the code.
+	DisabledReason string `json:"disabledReason,omitempty"`
+}
+
+const DestinationAppLink = "app_link"
+
+// AppLink mirrors qr-service's per-platform app routes.
+type AppLink struct {
+	IOSURL       string `json:"iosUrl,omitempty"`
+	AndroidURL   string `json:"androidUrl,omitempty"`
+	AppStoreURL  string `json:"appStoreUrl,omitempty"`
+	PlayStoreURL string `json:"playStoreUrl,omitempty"`
+}
+
+// LandingPage mirrors qr-service's page for codes that can't redirect.
+type LandingPage struct {
+	Title      string `json:"title,omitempty"`
+	Message    string `json:"message,omitempty"`
+	LogoURL    string `json:"logoUrl,omitempty"`
+	ButtonText string `json:"buttonText,omitempty"`
+	ButtonURL  string `json:"buttonUrl,omitempty"`
+}
+
+// Utm mirrors qr-service's UTM template; values may contain placeholders.
+type Utm struct {
+	Source   string `json:"source,omitempty"`
+	Medium   string `json:"medium,omitempty"`
+	Campaign string `json:"campaign,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Term     string `json:"term,omitempty"`
+}
+
+// UtmParam is one query parameter of a UTM template.
+type UtmParam struct {
+	Key, Value string
+}
+
+// Fields returns the template values keyed by their query parameter name,
+// in the conventional order, like qr-service's UtmTemplate.Fields.
+func (u Utm) Fields() []UtmParam {
+	return []UtmParam{
+		{"utm_source", u.Source},
+		{"utm_medium", u.Medium},
+		{"utm_campaign", u.Campaign},
+		{"utm_content", u.Content},
+		{"utm_term", u.Term},
+	}
 }
 
 type Settings struct {
-	DefaultRedirectURL string `json:"defaultRedirectUrl"`
+	DefaultRedirectURL string         `json:"defaultRedirectUrl"`
+	CampaignUtm        map[string]Utm `json:"campaignUtm,omitempty"`
+}
+
+// Redirect is everything the redirect handler needs to answer one scan.
+type Redirect struct {
+	QrCode   QrCode
+	Settings Settings
 }
 
+// Client resolves codes through the generated qr-service client.
 type Client struct {
-	BaseURL string
-	HTTP    *http.Client
+	api *qrapi.ClientWithResponses
+	// AdminKey is qr-service's ADMIN_API_KEY, sent as X-Admin-Key. Without
+	// it qr-service answers 404 for every code that has an owner, so
+	// lookups need it; so does the change feed.
+	AdminKey string
 }
 
 func New(baseURL string) *Client {
 	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
-	return &Client{
-		BaseURL: baseURL,
-		HTTP: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+	// The URL is only parsed here; a bad one fails on the first request.
+	api, _ := qrapi.NewClientWithResponses(baseURL, qrapi.WithHTTPClient(&http.Client{Timeout: 5 * time.Second}))
+	return &Client{api: api}
+}
+
+func (c *Client) adminKey(_ context.Context, req *http.Request) error {
+	if c.AdminKey != "" {
+		req.Header.Set("X-Admin-Key", c.AdminKey)
 	}
+	return nil
 }
 
 func (c *Client) GetQrCode(ctx context.Context, id string) (QrCode, error) {
 	id = strings.TrimSpace(id)
-	if id == "" {
+	if id == "" || c.api == nil {
 		return QrCode{}, ErrNotFound
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/qr-codes/%s", c.BaseURL, id), nil)
+	resp, err := c.api.GetQrCodeWithResponse(ctx, id, c.adminKey)
 	if err != nil {
 		return QrCode{}, err
 	}
-
-	resp, err := c.HTTP.Do(req)
-	if err != nil {
-		return QrCode{}, err
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return QrCode{}, ErrNotFound
+	case resp.JSON200 == nil:
+		return QrCode{}, fmt.Errorf("qr-service unexpected status: %d", resp.StatusCode())
 	}
-	defer resp.Body.Close()
+	return qrCodeFrom(*resp.JSON200), nil
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return QrCode{}, ErrNotFound
+// GetSettings fetches ownerID's settings, which needs the admin key.
+func (c *Client) GetSettings(ctx context.Context, ownerID string) (Settings, error) {
+	if c.api == nil {
+		return Settings{}, errors.New("qr-service base URL invalid")
 	}
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return QrCode{}, fmt.Errorf("qr-service unexpected status: %d", resp.StatusCode)
+	resp, err := c.api.GetSettingsWithResponse(ctx, &qrapi.GetSettingsParams{OwnerId: &ownerID}, c.adminKey)
+	if err != nil {
+		return Settings{}, err
 	}
-
-	var out QrCode
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return QrCode{}, err
+	if resp.JSON200 == nil {
+		return Settings{}, fmt.Errorf("qr-service unexpected status: %d", resp.StatusCode())
+	}
+	out := Settings{DefaultRedirectURL: deref(resp.JSON200.DefaultRedirectUrl)}
+	if m := resp.JSON200.CampaignUtm; m != nil {
+		out.CampaignUtm = make(map[string]Utm, len(*m))
+		for name, t := range *m {
+			out.CampaignUtm[name] = utmFrom(t)
+		}
 	}
 	return out, nil
 }
 
-func (c *Client) GetSettings(ctx context.Context) (Settings, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/settings", c.BaseURL), nil)
+// Change is one entry of qr-service's change feed. QrCodeID is empty for a
+// settings change.
+type Change struct {
+	ID       int64
+	QrCodeID string
+}
+
+// maxChanges is qr-service's limit on one page of its change feed.
+const maxChanges = 1000
+
+// Changes reads qr-service's change feed after the cursor, holding on for up
+// to wait (whole seconds) when there's nothing new yet; it needs the admin
+// key. next is the cursor for the following call.
+func (c *Client) Changes(ctx context.Context, after int64, wait time.Duration) (changes []Change, next int64, err error) {
+	if c.api == nil {
+		return nil, after, errors.New("qr-service base URL invalid")
+	}
+	limit, seconds := maxChanges, int(wait/time.Second)
+	resp, err := c.api.ListEventsWithResponse(ctx, &qrapi.ListEventsParams{After: &after, Limit: &limit, Wait: &seconds}, c.adminKey)
 	if err != nil {
-		return Settings{}, err
+		return nil, after, err
+	}
+	if resp.JSON200 == nil {
+		return nil, after, fmt.Errorf("qr-service unexpected status: %d", resp.StatusCode())
+	}
+	changes = make([]Change, 0, len(resp.JSON200.Events))
+	for _, e := range resp.JSON200.Events {
+		changes = append(changes, Change{ID: e.Id, QrCodeID: deref(e.QrCodeId)})
+	}
+	return changes, resp.JSON200.Next, nil
+}
+
+// qrCodeFrom keeps the fields a redirect needs.
+func qrCodeFrom(q qrapi.QrCode) QrCode {
+	out := QrCode{
+		ID:              q.Id,
+		OwnerID:         deref(q.OwnerId),
+		Label:           q.Label,
+		URL:             q.Url,
+		Active:          q.Active,
+		Campaign:        deref(q.Campaign),
+		DestinationType: string(q.DestinationType),
+		WorkspaceID:     deref(q.WorkspaceId),
+		FallbackURL:     deref(q.FallbackUrl),
+		DisabledReason:  deref(q.DisabledReason),
+	}
+	if q.Utm != nil {
+		u := utmFrom(*q.Utm)
+		out.Utm = &u
+	}
+	if a := q.AppLink; a != nil {
+		out.AppLink = &AppLink{
+			IOSURL:       deref(a.IosUrl),
+			AndroidURL:   deref(a.AndroidUrl),
+			AppStoreURL:  deref(a.AppStoreUrl),
+			PlayStoreURL: deref(a.PlayStoreUrl),
+		}
 	}
+	if p := q.LandingPage; p != nil {
+		out.LandingPage = &LandingPage{
+			Title:      deref(p.Title),
+			Message:    deref(p.Message),
+			LogoURL:    deref(p.LogoUrl),
+			ButtonText: deref(p.ButtonText),
+			ButtonURL:  deref(p.ButtonUrl),
+		}
+	}
+	return out
+}
 
-	resp, err := c.HTTP.Do(req)
-	if err != nil {
-		return Settings{}, err
+func utmFrom(t qrapi.UtmTemplate) Utm {
+	return Utm{
+		Source:   deref(t.Source),
+		Medium:   deref(t.Medium),
+		Campaign: deref(t.Campaign),
+		Content:  deref(t.Content),
+		Term:     deref(t.Term),
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return Settings{}, fmt.Errorf("qr-service unexpected status: %d", resp.StatusCode)
+func deref(s *string) string {
+	if s == nil {
+		return ""
 	}
+	return *s
+}
 
-	var out Settings
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return Settings{}, err
+// ResolveRedirect fetches the code and, only when the redirect needs them,
+// its owner's settings. Settings failures are not fatal: they only drive the
+// owner's default redirect for inactive codes without their own fallback, and
+// campaign UTM tags.
+func (c *Client) ResolveRedirect(ctx context.Context, id string) (Redirect, error) {
+	qr, err := c.GetQrCode(ctx, id)
+	if err != nil {
+		return Redirect{}, err
+	}
+	out := Redirect{QrCode: qr}
+	needsDefault := !qr.Active && qr.FallbackURL == "" && qr.LandingPage == nil
+	if needsDefault || qr.Campaign != "" {
+		if settings, err := c.GetSettings(ctx, qr.OwnerID); err == nil {
+			out.Settings = settings
+		}
 	}
 	return out, nil
 }
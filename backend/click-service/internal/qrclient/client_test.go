@@ -0,0 +1,72 @@
+package qrclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_ResolveRedirect(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Admin-Key") != "k" {
+			t.Errorf("%s: expected the admin key, got %q", r.URL.Path, r.Header.Get("X-Admin-Key"))
+		}
+		if r.URL.Path == "/api/settings" && r.URL.Query().Get("ownerId") != "alice" {
+			t.Errorf("expected the owner's settings, got %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/qr-codes/abc":
+			_, _ = w.Write([]byte(`{"id":"abc","label":"Menu","url":"https://example.com","active":true,"destinationType":"app_link",
+				"createdAtIso":"2026-01-02T03:04:05Z","symbology":"qr","campaign":"spring","ownerId":"alice","workspaceId":"ws1",
+				"utm":{"source":"qr"},"appLink":{"iosUrl":"myapp://open"}}`))
+		case "/api/settings":
+			_, _ = w.Write([]byte(`{"defaultRedirectUrl":"https://example.com/home","campaignUtm":{"spring":{"medium":"print"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not_found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	c.AdminKey = "k"
+	got, err := c.ResolveRedirect(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	q := got.QrCode
+	if q.ID != "abc" || q.OwnerID != "alice" || q.DestinationType != DestinationAppLink || q.WorkspaceID != "ws1" || q.Utm == nil || q.Utm.Source != "qr" || q.AppLink == nil || q.AppLink.IOSURL != "myapp://open" {
+		t.Fatalf("unexpected code %+v", q)
+	}
+	if got.Settings.CampaignUtm["spring"].Medium != "print" {
+		t.Fatalf("expected campaign settings, got %+v", got.Settings)
+	}
+	if _, err := c.ResolveRedirect(context.Background(), "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_Changes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/api/events" || r.Header.Get("X-Admin-Key") != "k" || q.Get("after") != "7" || q.Get("wait") != "3" || q.Get("limit") != "1000" {
+			t.Errorf("unexpected request %s %s", r.URL, r.Header.Get("X-Admin-Key"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"events":[
+			{"id":8,"type":"qr_code.updated","qrCodeId":"abc","atIso":"2026-01-02T03:04:05Z"},
+			{"id":9,"type":"settings.updated","ownerId":"alice","atIso":"2026-01-02T03:04:06Z"}],"next":9}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	c.AdminKey = "k"
+	changes, next, err := c.Changes(context.Background(), 7, 3*time.Second)
+	if err != nil || next != 9 || len(changes) != 2 || changes[0] != (Change{ID: 8, QrCodeID: "abc"}) || changes[1].QrCodeID != "" {
+		t.Fatalf("unexpected changes %+v next %d: %v", changes, next, err)
+	}
+}
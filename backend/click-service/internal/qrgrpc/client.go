@@ -0,0 +1,121 @@
+// Package qrgrpc resolves redirects through qr-service's internal gRPC API,
+// replacing the two HTTP lookups qrclient makes with a single call.
+package qrgrpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb"
+
+	"click-service/internal/qrclient"
+)
+
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  redirectpb.RedirectServiceClient
+	// Timeout bounds each call, matching qrclient's HTTP timeout.
+	Timeout time.Duration
+}
+
+// New connects lazily to addr (host:port); the first call dials.
+func New(addr string, opts ...grpc.DialOption) (*Client, error) {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(strings.TrimSpace(addr), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, rpc: redirectpb.NewRedirectServiceClient(conn), Timeout: 5 * time.Second}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) ResolveRedirect(ctx context.Context, id string) (qrclient.Redirect, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return qrclient.Redirect{}, qrclient.ErrNotFound
+	}
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	resp, err := c.rpc.ResolveRedirect(ctx, &redirectpb.ResolveRedirectRequest{QrCodeId: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return qrclient.Redirect{}, qrclient.ErrNotFound
+		}
+		return qrclient.Redirect{}, err
+	}
+	return qrclient.Redirect{
+		QrCode:   qrCodeFromProto(resp.GetQrCode()),
+		Settings: settingsFromProto(resp.GetSettings()),
+	}, nil
+}
+
+func qrCodeFromProto(q *redirectpb.QrCode) qrclient.QrCode {
+	out := qrclient.QrCode{
+		ID:              q.GetId(),
+		OwnerID:         q.GetOwnerId(),
+		Label:           q.GetLabel(),
+		URL:             q.GetUrl(),
+		Active:          q.GetActive(),
+		Campaign:        q.GetCampaign(),
+		DestinationType: q.GetDestinationType(),
+		WorkspaceID:     q.GetWorkspaceId(),
+		FallbackURL:     q.GetFallbackUrl(),
+		DisabledReason:  q.GetDisabledReason(),
+	}
+	if q.GetUtm() != nil {
+		utm := utmFromProto(q.GetUtm())
+		out.Utm = &utm
+	}
+	if link := q.GetAppLink(); link != nil {
+		out.AppLink = &qrclient.AppLink{
+			IOSURL:       link.GetIosUrl(),
+			AndroidURL:   link.GetAndroidUrl(),
+			AppStoreURL:  link.GetAppStoreUrl(),
+			PlayStoreURL: link.GetPlayStoreUrl(),
+		}
+	}
+	if p := q.GetLandingPage(); p != nil {
+		out.LandingPage = &qrclient.LandingPage{
+			Title:      p.GetTitle(),
+			Message:    p.GetMessage(),
+			LogoURL:    p.GetLogoUrl(),
+			ButtonText: p.GetButtonText(),
+			ButtonURL:  p.GetButtonUrl(),
+		}
+	}
+	return out
+}
+
+func utmFromProto(t *redirectpb.UtmTemplate) qrclient.Utm {
+	return qrclient.Utm{
+		Source:   t.GetSource(),
+		Medium:   t.GetMedium(),
+		Campaign: t.GetCampaign(),
+		Content:  t.GetContent(),
+		Term:     t.GetTerm(),
+	}
+}
+
+func settingsFromProto(s *redirectpb.Settings) qrclient.Settings {
+	out := qrclient.Settings{DefaultRedirectURL: s.GetDefaultRedirectUrl()}
+	if len(s.GetCampaignUtm()) > 0 {
+		out.CampaignUtm = make(map[string]qrclient.Utm, len(s.GetCampaignUtm()))
+		for name, t := range s.GetCampaignUtm() {
+			out.CampaignUtm[name] = utmFromProto(t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+package qrgrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb"
+
+	"click-service/internal/qrclient"
+)
+
+type fakeRedirectServer struct {
+	redirectpb.UnimplementedRedirectServiceServer
+}
+
+func (fakeRedirectServer) ResolveRedirect(_ context.Context, req *redirectpb.ResolveRedirectRequest) (*redirectpb.ResolveRedirectResponse, error) {
+	if req.GetQrCodeId() != "abc" {
+		return nil, status.Error(codes.NotFound, "not_found")
+	}
+	return &redirectpb.ResolveRedirectResponse{
+		QrCode: &redirectpb.QrCode{
+			Id: "abc", OwnerId: "alice", Url: "https://example.com", Active: true, Campaign: "spring",
+			DestinationType: qrclient.DestinationAppLink,
+			AppLink:         &redirectpb.AppLink{IosUrl: "myapp://open"},
+			DisabledReason:  "Phishing",
+			Utm:             &redirectpb.UtmTemplate{Source: "qr"},
+			FallbackUrl:     "https://example.com/closed",
+			LandingPage:     &redirectpb.LandingPage{Title: "Closed", ButtonUrl: "https://example.com"},
+		},
+		Settings: &redirectpb.Settings{
+			DefaultRedirectUrl: "https://example.com/fallback",
+			CampaignUtm:        map[string]*redirectpb.UtmTemplate{"spring": {Medium: "print"}},
+		},
+	}, nil
+}
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	redirectpb.RegisterRedirectServiceServer(gs, fakeRedirectServer{})
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	c, err := New("passthrough:///bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestResolveRedirect_MapsResponse(t *testing.T) {
+	got, err := newTestClient(t).ResolveRedirect(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	q := got.QrCode
+	if q.ID != "abc" || q.OwnerID != "alice" || q.DisabledReason != "Phishing" || !q.Active || q.Campaign != "spring" || q.Utm == nil || q.Utm.Source != "qr" {
+		t.Fatalf("unexpected code %+v", q)
+	}
+	if q.AppLink == nil || q.AppLink.IOSURL != "myapp://open" {
+		t.Fatalf("expected app link, got %+v", q.AppLink)
+	}
+	if q.FallbackURL != "https://example.com/closed" || q.LandingPage == nil || q.LandingPage.Title != "Closed" || q.LandingPage.ButtonURL != "https://example.com" {
+		t.Fatalf("expected fallback and landing page, got %q %+v", q.FallbackURL, q.LandingPage)
+	}
+	if got.Settings.DefaultRedirectURL != "https://example.com/fallback" || got.Settings.CampaignUtm["spring"].Medium != "print" {
+		t.Fatalf("unexpected settings %+v", got.Settings)
+	}
+}
+
+func TestResolveRedirect_NotFound(t *testing.T) {
+	_, err := newTestClient(t).ResolveRedirect(context.Background(), "missing")
+	if !errors.Is(err, qrclient.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
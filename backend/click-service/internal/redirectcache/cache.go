@@ -0,0 +1,180 @@
+// Package redirectcache keeps recently resolved redirects in memory so most
+// scans answer without a round trip to qr-service.
+package redirectcache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"click-service/internal/qrclient"
+)
+
+// Resolver is the lookup the cache sits in front of.
+type Resolver interface {
+	ResolveRedirect(ctx context.Context, id string) (qrclient.Redirect, error)
+}
+
+type Options struct {
+	// Size caps the number of cached codes; the least recently used is
+	// evicted first. Defaults to 10000.
+	Size int
+	// TTL bounds how long a resolved code is served from memory when no
+	// invalidation arrives. Defaults to one minute.
+	TTL time.Duration
+	// NegativeTTL is TTL for codes qr-service doesn't know. Defaults to ten
+	// seconds.
+	NegativeTTL time.Duration
+	// Now is the clock; tests replace it.
+	Now func() time.Time
+}
+
+// lookupTimeout bounds a shared lookup, which runs detached from any one
+// caller so that caller leaving doesn't fail the others waiting on it.
+const lookupTimeout = 5 * time.Second
+
+// Cache is an LRU of resolved redirects with per-entry expiry. Concurrent
+// misses for the same code share one lookup. Only successes and ErrNotFound
+// are cached; other errors go back to the caller and the next scan retries.
+type Cache struct {
+	next Resolver
+	opts Options
+
+	mu    sync.Mutex
+	lru   *list.List
+	items map[string]*list.Element
+	// gen counts invalidations. A lookup that started before one isn't
+	// cached, and lookups after it don't join one that started before.
+	gen uint64
+
+	group singleflight.Group
+}
+
+type entry struct {
+	id       string
+	redirect qrclient.Redirect
+	notFound bool
+	expires  time.Time
+}
+
+func New(next Resolver, opts Options) *Cache {
+	if opts.Size <= 0 {
+		opts.Size = 10000
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = time.Minute
+	}
+	if opts.NegativeTTL <= 0 {
+		opts.NegativeTTL = 10 * time.Second
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	return &Cache{next: next, opts: opts, lru: list.New(), items: map[string]*list.Element{}}
+}
+
+func (c *Cache) ResolveRedirect(ctx context.Context, id string) (qrclient.Redirect, error) {
+	if e, ok := c.get(id); ok {
+		if e.notFound {
+			return qrclient.Redirect{}, qrclient.ErrNotFound
+		}
+		return e.redirect, nil
+	}
+
+	c.mu.Lock()
+	gen := c.gen
+	c.mu.Unlock()
+	ch := c.group.DoChan(strconv.FormatUint(gen, 10)+":"+id, func() (any, error) {
+		lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lookupTimeout)
+		defer cancel()
+		r, err := c.next.ResolveRedirect(lookupCtx, id)
+		switch {
+		case err == nil:
+			c.put(gen, entry{id: id, redirect: r, expires: c.opts.Now().Add(c.opts.TTL)})
+		case errors.Is(err, qrclient.ErrNotFound):
+			c.put(gen, entry{id: id, notFound: true, expires: c.opts.Now().Add(c.opts.NegativeTTL)})
+		}
+		return r, err
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return qrclient.Redirect{}, res.Err
+		}
+		return res.Val.(qrclient.Redirect), nil
+	case <-ctx.Done():
+		return qrclient.Redirect{}, ctx.Err()
+	}
+}
+
+// Invalidate drops the given codes, so their next scan asks qr-service.
+func (c *Cache) Invalidate(ids ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for _, id := range ids {
+		if el, ok := c.items[id]; ok {
+			c.lru.Remove(el)
+			delete(c.items, id)
+		}
+	}
+}
+
+// Purge drops every code, for changes such as settings that affect them all.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.lru.Init()
+	clear(c.items)
+}
+
+// Len is the number of cached codes, expired ones included until they're
+// looked up or evicted.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache) get(id string) (entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[id]
+	if !ok {
+		return entry{}, false
+	}
+	e := el.Value.(entry)
+	if !c.opts.Now().Before(e.expires) {
+		c.lru.Remove(el)
+		delete(c.items, id)
+		return entry{}, false
+	}
+	c.lru.MoveToFront(el)
+	return e, true
+}
+
+// put stores e unless the cache was invalidated since gen was read.
+func (c *Cache) put(gen uint64, e entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	if el, ok := c.items[e.id]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.items[e.id] = c.lru.PushFront(e)
+	for c.lru.Len() > c.opts.Size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.items, oldest.Value.(entry).id)
+	}
+}
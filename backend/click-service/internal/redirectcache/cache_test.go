@@ -0,0 +1,215 @@
+package redirectcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"click-service/internal/qrclient"
+)
+
+type fakeResolver struct {
+	calls atomic.Int32
+	// release, when set, holds every lookup until it's closed.
+	release chan struct{}
+	err     error
+	url     atomic.Value
+}
+
+func (f *fakeResolver) ResolveRedirect(_ context.Context, id string) (qrclient.Redirect, error) {
+	f.calls.Add(1)
+	if f.release != nil {
+		<-f.release
+	}
+	if f.err != nil {
+		return qrclient.Redirect{}, f.err
+	}
+	url, _ := f.url.Load().(string)
+	return qrclient.Redirect{QrCode: qrclient.QrCode{ID: id, URL: url, Active: true}}, nil
+}
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newCache(f *fakeResolver, opts Options) (*Cache, *clock) {
+	clk := &clock{now: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+	opts.Now = clk.Now
+	return New(f, opts), clk
+}
+
+func resolve(t *testing.T, c *Cache, id string) (qrclient.Redirect, error) {
+	t.Helper()
+	return c.ResolveRedirect(context.Background(), id)
+}
+
+func TestCache_HitsUntilTTL(t *testing.T) {
+	f := &fakeResolver{}
+	f.url.Store("https://example.com/a")
+	c, clk := newCache(f, Options{TTL: time.Minute})
+
+	for range 3 {
+		r, err := resolve(t, c, "abc")
+		if err != nil || r.QrCode.URL != "https://example.com/a" {
+			t.Fatalf("unexpected %+v %v", r, err)
+		}
+	}
+	if n := f.calls.Load(); n != 1 {
+		t.Fatalf("expected 1 lookup, got %d", n)
+	}
+
+	clk.now = clk.now.Add(time.Minute)
+	_, _ = resolve(t, c, "abc")
+	if n := f.calls.Load(); n != 2 {
+		t.Fatalf("expected a lookup after the TTL, got %d", n)
+	}
+}
+
+func TestCache_NegativeCachingAndErrors(t *testing.T) {
+	f := &fakeResolver{err: qrclient.ErrNotFound}
+	c, clk := newCache(f, Options{NegativeTTL: 10 * time.Second})
+
+	for range 2 {
+		if _, err := resolve(t, c, "missing"); !errors.Is(err, qrclient.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	}
+	if n := f.calls.Load(); n != 1 {
+		t.Fatalf("expected the miss to be cached, got %d lookups", n)
+	}
+	clk.now = clk.now.Add(10 * time.Second)
+	_, _ = resolve(t, c, "missing")
+	if n := f.calls.Load(); n != 2 {
+		t.Fatalf("expected a lookup after the negative TTL, got %d", n)
+	}
+
+	// Other failures aren't cached.
+	f.err = errors.New("qr-service down")
+	for range 2 {
+		if _, err := resolve(t, c, "flaky"); err == nil {
+			t.Fatalf("expected the error")
+		}
+	}
+	if n := f.calls.Load(); n != 4 {
+		t.Fatalf("expected every failed lookup to retry, got %d lookups", n)
+	}
+}
+
+func TestCache_ConcurrentMissesShareOneLookup(t *testing.T) {
+	f := &fakeResolver{release: make(chan struct{})}
+	c, _ := newCache(f, Options{})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := resolve(t, c, "abc"); err != nil {
+				t.Errorf("resolve: %v", err)
+			}
+		}()
+	}
+	// Let the goroutines pile up behind the first lookup.
+	time.Sleep(20 * time.Millisecond)
+	close(f.release)
+	wg.Wait()
+	if n := f.calls.Load(); n != 1 {
+		t.Fatalf("expected 1 shared lookup, got %d", n)
+	}
+}
+
+func TestCache_CallerLeavingDoesNotCancelSharedLookup(t *testing.T) {
+	f := &fakeResolver{release: make(chan struct{})}
+	c, _ := newCache(f, Options{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := c.ResolveRedirect(ctx, "abc")
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	close(f.release)
+	if _, err := resolve(t, c, "abc"); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if n := f.calls.Load(); n != 1 {
+		t.Fatalf("expected the abandoned lookup to finish and be cached, got %d lookups", n)
+	}
+}
+
+func TestCache_InvalidateAndPurge(t *testing.T) {
+	f := &fakeResolver{}
+	f.url.Store("https://example.com/old")
+	c, _ := newCache(f, Options{})
+	_, _ = resolve(t, c, "a")
+	_, _ = resolve(t, c, "b")
+
+	f.url.Store("https://example.com/new")
+	c.Invalidate("a")
+	if r, _ := resolve(t, c, "a"); r.QrCode.URL != "https://example.com/new" {
+		t.Fatalf("invalidated code served stale %q", r.QrCode.URL)
+	}
+	if r, _ := resolve(t, c, "b"); r.QrCode.URL != "https://example.com/old" {
+		t.Fatalf("other code dropped: %q", r.QrCode.URL)
+	}
+
+	c.Purge()
+	if c.Len() != 0 {
+		t.Fatalf("expected an empty cache, got %d", c.Len())
+	}
+	if r, _ := resolve(t, c, "b"); r.QrCode.URL != "https://example.com/new" {
+		t.Fatalf("purged code served stale %q", r.QrCode.URL)
+	}
+}
+
+func TestCache_InvalidationDuringLookupIsNotCached(t *testing.T) {
+	f := &fakeResolver{release: make(chan struct{})}
+	f.url.Store("https://example.com/old")
+	c, _ := newCache(f, Options{})
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = resolve(t, c, "a")
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	c.Invalidate("a")
+	close(f.release)
+	<-done
+
+	f.url.Store("https://example.com/new")
+	if r, _ := resolve(t, c, "a"); r.QrCode.URL != "https://example.com/new" {
+		t.Fatalf("lookup from before the invalidation was cached: %q", r.QrCode.URL)
+	}
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	f := &fakeResolver{}
+	c, _ := newCache(f, Options{Size: 2})
+	_, _ = resolve(t, c, "a")
+	_, _ = resolve(t, c, "b")
+	_, _ = resolve(t, c, "a") // a is now the most recent
+	_, _ = resolve(t, c, "c") // evicts b
+
+	if c.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", c.Len())
+	}
+	before := f.calls.Load()
+	_, _ = resolve(t, c, "a")
+	if f.calls.Load() != before {
+		t.Fatalf("a was evicted")
+	}
+	_, _ = resolve(t, c, "b")
+	if f.calls.Load() != before+1 {
+		t.Fatalf("b was not evicted")
+	}
+}
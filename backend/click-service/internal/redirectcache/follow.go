@@ -0,0 +1,78 @@
+package redirectcache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"click-service/internal/qrclient"
+)
+
+// Feed is qr-service's change feed; qrclient.Client implements it.
+type Feed interface {
+	Changes(ctx context.Context, after int64, wait time.Duration) ([]qrclient.Change, int64, error)
+}
+
+const (
+	// followWait is how long one feed call waits for a change. It stays
+	// under qrclient's 5s request timeout.
+	followWait = 3 * time.Second
+	// followRetry is the pause after a failed feed call.
+	followRetry = 5 * time.Second
+)
+
+// Follow drops cached codes as qr-service's change feed reports them, and
+// every code on a settings change, until ctx ends. Each instance follows the
+// feed itself, so all of them hear of every change; qr-service's
+// invalidation calls only reach the one behind its CLICK_SERVICE_BASE_URL.
+//
+// Follow starts by reading the feed to its end without acting on it, since
+// the cache holds nothing that old, then drops whatever was cached while it
+// caught up. A failed call is retried after followRetry from the same
+// cursor, so no change is skipped.
+func (c *Cache) Follow(ctx context.Context, feed Feed) {
+	var after int64
+	caughtUp := false
+	for ctx.Err() == nil {
+		wait := followWait
+		if !caughtUp {
+			wait = 0
+		}
+		changes, next, err := feed.Changes(ctx, after, wait)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("change feed read failed: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(followRetry):
+			}
+			continue
+		}
+		after = next
+		if !caughtUp {
+			if len(changes) == 0 {
+				caughtUp = true
+				c.Purge()
+			}
+			continue
+		}
+		c.apply(changes)
+	}
+}
+
+// apply drops the codes changes name, or everything if one is a settings
+// change.
+func (c *Cache) apply(changes []qrclient.Change) {
+	ids := make([]string, 0, len(changes))
+	for _, ch := range changes {
+		if ch.QrCodeID == "" {
+			c.Purge()
+			return
+		}
+		ids = append(ids, ch.QrCodeID)
+	}
+	if len(ids) > 0 {
+		c.Invalidate(ids...)
+	}
+}
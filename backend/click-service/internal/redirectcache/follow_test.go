@@ -0,0 +1,75 @@
+package redirectcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"click-service/internal/qrclient"
+)
+
+type feedPage struct {
+	changes []qrclient.Change
+	next    int64
+}
+
+// fakeFeed hands out pages one call at a time, so a send returns once Follow
+// has acted on the page before it.
+type fakeFeed struct {
+	pages  chan feedPage
+	afters []int64
+}
+
+func (f *fakeFeed) Changes(ctx context.Context, after int64, _ time.Duration) ([]qrclient.Change, int64, error) {
+	f.afters = append(f.afters, after)
+	select {
+	case p := <-f.pages:
+		return p.changes, p.next, nil
+	case <-ctx.Done():
+		return nil, after, ctx.Err()
+	}
+}
+
+func TestCache_FollowInvalidatesFromTheFeed(t *testing.T) {
+	f := &fakeResolver{}
+	c, _ := newCache(f, Options{})
+	feed := &fakeFeed{pages: make(chan feedPage)}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Follow(ctx, feed)
+		close(done)
+	}()
+
+	// Old changes only move the cursor; reaching the end empties the cache.
+	_, _ = resolve(t, c, "old")
+	feed.pages <- feedPage{changes: []qrclient.Change{{ID: 1, QrCodeID: "a"}, {ID: 2, QrCodeID: "b"}}, next: 2}
+	feed.pages <- feedPage{next: 2}
+	feed.pages <- feedPage{next: 2}
+
+	for _, id := range []string{"a", "b"} {
+		_, _ = resolve(t, c, id)
+	}
+	feed.pages <- feedPage{changes: []qrclient.Change{{ID: 3, QrCodeID: "a"}}, next: 3}
+	feed.pages <- feedPage{next: 3}
+	if n := c.Len(); n != 1 {
+		t.Fatalf("expected only b cached, got %d", n)
+	}
+
+	feed.pages <- feedPage{changes: []qrclient.Change{{ID: 4}}, next: 4}
+	feed.pages <- feedPage{next: 4}
+	if n := c.Len(); n != 0 {
+		t.Fatalf("expected a settings change to empty the cache, got %d", n)
+	}
+
+	cancel()
+	<-done
+	// Each call resumes from the last cursor; the one after the last page
+	// may or may not have started before cancel.
+	want := []int64{0, 2, 2, 2, 3, 3, 4}
+	for i, after := range want {
+		if len(feed.afters) < len(want) || feed.afters[i] != after {
+			t.Fatalf("expected cursors %v, got %v", want, feed.afters)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+// Package seed generates synthetic click history for QR codes.
+//
+// Daily volumes follow a Poisson distribution around a mean that grows with
+// the fixture's trend and dips on weekends; each click's hour and country are
+// drawn from the fixture's weights. The same fixture, codes and seed always
+// produce the same events, and codes that already have clicks are skipped,
+// so re-running a seed doesn't count anything twice.
+package seed
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"time"
+
+	"click-service/internal/store"
+)
+
+//go:embed fixtures/default.json
+var defaultFixture []byte
+
+var ErrNoCodes = errors.New("no QR codes to seed clicks for")
+
+type Fixture struct {
+	// Days of history to generate, ending yesterday.
+	Days int `json:"days"`
+	// DailyMean is the expected clicks per code on a weekday at the start
+	// of the window.
+	DailyMean float64 `json:"dailyMean"`
+	// WeekendFactor scales Saturday and Sunday volume; zero means 1.
+	WeekendFactor float64 `json:"weekendFactor,omitempty"`
+	// Trend is the relative growth across the window: 0.5 ends 50% higher.
+	Trend          float64            `json:"trend,omitempty"`
+	HourlyWeights  [24]float64        `json:"hourlyWeights"`
+	CountryWeights map[string]float64 `json:"countryWeights,omitempty"`
+	UserAgents     []string           `json:"userAgents,omitempty"`
+	Codes          []CodeFixture      `json:"codes,omitempty"`
+}
+
+type CodeFixture struct {
+	QrCodeID string `json:"qrCodeId"`
+	// Weight scales DailyMean for this code; zero means 1.
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// Default returns the fixture bundled with the service. It has no codes;
+// pass them via Options.Codes.
+func Default() Fixture {
+	f, err := Load(bytes.NewReader(defaultFixture))
+	if err != nil {
+		panic(fmt.Sprintf("seed: bundled fixture is invalid: %v", err))
+	}
+	return f
+}
+
+func Load(r io.Reader) (Fixture, error) {
+	var f Fixture
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return Fixture{}, err
+	}
+	if f.Days <= 0 || f.DailyMean < 0 || f.WeekendFactor < 0 {
+		return Fixture{}, errors.New("days must be positive and rates non-negative")
+	}
+	hourTotal := 0.0
+	for _, w := range f.HourlyWeights {
+		if w < 0 {
+			return Fixture{}, errors.New("hourlyWeights must be non-negative")
+		}
+		hourTotal += w
+	}
+	if hourTotal == 0 {
+		return Fixture{}, errors.New("hourlyWeights must not all be zero")
+	}
+	for c, w := range f.CountryWeights {
+		if w < 0 {
+			return Fixture{}, fmt.Errorf("countryWeights[%s] must be non-negative", c)
+		}
+	}
+	return f, nil
+}
+
+// LoadFile reads a fixture from path; "default" selects the bundled fixture.
+func LoadFile(path string) (Fixture, error) {
+	if path == "default" {
+		return Default(), nil
+	}
+	fh, err := os.Open(path)
+	if err != nil {
+		return Fixture{}, err
+	}
+	defer fh.Close()
+	return Load(fh)
+}
+
+// LoadManifest reads the code list written by qr-service's seed -manifest.
+func LoadManifest(r io.Reader) ([]string, error) {
+	var entries []struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.ID != "" {
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids, nil
+}
+
+type Options struct {
+	Seed int64
+	// Now anchors the window; the last generated day is the one before Now.
+	Now time.Time
+	// Codes are added to the fixture's own codes with weight 1.
+	Codes []string
+}
+
+type Result struct {
+	// Codes counts the codes clicks were generated for.
+	Codes int
+	// Skipped counts codes left alone because they already had clicks.
+	Skipped int
+	Events  int
+}
+
+// Apply records the generated clicks through st.RecordClick, oldest first,
+// so per-code "last click" stats end up on the newest event. A code with any
+// clicks already, from an earlier run or real scans, is skipped.
+func Apply(ctx context.Context, st store.Store, f Fixture, opts Options) (Result, error) {
+	if opts.Now.IsZero() {
+		opts.Now = time.Now().UTC()
+	}
+	codes := append([]CodeFixture(nil), f.Codes...)
+	for _, id := range opts.Codes {
+		codes = append(codes, CodeFixture{QrCodeID: id})
+	}
+	if len(codes) == 0 {
+		return Result{}, ErrNoCodes
+	}
+
+	hours := newPicker(f.HourlyWeights[:])
+	countryNames, countryWeights := sortedWeights(f.CountryWeights)
+	countries := newPicker(countryWeights)
+
+	today := opts.Now.UTC().Truncate(24 * time.Hour)
+	start := today.AddDate(0, 0, -f.Days)
+	weekend := f.WeekendFactor
+	if weekend == 0 {
+		weekend = 1
+	}
+
+	var res Result
+	for _, code := range codes {
+		_, err := st.GetStats(ctx, code.QrCodeID)
+		if err == nil {
+			res.Skipped++
+			continue
+		}
+		if !errors.Is(err, store.ErrNotFound) {
+			return res, fmt.Errorf("stats for %s: %w", code.QrCodeID, err)
+		}
+		res.Codes++
+
+		// Each code draws from its own source, so skipping one leaves the
+		// others' history unchanged.
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(code.QrCodeID))
+		rng := rand.New(rand.NewSource(opts.Seed ^ int64(h.Sum64())))
+		weight := code.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		for d := 0; d < f.Days; d++ {
+			day := start.AddDate(0, 0, d)
+			mean := f.DailyMean * weight * (1 + f.Trend*float64(d)/float64(f.Days))
+			if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+				mean *= weekend
+			}
+
+			n := poisson(rng, mean)
+			offsets := make([]time.Duration, n)
+			for i := range offsets {
+				offsets[i] = time.Duration(hours.pick(rng))*time.Hour + time.Duration(rng.Intn(3600))*time.Second
+			}
+			sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+			for i, off := range offsets {
+				at := day.Add(off)
+				event := store.ClickEvent{
+					At:        at,
+					AtIso:     at.Format(time.RFC3339),
+					QrCodeID:  code.QrCodeID,
+					RequestID: fmt.Sprintf("seed-%s-%s-%d", code.QrCodeID, day.Format("20060102"), i),
+				}
+				if len(countryNames) > 0 {
+					event.Country = countryNames[countries.pick(rng)]
+				}
+				if len(f.UserAgents) > 0 {
+					event.UserAgent = f.UserAgents[rng.Intn(len(f.UserAgents))]
+				}
+				if err := st.RecordClick(ctx, event); err != nil {
+					return res, fmt.Errorf("record click for %s: %w", code.QrCodeID, err)
+				}
+				res.Events++
+			}
+		}
+	}
+	return res, nil
+}
+
+// sortedWeights flattens a weight map in key order so picks are reproducible.
+func sortedWeights(m map[string]float64) ([]string, []float64) {
+	names := make([]string, 0, len(m))
+	for k := range m {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	weights := make([]float64, len(names))
+	for i, k := range names {
+		weights[i] = m[k]
+	}
+	return names, weights
+}
+
+// picker samples indexes proportionally to their weights.
+type picker struct {
+	cumulative []float64
+}
+
+func newPicker(weights []float64) picker {
+	p := picker{cumulative: make([]float64, len(weights))}
+	total := 0.0
+	for i, w := range weights {
+		total += w
+		p.cumulative[i] = total
+	}
+	return p
+}
+
+func (p picker) pick(rng *rand.Rand) int {
+	if len(p.cumulative) == 0 {
+		return 0
+	}
+	target := rng.Float64() * p.cumulative[len(p.cumulative)-1]
+	return sort.Search(len(p.cumulative), func(i int) bool { return p.cumulative[i] > target })
+}
+
+// poisson uses Knuth's method for small means and a rounded normal
+// approximation above that, where the product of uniforms underflows.
+func poisson(rng *rand.Rand, mean float64) int {
+	if mean <= 0 {
+		return 0
+	}
+	if mean > 30 {
+		n := int(math.Round(mean + math.Sqrt(mean)*rng.NormFloat64()))
+		return max(n, 0)
+	}
+	limit := math.Exp(-mean)
+	n, p := 0, 1.0
+	for {
+		p *= rng.Float64()
+		if p <= limit {
+			return n
+		}
+		n++
+	}
+}
@@ -0,0 +1,108 @@
+package seed
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"click-service/internal/store"
+)
+
+func TestApply_Deterministic(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 30, 0, 0, time.UTC)
+	opts := Options{Seed: 7, Now: now, Codes: []string{"a", "b"}}
+
+	a := store.NewMemoryStore()
+	ra, err := Apply(context.Background(), a, Default(), opts)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	b := store.NewMemoryStore()
+	rb, err := Apply(context.Background(), b, Default(), opts)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if ra != rb || ra.Events == 0 {
+		t.Fatalf("expected identical non-empty results, got %+v and %+v", ra, rb)
+	}
+
+	day := now.AddDate(0, 0, -30)
+	da, _ := a.GetDaily(context.Background(), "a", day)
+	db, _ := b.GetDaily(context.Background(), "a", day)
+	if da.Total != db.Total || da.Hour12 != db.Hour12 || len(da.RegionCounts) != len(db.RegionCounts) {
+		t.Fatalf("daily stats differ: %+v vs %+v", da, db)
+	}
+
+	st, err := a.GetStats(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	last, _ := time.Parse(time.RFC3339, st.LastAtIso)
+	if !last.Before(now.Truncate(24 * time.Hour)) {
+		t.Fatalf("expected history to end before today, last click %s", st.LastAtIso)
+	}
+}
+
+func TestApply_FollowsHourlyAndCountryWeights(t *testing.T) {
+	f := Fixture{Days: 14, DailyMean: 50, CountryWeights: map[string]float64{"US": 1}}
+	f.HourlyWeights[9] = 1
+
+	st := store.NewMemoryStore()
+	res, err := Apply(context.Background(), st, f, Options{Seed: 1, Codes: []string{"q"}, Now: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	// Mean 50/day over 14 days; a wildly different total means the sampler is off.
+	if res.Events < 550 || res.Events > 850 {
+		t.Fatalf("expected roughly 700 events, got %d", res.Events)
+	}
+	d, err := st.GetDaily(context.Background(), "q", time.Date(2026, 5, 25, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("daily: %v", err)
+	}
+	if d.Total == 0 || d.Hour09 != d.Total || d.RegionCounts["US"] != d.Total {
+		t.Fatalf("expected all clicks at 09h from US, got %+v", d)
+	}
+}
+
+func TestApply_RequiresCodes(t *testing.T) {
+	if _, err := Apply(context.Background(), store.NewMemoryStore(), Default(), Options{}); err != ErrNoCodes {
+		t.Fatalf("expected ErrNoCodes, got %v", err)
+	}
+}
+
+func TestLoadManifest(t *testing.T) {
+	ids, err := LoadManifest(strings.NewReader(`[{"id":"a","label":"A"},{"id":"b"}]`))
+	if err != nil || len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Fatalf("unexpected manifest result %v, %v", ids, err)
+	}
+}
+
+func TestApply_SkipsCodesWithClicks(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 30, 0, 0, time.UTC)
+	st := store.NewMemoryStore()
+	first, err := Apply(context.Background(), st, Default(), Options{Seed: 7, Now: now, Codes: []string{"a"}})
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	before, _ := st.GetStats(context.Background(), "a")
+
+	again, err := Apply(context.Background(), st, Default(), Options{Seed: 7, Now: now, Codes: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("re-apply: %v", err)
+	}
+	if again.Codes != 1 || again.Skipped != 1 {
+		t.Fatalf("expected only b seeded, got %+v", again)
+	}
+	if after, _ := st.GetStats(context.Background(), "a"); after.Total != before.Total {
+		t.Fatalf("a counted twice: %d then %d", before.Total, after.Total)
+	}
+
+	// b's history is the same as if it had been seeded alongside a.
+	fresh := store.NewMemoryStore()
+	both, _ := Apply(context.Background(), fresh, Default(), Options{Seed: 7, Now: now, Codes: []string{"a", "b"}})
+	if first.Events+again.Events != both.Events {
+		t.Fatalf("expected %d events in total, got %d + %d", both.Events, first.Events, again.Events)
+	}
+}
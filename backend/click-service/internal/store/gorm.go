@@ -0,0 +1,299 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// gormStore holds the queries PostgresStore and SQLiteStore share; each of
+// them opens the connection, sets up the schema and records clicks with its
+// own dialect's upsert.
+type gormStore struct {
+	db *gorm.DB
+}
+
+type clickDailyStatsRow struct {
+	QrCodeID     string    `gorm:"primaryKey;not null"`
+	Day          time.Time `gorm:"primaryKey;type:date;not null"`
+	Total        int       `gorm:"not null;default:0"`
+	RegionCounts []byte    `gorm:"column:region_counts;type:jsonb"`
+	RouteCounts  []byte    `gorm:"column:route_counts;type:jsonb"`
+	Hour00       int       `gorm:"column:hour00;not null;default:0"`
+	Hour01       int       `gorm:"column:hour01;not null;default:0"`
+	Hour02       int       `gorm:"column:hour02;not null;default:0"`
+	Hour03       int       `gorm:"column:hour03;not null;default:0"`
+	Hour04       int       `gorm:"column:hour04;not null;default:0"`
+	Hour05       int       `gorm:"column:hour05;not null;default:0"`
+	Hour06       int       `gorm:"column:hour06;not null;default:0"`
+	Hour07       int       `gorm:"column:hour07;not null;default:0"`
+	Hour08       int       `gorm:"column:hour08;not null;default:0"`
+	Hour09       int       `gorm:"column:hour09;not null;default:0"`
+	Hour10       int       `gorm:"column:hour10;not null;default:0"`
+	Hour11       int       `gorm:"column:hour11;not null;default:0"`
+	Hour12       int       `gorm:"column:hour12;not null;default:0"`
+	Hour13       int       `gorm:"column:hour13;not null;default:0"`
+	Hour14       int       `gorm:"column:hour14;not null;default:0"`
+	Hour15       int       `gorm:"column:hour15;not null;default:0"`
+	Hour16       int       `gorm:"column:hour16;not null;default:0"`
+	Hour17       int       `gorm:"column:hour17;not null;default:0"`
+	Hour18       int       `gorm:"column:hour18;not null;default:0"`
+	Hour19       int       `gorm:"column:hour19;not null;default:0"`
+	Hour20       int       `gorm:"column:hour20;not null;default:0"`
+	Hour21       int       `gorm:"column:hour21;not null;default:0"`
+	Hour22       int       `gorm:"column:hour22;not null;default:0"`
+	Hour23       int       `gorm:"column:hour23;not null;default:0"`
+	LastAt       time.Time `gorm:"not null"`
+	LastCountry  string    `gorm:"not null;default:''"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (clickDailyStatsRow) TableName() string { return "click_daily_stats" }
+
+// clickEventRow is one raw click. SQLiteStore migrates it as a plain table;
+// PostgresStore creates it partitioned by month.
+type clickEventRow struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	QrCodeID       string    `gorm:"not null;index:click_events_code_at,priority:1"`
+	ClickedAt      time.Time `gorm:"not null;index:click_events_code_at,priority:2;index:click_events_clicked_at"`
+	IP             string    `gorm:"not null;default:''"`
+	UserAgent      string    `gorm:"not null;default:''"`
+	Referer        string    `gorm:"not null;default:''"`
+	Country        string    `gorm:"not null;default:''"`
+	AcceptLanguage string    `gorm:"not null;default:''"`
+	RequestID      string    `gorm:"not null;default:''"`
+	TargetURL      string    `gorm:"not null;default:''"`
+	UserType       string    `gorm:"not null;default:''"`
+	Route          string    `gorm:"not null;default:''"`
+}
+
+func (clickEventRow) TableName() string { return "click_events" }
+
+func newClickEventRow(e ClickEvent) clickEventRow {
+	return clickEventRow{
+		QrCodeID:       e.QrCodeID,
+		ClickedAt:      eventTime(e.At),
+		IP:             e.IP,
+		UserAgent:      e.UserAgent,
+		Referer:        e.Referer,
+		Country:        e.Country,
+		AcceptLanguage: e.AcceptLang,
+		RequestID:      e.RequestID,
+		TargetURL:      e.TargetURL,
+		UserType:       e.UserType,
+		Route:          e.Route,
+	}
+}
+
+func (r clickEventRow) toEvent() ClickEvent {
+	at := r.ClickedAt.UTC()
+	return ClickEvent{
+		ID:         r.ID,
+		At:         at,
+		AtIso:      at.Format(time.RFC3339Nano),
+		IP:         r.IP,
+		UserAgent:  r.UserAgent,
+		Referer:    r.Referer,
+		Country:    r.Country,
+		RequestID:  r.RequestID,
+		QrCodeID:   r.QrCodeID,
+		TargetURL:  r.TargetURL,
+		UserType:   r.UserType,
+		AcceptLang: r.AcceptLanguage,
+		Route:      r.Route,
+	}
+}
+
+func (s *gormStore) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// queryTimeout bounds each store operation on top of the caller's context.
+const queryTimeout = 5 * time.Second
+
+// op runs fn against a session bound to ctx with queryTimeout applied. Drivers
+// don't all wrap context errors, so when the context has ended the error
+// reported is ctx.Err() and callers can match it with errors.Is.
+func (s *gormStore) op(ctx context.Context, fn func(db *gorm.DB) error) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+	err := fn(s.db.WithContext(ctx))
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (s *gormStore) ListClicks(ctx context.Context, qrCodeID string, cursor ClickCursor, limit int) ([]ClickEvent, error) {
+	var rows []clickEventRow
+	err := s.op(ctx, func(db *gorm.DB) error {
+		q := db.Where("qr_code_id = ?", qrCodeID)
+		if !cursor.IsZero() {
+			at := eventTime(cursor.At)
+			q = q.Where("clicked_at < ? OR (clicked_at = ? AND id < ?)", at, at, cursor.ID)
+		}
+		return q.Order("clicked_at DESC, id DESC").Limit(limit).Find(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]ClickEvent, len(rows))
+	for i, row := range rows {
+		events[i] = row.toEvent()
+	}
+	return events, nil
+}
+
+func (s *gormStore) GetStats(ctx context.Context, qrCodeID string) (ClickStats, error) {
+	type agg struct {
+		Total int64
+	}
+	var a agg
+	var last clickDailyStatsRow
+	err := s.op(ctx, func(db *gorm.DB) error {
+		if err := db.Model(&clickDailyStatsRow{}).
+			Select("COALESCE(SUM(total), 0) AS total").
+			Where("qr_code_id = ?", qrCodeID).
+			Scan(&a).Error; err != nil || a.Total == 0 {
+			return err
+		}
+		return db.Where("qr_code_id = ?", qrCodeID).Order("last_at desc").Limit(1).Find(&last).Error
+	})
+	if err != nil {
+		return ClickStats{}, err
+	}
+	if a.Total == 0 {
+		return ClickStats{}, ErrNotFound
+	}
+	if last.QrCodeID == "" {
+		return ClickStats{}, ErrNotFound
+	}
+
+	return ClickStats{QrCodeID: qrCodeID, Total: int(a.Total), LastAtIso: last.LastAt.UTC().Format(time.RFC3339), LastCountry: last.LastCountry}, nil
+}
+
+func (s *gormStore) GetDaily(ctx context.Context, qrCodeID string, day time.Time) (DailyClickStats, error) {
+	day = day.UTC()
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	var row clickDailyStatsRow
+	err := s.op(ctx, func(db *gorm.DB) error {
+		return db.Where("qr_code_id = ? AND day = ?", qrCodeID, day).First(&row).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return DailyClickStats{}, ErrNotFound
+		}
+		return DailyClickStats{}, err
+	}
+
+	return DailyClickStats{
+		QrCodeID:     qrCodeID,
+		DayIso:       row.Day.UTC().Format("2006-01-02"),
+		Total:        row.Total,
+		RegionCounts: decodeCounts(row.RegionCounts),
+		RouteCounts:  decodeCounts(row.RouteCounts),
+		Hour00:       row.Hour00,
+		Hour01:       row.Hour01,
+		Hour02:       row.Hour02,
+		Hour03:       row.Hour03,
+		Hour04:       row.Hour04,
+		Hour05:       row.Hour05,
+		Hour06:       row.Hour06,
+		Hour07:       row.Hour07,
+		Hour08:       row.Hour08,
+		Hour09:       row.Hour09,
+		Hour10:       row.Hour10,
+		Hour11:       row.Hour11,
+		Hour12:       row.Hour12,
+		Hour13:       row.Hour13,
+		Hour14:       row.Hour14,
+		Hour15:       row.Hour15,
+		Hour16:       row.Hour16,
+		Hour17:       row.Hour17,
+		Hour18:       row.Hour18,
+		Hour19:       row.Hour19,
+		Hour20:       row.Hour20,
+		Hour21:       row.Hour21,
+		Hour22:       row.Hour22,
+		Hour23:       row.Hour23,
+	}, nil
+}
+
+func (s *gormStore) GetDailyBatch(ctx context.Context, qrCodeID string, days []time.Time) (map[string]DailyClickStats, error) {
+	if len(days) == 0 {
+		return map[string]DailyClickStats{}, nil
+	}
+
+	// Normalize days to UTC date boundaries
+	normalizedDays := make([]time.Time, len(days))
+	for i, day := range days {
+		normalizedDays[i] = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	var rows []clickDailyStatsRow
+	err := s.op(ctx, func(db *gorm.DB) error {
+		return db.Where("qr_code_id = ? AND day IN ?", qrCodeID, normalizedDays).Find(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]DailyClickStats)
+	for _, row := range rows {
+		dayIso := row.Day.UTC().Format("2006-01-02")
+		result[dayIso] = DailyClickStats{
+			QrCodeID:     qrCodeID,
+			DayIso:       dayIso,
+			Total:        row.Total,
+			RegionCounts: decodeCounts(row.RegionCounts),
+			RouteCounts:  decodeCounts(row.RouteCounts),
+			Hour00:       row.Hour00,
+			Hour01:       row.Hour01,
+			Hour02:       row.Hour02,
+			Hour03:       row.Hour03,
+			Hour04:       row.Hour04,
+			Hour05:       row.Hour05,
+			Hour06:       row.Hour06,
+			Hour07:       row.Hour07,
+			Hour08:       row.Hour08,
+			Hour09:       row.Hour09,
+			Hour10:       row.Hour10,
+			Hour11:       row.Hour11,
+			Hour12:       row.Hour12,
+			Hour13:       row.Hour13,
+			Hour14:       row.Hour14,
+			Hour15:       row.Hour15,
+			Hour16:       row.Hour16,
+			Hour17:       row.Hour17,
+			Hour18:       row.Hour18,
+			Hour19:       row.Hour19,
+			Hour20:       row.Hour20,
+			Hour21:       row.Hour21,
+			Hour22:       row.Hour22,
+			Hour23:       row.Hour23,
+		}
+	}
+
+	return result, nil
+}
+
+// decodeCounts reads a jsonb counter column, or nil when it's empty.
+func decodeCounts(b []byte) map[string]int {
+	var counts map[string]int
+	if len(b) > 0 {
+		_ = json.Unmarshal(b, &counts)
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+	return counts
+}
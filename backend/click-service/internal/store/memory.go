@@ -1,6 +1,9 @@
 package store
 
 import (
+	"cmp"
+	"context"
+	"slices"
 	"sync"
 	"time"
 )
@@ -9,13 +12,19 @@ type MemoryStore struct {
 	mu    sync.RWMutex
 	stats map[string]ClickStats
 	daily map[string]map[string]*DailyClickStats
+	// events is each code's raw click log, oldest first.
+	events map[string][]ClickEvent
+	lastID int64
 }
 
 func NewMemoryStore() *MemoryStore {
-	return &MemoryStore{stats: map[string]ClickStats{}, daily: map[string]map[string]*DailyClickStats{}}
+	return &MemoryStore{stats: map[string]ClickStats{}, daily: map[string]map[string]*DailyClickStats{}, events: map[string][]ClickEvent{}}
 }
 
-func (s *MemoryStore) RecordClick(event ClickEvent) error {
+func (s *MemoryStore) RecordClick(ctx context.Context, event ClickEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -44,6 +53,12 @@ func (s *MemoryStore) RecordClick(event ClickEvent) error {
 		}
 		ds.RegionCounts[region]++
 	}
+	if route := event.Route; route != "" {
+		if ds.RouteCounts == nil {
+			ds.RouteCounts = map[string]int{}
+		}
+		ds.RouteCounts[route]++
+	}
 
 	st := s.stats[event.QrCodeID]
 	if st.QrCodeID == "" {
@@ -53,10 +68,18 @@ func (s *MemoryStore) RecordClick(event ClickEvent) error {
 	st.LastAtIso = event.At.UTC().Format(time.RFC3339)
 	st.LastCountry = event.Country
 	s.stats[event.QrCodeID] = st
+
+	s.lastID++
+	event.ID, event.At = s.lastID, eventTime(event.At)
+	event.AtIso = event.At.Format(time.RFC3339Nano)
+	s.events[event.QrCodeID] = append(s.events[event.QrCodeID], event)
 	return nil
 }
 
-func (s *MemoryStore) GetStats(qrCodeID string) (ClickStats, error) {
+func (s *MemoryStore) GetStats(ctx context.Context, qrCodeID string) (ClickStats, error) {
+	if err := ctx.Err(); err != nil {
+		return ClickStats{}, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -67,7 +90,10 @@ func (s *MemoryStore) GetStats(qrCodeID string) (ClickStats, error) {
 	return st, nil
 }
 
-func (s *MemoryStore) GetDaily(qrCodeID string, day time.Time) (DailyClickStats, error) {
+func (s *MemoryStore) GetDaily(ctx context.Context, qrCodeID string, day time.Time) (DailyClickStats, error) {
+	if err := ctx.Err(); err != nil {
+		return DailyClickStats{}, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -87,7 +113,10 @@ func (s *MemoryStore) GetDaily(qrCodeID string, day time.Time) (DailyClickStats,
 	return *ds, nil
 }
 
-func (s *MemoryStore) GetDailyBatch(qrCodeID string, days []time.Time) (map[string]DailyClickStats, error) {
+func (s *MemoryStore) GetDailyBatch(ctx context.Context, qrCodeID string, days []time.Time) (map[string]DailyClickStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -110,6 +139,50 @@ func (s *MemoryStore) GetDailyBatch(qrCodeID string, days []time.Time) (map[stri
 	return result, nil
 }
 
+func (s *MemoryStore) ListClicks(ctx context.Context, qrCodeID string, cursor ClickCursor, limit int) ([]ClickEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []ClickEvent
+	for _, e := range s.events[qrCodeID] {
+		if cursor.before(e) {
+			out = append(out, e)
+		}
+	}
+	// Events can be recorded out of order, so sort rather than reverse.
+	slices.SortFunc(out, func(a, b ClickEvent) int {
+		if c := b.At.Compare(a.At); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.ID, a.ID)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) PurgeClicks(ctx context.Context, cutoff time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, events := range s.events {
+		events = slices.DeleteFunc(events, func(e ClickEvent) bool { return e.At.Before(cutoff) })
+		if len(events) == 0 {
+			delete(s.events, id)
+		} else {
+			s.events[id] = events
+		}
+	}
+	return nil
+}
+
 func incrementHour(ds *DailyClickStats, hour int) {
 	switch hour {
 	case 0:
@@ -2,9 +2,9 @@ package store
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"gorm.io/driver/postgres"
@@ -13,46 +13,14 @@ import (
 )
 
 type PostgresStore struct {
-	db *gorm.DB
-}
+	gormStore
 
-type clickDailyStatsRow struct {
-	QrCodeID     string    `gorm:"primaryKey;not null"`
-	Day          time.Time `gorm:"primaryKey;type:date;not null"`
-	Total        int       `gorm:"not null;default:0"`
-	RegionCounts []byte    `gorm:"column:region_counts;type:jsonb"`
-	Hour00       int       `gorm:"column:hour00;not null;default:0"`
-	Hour01       int       `gorm:"column:hour01;not null;default:0"`
-	Hour02       int       `gorm:"column:hour02;not null;default:0"`
-	Hour03       int       `gorm:"column:hour03;not null;default:0"`
-	Hour04       int       `gorm:"column:hour04;not null;default:0"`
-	Hour05       int       `gorm:"column:hour05;not null;default:0"`
-	Hour06       int       `gorm:"column:hour06;not null;default:0"`
-	Hour07       int       `gorm:"column:hour07;not null;default:0"`
-	Hour08       int       `gorm:"column:hour08;not null;default:0"`
-	Hour09       int       `gorm:"column:hour09;not null;default:0"`
-	Hour10       int       `gorm:"column:hour10;not null;default:0"`
-	Hour11       int       `gorm:"column:hour11;not null;default:0"`
-	Hour12       int       `gorm:"column:hour12;not null;default:0"`
-	Hour13       int       `gorm:"column:hour13;not null;default:0"`
-	Hour14       int       `gorm:"column:hour14;not null;default:0"`
-	Hour15       int       `gorm:"column:hour15;not null;default:0"`
-	Hour16       int       `gorm:"column:hour16;not null;default:0"`
-	Hour17       int       `gorm:"column:hour17;not null;default:0"`
-	Hour18       int       `gorm:"column:hour18;not null;default:0"`
-	Hour19       int       `gorm:"column:hour19;not null;default:0"`
-	Hour20       int       `gorm:"column:hour20;not null;default:0"`
-	Hour21       int       `gorm:"column:hour21;not null;default:0"`
-	Hour22       int       `gorm:"column:hour22;not null;default:0"`
-	Hour23       int       `gorm:"column:hour23;not null;default:0"`
-	LastAt       time.Time `gorm:"not null"`
-	LastCountry  string    `gorm:"not null;default:''"`
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	// partitions holds the months ("2026-01") whose click_events partition
+	// is known to exist.
+	partitionsMu sync.Mutex
+	partitions   map[string]bool
 }
 
-func (clickDailyStatsRow) TableName() string { return "click_daily_stats" }
-
 func NewPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore, error) {
 	db, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
@@ -61,26 +29,121 @@ func NewPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore,
 		return nil, err
 	}
 
-	s := &PostgresStore{db: db}
+	s := &PostgresStore{gormStore: gormStore{db: db}, partitions: map[string]bool{}}
 	if err := s.ensureSchema(ctx); err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
-func (s *PostgresStore) Close() error {
-	sqlDB, err := s.db.DB()
-	if err != nil {
+// clickEventsDDL creates the raw click log partitioned by month, so the
+// retention purge drops whole partitions instead of deleting rows. The
+// partition key has to be part of the primary key.
+const clickEventsDDL = `
+CREATE TABLE IF NOT EXISTS click_events (
+	id bigserial NOT NULL,
+	qr_code_id text NOT NULL,
+	clicked_at timestamptz NOT NULL,
+	ip text NOT NULL DEFAULT '',
+	user_agent text NOT NULL DEFAULT '',
+	referer text NOT NULL DEFAULT '',
+	country text NOT NULL DEFAULT '',
+	accept_language text NOT NULL DEFAULT '',
+	request_id text NOT NULL DEFAULT '',
+	target_url text NOT NULL DEFAULT '',
+	user_type text NOT NULL DEFAULT '',
+	route text NOT NULL DEFAULT '',
+	PRIMARY KEY (clicked_at, id)
+) PARTITION BY RANGE (clicked_at);
+CREATE INDEX IF NOT EXISTS click_events_code_at ON click_events (qr_code_id, clicked_at DESC, id DESC);
+`
+
+func (s *PostgresStore) ensureSchema(ctx context.Context) error {
+	if err := s.db.WithContext(ctx).AutoMigrate(&clickDailyStatsRow{}); err != nil {
+		return err
+	}
+	if err := s.db.WithContext(ctx).Exec(clickEventsDDL).Error; err != nil {
 		return err
 	}
-	return sqlDB.Close()
+	// Create this month's and next month's partitions up front, so the
+	// first clicks of a month don't wait on DDL.
+	now := time.Now().UTC()
+	for _, t := range []time.Time{now, now.AddDate(0, 1, 0)} {
+		if err := s.ensurePartition(s.db.WithContext(ctx), t); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (s *PostgresStore) ensureSchema(ctx context.Context) error {
-	return s.db.WithContext(ctx).AutoMigrate(&clickDailyStatsRow{})
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func partitionName(month time.Time) string {
+	return fmt.Sprintf("click_events_y%04dm%02d", month.Year(), int(month.Month()))
 }
 
-func (s *PostgresStore) RecordClick(event ClickEvent) error {
+// ensurePartition creates the click_events partition holding t, once per
+// month per process. Clicks can arrive for any month (seeding writes
+// history), so partitions are made on demand rather than by a schedule.
+func (s *PostgresStore) ensurePartition(db *gorm.DB, t time.Time) error {
+	month := monthStart(t)
+	key := month.Format("2006-01")
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	if s.partitions[key] {
+		return nil
+	}
+	name := partitionName(month)
+	ddl := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s PARTITION OF click_events FOR VALUES FROM ('%s') TO ('%s')`,
+		name, month.Format(time.RFC3339), month.AddDate(0, 1, 0).Format(time.RFC3339))
+	if err := db.Exec(ddl).Error; err != nil {
+		// Another instance may have created it between our check and ours.
+		var exists bool
+		if db.Raw(`SELECT to_regclass(?) IS NOT NULL`, name).Scan(&exists).Error != nil || !exists {
+			return err
+		}
+	}
+	s.partitions[key] = true
+	return nil
+}
+
+// PurgeClicks drops the partitions that end by cutoff, then deletes what's
+// left before it from the partition cutoff falls in.
+func (s *PostgresStore) PurgeClicks(ctx context.Context, cutoff time.Time) error {
+	cutoff = eventTime(cutoff)
+	return s.op(ctx, func(db *gorm.DB) error {
+		var names []string
+		if err := db.Raw(`SELECT c.relname FROM pg_inherits i
+			JOIN pg_class c ON c.oid = i.inhrelid
+			JOIN pg_class p ON p.oid = i.inhparent
+			WHERE p.relname = 'click_events'`).Scan(&names).Error; err != nil {
+			return err
+		}
+		for _, name := range names {
+			var year, month int
+			if _, err := fmt.Sscanf(name, "click_events_y%4dm%2d", &year, &month); err != nil {
+				continue
+			}
+			start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+			if start.AddDate(0, 1, 0).After(cutoff) {
+				continue
+			}
+			s.partitionsMu.Lock()
+			err := db.Exec(fmt.Sprintf(`DROP TABLE IF EXISTS %s`, name)).Error
+			delete(s.partitions, start.Format("2006-01"))
+			s.partitionsMu.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		return db.Exec(`DELETE FROM click_events WHERE clicked_at < ?`, cutoff).Error
+	})
+}
+
+func (s *PostgresStore) RecordClick(ctx context.Context, event ClickEvent) error {
 	t := event.At.UTC()
 	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 	hour := t.Hour()
@@ -91,9 +154,13 @@ func (s *PostgresStore) RecordClick(event ClickEvent) error {
 	hourCol := fmt.Sprintf("hour%02d", hour)
 
 	// Atomic upsert: creates the per-day row on first click; increments the matching hour column per click.
+	// The route's counter is bumped the same way; its parameters are cast so
+	// ->> picks the text-key operator. The raw event goes into click_events in
+	// the same transaction.
 	sql := fmt.Sprintf(
-		`INSERT INTO click_daily_stats (qr_code_id, day, total, %s, last_at, last_country, region_counts, created_at, updated_at)
-		 VALUES (?, ?, 1, 1, ?, ?, CASE WHEN ? <> '' THEN jsonb_build_object(?, 1) ELSE '{}'::jsonb END, now(), now())
+		`INSERT INTO click_daily_stats (qr_code_id, day, total, %s, last_at, last_country, region_counts, route_counts, created_at, updated_at)
+		 VALUES (?, ?, 1, 1, ?, ?, CASE WHEN ? <> '' THEN jsonb_build_object(?, 1) ELSE '{}'::jsonb END,
+		   CASE WHEN ?::text <> '' THEN jsonb_build_object(?::text, 1) ELSE '{}'::jsonb END, now(), now())
 		 ON CONFLICT (qr_code_id, day)
 		 DO UPDATE SET
 		   total = click_daily_stats.total + 1,
@@ -112,151 +179,29 @@ func (s *PostgresStore) RecordClick(event ClickEvent) error {
 		       )
 		     ELSE click_daily_stats.region_counts
 		   END,
+		   route_counts = CASE
+		     WHEN ?::text <> '' THEN
+		       COALESCE(click_daily_stats.route_counts, '{}'::jsonb) || jsonb_build_object(
+		         ?::text,
+		         COALESCE((click_daily_stats.route_counts->>?::text)::int, 0) + 1
+		       )
+		     ELSE click_daily_stats.route_counts
+		   END,
 		   updated_at = now()`,
 		hourCol, hourCol, hourCol,
 	)
 
-	return s.db.Exec(sql, event.QrCodeID, day, t, event.Country, event.Country, event.Country).Error
-}
-
-func (s *PostgresStore) GetStats(qrCodeID string) (ClickStats, error) {
-	type agg struct {
-		Total int64
-	}
-	var a agg
-	if err := s.db.Model(&clickDailyStatsRow{}).
-		Select("COALESCE(SUM(total), 0) AS total").
-		Where("qr_code_id = ?", qrCodeID).
-		Scan(&a).Error; err != nil {
-		return ClickStats{}, err
-	}
-	if a.Total == 0 {
-		return ClickStats{}, ErrNotFound
-	}
-
-	var last clickDailyStatsRow
-	if err := s.db.Where("qr_code_id = ?", qrCodeID).Order("last_at desc").Limit(1).Find(&last).Error; err != nil {
-		return ClickStats{}, err
-	}
-	if last.QrCodeID == "" {
-		return ClickStats{}, ErrNotFound
-	}
-
-	return ClickStats{QrCodeID: qrCodeID, Total: int(a.Total), LastAtIso: last.LastAt.UTC().Format(time.RFC3339), LastCountry: last.LastCountry}, nil
-}
-
-func (s *PostgresStore) GetDaily(qrCodeID string, day time.Time) (DailyClickStats, error) {
-	day = day.UTC()
-	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
-
-	var row clickDailyStatsRow
-	err := s.db.Where("qr_code_id = ? AND day = ?", qrCodeID, day).First(&row).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return DailyClickStats{}, ErrNotFound
-		}
-		return DailyClickStats{}, err
-	}
-
-	var regionCounts map[string]int
-	if len(row.RegionCounts) > 0 {
-		_ = json.Unmarshal(row.RegionCounts, &regionCounts)
-		if len(regionCounts) == 0 {
-			regionCounts = nil
+	row := newClickEventRow(event)
+	return s.op(ctx, func(db *gorm.DB) error {
+		if err := s.ensurePartition(db, row.ClickedAt); err != nil {
+			return err
 		}
-	}
-
-	return DailyClickStats{
-		QrCodeID:     qrCodeID,
-		DayIso:       row.Day.UTC().Format("2006-01-02"),
-		Total:        row.Total,
-		RegionCounts: regionCounts,
-		Hour00:       row.Hour00,
-		Hour01:       row.Hour01,
-		Hour02:       row.Hour02,
-		Hour03:       row.Hour03,
-		Hour04:       row.Hour04,
-		Hour05:       row.Hour05,
-		Hour06:       row.Hour06,
-		Hour07:       row.Hour07,
-		Hour08:       row.Hour08,
-		Hour09:       row.Hour09,
-		Hour10:       row.Hour10,
-		Hour11:       row.Hour11,
-		Hour12:       row.Hour12,
-		Hour13:       row.Hour13,
-		Hour14:       row.Hour14,
-		Hour15:       row.Hour15,
-		Hour16:       row.Hour16,
-		Hour17:       row.Hour17,
-		Hour18:       row.Hour18,
-		Hour19:       row.Hour19,
-		Hour20:       row.Hour20,
-		Hour21:       row.Hour21,
-		Hour22:       row.Hour22,
-		Hour23:       row.Hour23,
-	}, nil
-}
-
-func (s *PostgresStore) GetDailyBatch(qrCodeID string, days []time.Time) (map[string]DailyClickStats, error) {
-	if len(days) == 0 {
-		return map[string]DailyClickStats{}, nil
-	}
-
-	// Normalize days to UTC date boundaries
-	normalizedDays := make([]time.Time, len(days))
-	for i, day := range days {
-		normalizedDays[i] = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
-	}
-
-	var rows []clickDailyStatsRow
-	err := s.db.Where("qr_code_id = ? AND day IN ?", qrCodeID, normalizedDays).Find(&rows).Error
-	if err != nil {
-		return nil, err
-	}
-
-	result := make(map[string]DailyClickStats)
-	for _, row := range rows {
-		var regionCounts map[string]int
-		if len(row.RegionCounts) > 0 {
-			_ = json.Unmarshal(row.RegionCounts, &regionCounts)
-			if len(regionCounts) == 0 {
-				regionCounts = nil
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(sql, event.QrCodeID, day, t, event.Country, event.Country, event.Country,
+				event.Route, event.Route, event.Route, event.Route, event.Route).Error; err != nil {
+				return err
 			}
-		}
-
-		dayIso := row.Day.UTC().Format("2006-01-02")
-		result[dayIso] = DailyClickStats{
-			QrCodeID:     qrCodeID,
-			DayIso:       dayIso,
-			Total:        row.Total,
-			RegionCounts: regionCounts,
-			Hour00:       row.Hour00,
-			Hour01:       row.Hour01,
-			Hour02:       row.Hour02,
-			Hour03:       row.Hour03,
-			Hour04:       row.Hour04,
-			Hour05:       row.Hour05,
-			Hour06:       row.Hour06,
-			Hour07:       row.Hour07,
-			Hour08:       row.Hour08,
-			Hour09:       row.Hour09,
-			Hour10:       row.Hour10,
-			Hour11:       row.Hour11,
-			Hour12:       row.Hour12,
-			Hour13:       row.Hour13,
-			Hour14:       row.Hour14,
-			Hour15:       row.Hour15,
-			Hour16:       row.Hour16,
-			Hour17:       row.Hour17,
-			Hour18:       row.Hour18,
-			Hour19:       row.Hour19,
-			Hour20:       row.Hour20,
-			Hour21:       row.Hour21,
-			Hour22:       row.Hour22,
-			Hour23:       row.Hour23,
-		}
-	}
-
-	return result, nil
+			return tx.Create(&row).Error
+		})
+	})
 }
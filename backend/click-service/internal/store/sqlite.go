@@ -0,0 +1,176 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SQLiteStore keeps click stats in one SQLite file, for single-node installs
+// that want them to survive a restart without running Postgres. It uses a
+// pure-Go driver, so the binary still builds without cgo.
+type SQLiteStore struct {
+	gormStore
+}
+
+// DBStore is a Store backed by a database connection.
+type DBStore interface {
+	Store
+	Close() error
+}
+
+// Open connects to the database databaseURL names: SQLite for sqlite:// URLs,
+// Postgres for anything else.
+func Open(ctx context.Context, databaseURL string) (DBStore, error) {
+	if IsSQLiteURL(databaseURL) {
+		s, err := NewSQLiteStore(ctx, databaseURL)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	s, err := NewPostgresStore(ctx, databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// IsSQLiteURL reports whether databaseURL selects SQLiteStore rather than
+// PostgresStore.
+func IsSQLiteURL(databaseURL string) bool {
+	return strings.HasPrefix(databaseURL, "sqlite:")
+}
+
+// BackendName names the backend Open picks for databaseURL, for logs.
+func BackendName(databaseURL string) string {
+	if IsSQLiteURL(databaseURL) {
+		return "sqlite"
+	}
+	return "postgres"
+}
+
+// NewSQLiteStore opens (creating if needed) the database file named by
+// databaseURL: sqlite:///data/clicks.db for an absolute path, or
+// sqlite://clicks.db for one relative to the working directory.
+func NewSQLiteStore(ctx context.Context, databaseURL string) (*SQLiteStore, error) {
+	dsn, err := sqliteDSN(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+
+	s := &SQLiteStore{gormStore{db: db}}
+	if err := s.db.WithContext(ctx).AutoMigrate(&clickDailyStatsRow{}, &clickEventRow{}); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// sqliteDSN turns a sqlite:// URL into the driver's file name. WAL lets
+// readers run alongside the single writer; busy_timeout makes a writer wait
+// for the lock instead of failing; and immediate transactions take the write
+// lock up front, so two transactions can't deadlock upgrading from a read.
+func sqliteDSN(databaseURL string) (string, error) {
+	path, ok := strings.CutPrefix(databaseURL, "sqlite://")
+	if !ok || path == "" || strings.ContainsAny(path, "?#") {
+		return "", errors.New("sqlite URL must look like sqlite:///path/to/file.db")
+	}
+	q := url.Values{}
+	q.Add("_pragma", "journal_mode(WAL)")
+	q.Add("_pragma", "busy_timeout(5000)")
+	q.Add("_pragma", "foreign_keys(1)")
+	q.Set("_txlock", "immediate")
+	return "file:" + path + "?" + q.Encode(), nil
+}
+
+// RecordClick is PostgresStore.RecordClick's upsert in SQLite's dialect: one
+// statement, so concurrent clicks on the same code and day never lose a count.
+// Region and route counts are merged with json_patch and read back with
+// json_each, which take the key as a value rather than as part of a JSON path.
+// The raw event is logged in the same transaction.
+func (s *SQLiteStore) RecordClick(ctx context.Context, event ClickEvent) error {
+	t := event.At.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	hour := t.Hour()
+	if hour < 0 || hour > 23 {
+		return errors.New("invalid hour")
+	}
+
+	hourCol := fmt.Sprintf("hour%02d", hour)
+
+	sql := fmt.Sprintf(
+		`INSERT INTO click_daily_stats (qr_code_id, day, total, %s, last_at, last_country, region_counts, route_counts, created_at, updated_at)
+		 VALUES (?, ?, 1, 1, ?, ?, CASE WHEN ? <> '' THEN json_object(?, 1) ELSE '{}' END,
+		   CASE WHEN ? <> '' THEN json_object(?, 1) ELSE '{}' END, ?, ?)
+		 ON CONFLICT (qr_code_id, day)
+		 DO UPDATE SET
+		   total = click_daily_stats.total + 1,
+		   %s = click_daily_stats.%s + 1,
+		   last_at = MAX(click_daily_stats.last_at, excluded.last_at),
+		   last_country = CASE WHEN excluded.last_at >= click_daily_stats.last_at THEN excluded.last_country ELSE click_daily_stats.last_country END,
+		   region_counts = CASE
+		     WHEN excluded.last_country <> '' THEN
+		       json_patch(
+		         COALESCE(click_daily_stats.region_counts, '{}'),
+		         json_object(
+		           excluded.last_country,
+		           COALESCE((SELECT value FROM json_each(COALESCE(click_daily_stats.region_counts, '{}')) WHERE key = excluded.last_country), 0) + 1
+		         )
+		       )
+		     ELSE click_daily_stats.region_counts
+		   END,
+		   route_counts = CASE
+		     WHEN ? <> '' THEN
+		       json_patch(
+		         COALESCE(click_daily_stats.route_counts, '{}'),
+		         json_object(
+		           ?,
+		           COALESCE((SELECT value FROM json_each(COALESCE(click_daily_stats.route_counts, '{}')) WHERE key = ?), 0) + 1
+		         )
+		       )
+		     ELSE click_daily_stats.route_counts
+		   END,
+		   updated_at = excluded.updated_at`,
+		hourCol, hourCol, hourCol,
+	)
+
+	now := time.Now().UTC()
+	row := newClickEventRow(event)
+	return s.op(ctx, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(sql, event.QrCodeID, day, t, event.Country, event.Country, event.Country,
+				event.Route, event.Route, now, now, event.Route, event.Route, event.Route).Error; err != nil {
+				return err
+			}
+			return tx.Create(&row).Error
+		})
+	})
+}
+
+func (s *SQLiteStore) PurgeClicks(ctx context.Context, cutoff time.Time) error {
+	return s.op(ctx, func(db *gorm.DB) error {
+		return db.Where("clicked_at < ?", eventTime(cutoff)).Delete(&clickEventRow{}).Error
+	})
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestSQLiteStore(t *testing.T, path string) *SQLiteStore {
+	t.Helper()
+	s, err := NewSQLiteStore(context.Background(), "sqlite://"+path)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestSQLiteStore(t *testing.T) {
+	runStoreSuite(t, func(t *testing.T) Store {
+		return newTestSQLiteStore(t, filepath.Join(t.TempDir(), "clicks.db"))
+	})
+}
+
+func TestSQLiteStore_OutOfOrderClickKeepsLatest(t *testing.T) {
+	s := newTestSQLiteStore(t, filepath.Join(t.TempDir(), "clicks.db"))
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	_ = s.RecordClick(context.Background(), ClickEvent{QrCodeID: "abc", At: day.Add(15 * time.Hour), Country: "US"})
+	_ = s.RecordClick(context.Background(), ClickEvent{QrCodeID: "abc", At: day.Add(9 * time.Hour), Country: "FR"})
+
+	st, err := s.GetStats(context.Background(), "abc")
+	if err != nil || st.Total != 2 || st.LastCountry != "US" || st.LastAtIso != "2026-03-02T15:00:00Z" {
+		t.Fatalf("expected the later click to stay last, got %+v %v", st, err)
+	}
+	var mode string
+	if err := s.db.Raw("PRAGMA journal_mode").Scan(&mode).Error; err != nil || mode != "wal" {
+		t.Fatalf("expected WAL mode, got %q %v", mode, err)
+	}
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"context"
 	"errors"
 	"time"
 )
@@ -8,6 +9,8 @@ import (
 var ErrNotFound = errors.New("not found")
 
 type ClickEvent struct {
+	// ID orders events recorded at the same instant; the store assigns it.
+	ID         int64     `json:"id,omitempty"`
 	At         time.Time `json:"-"`
 	AtIso      string    `json:"atIso"`
 	IP         string    `json:"ip"`
@@ -19,8 +22,19 @@ type ClickEvent struct {
 	TargetURL  string    `json:"targetUrl"`
 	UserType   string    `json:"userType,omitempty"`
 	AcceptLang string    `json:"acceptLanguage,omitempty"`
+	// Route records which branch of an app-link destination was taken
+	// (see the Route* constants); empty for plain URL destinations.
+	Route string `json:"route,omitempty"`
 }
 
+const (
+	RouteWeb          = "web"
+	RouteIOSApp       = "ios_app"
+	RouteIOSStore     = "ios_store"
+	RouteAndroidApp   = "android_app"
+	RouteAndroidStore = "android_store"
+)
+
 type ClickStats struct {
 	QrCodeID    string `json:"qrCodeId"`
 	Total       int    `json:"total"`
@@ -33,35 +47,70 @@ type DailyClickStats struct {
 	DayIso       string         `json:"dayIso"`
 	Total        int            `json:"total"`
 	RegionCounts map[string]int `json:"regionCounts,omitempty"`
-	Hour00       int            `json:"hour00"`
-	Hour01       int            `json:"hour01"`
-	Hour02       int            `json:"hour02"`
-	Hour03       int            `json:"hour03"`
-	Hour04       int            `json:"hour04"`
-	Hour05       int            `json:"hour05"`
-	Hour06       int            `json:"hour06"`
-	Hour07       int            `json:"hour07"`
-	Hour08       int            `json:"hour08"`
-	Hour09       int            `json:"hour09"`
-	Hour10       int            `json:"hour10"`
-	Hour11       int            `json:"hour11"`
-	Hour12       int            `json:"hour12"`
-	Hour13       int            `json:"hour13"`
-	Hour14       int            `json:"hour14"`
-	Hour15       int            `json:"hour15"`
-	Hour16       int            `json:"hour16"`
-	Hour17       int            `json:"hour17"`
-	Hour18       int            `json:"hour18"`
-	Hour19       int            `json:"hour19"`
-	Hour20       int            `json:"hour20"`
-	Hour21       int            `json:"hour21"`
-	Hour22       int            `json:"hour22"`
-	Hour23       int            `json:"hour23"`
+	// RouteCounts counts app-link scans by the Route* branch taken; plain
+	// URL scans aren't counted here.
+	RouteCounts map[string]int `json:"routeCounts,omitempty"`
+	Hour00      int            `json:"hour00"`
+	Hour01      int            `json:"hour01"`
+	Hour02      int            `json:"hour02"`
+	Hour03      int            `json:"hour03"`
+	Hour04      int            `json:"hour04"`
+	Hour05      int            `json:"hour05"`
+	Hour06      int            `json:"hour06"`
+	Hour07      int            `json:"hour07"`
+	Hour08      int            `json:"hour08"`
+	Hour09      int            `json:"hour09"`
+	Hour10      int            `json:"hour10"`
+	Hour11      int            `json:"hour11"`
+	Hour12      int            `json:"hour12"`
+	Hour13      int            `json:"hour13"`
+	Hour14      int            `json:"hour14"`
+	Hour15      int            `json:"hour15"`
+	Hour16      int            `json:"hour16"`
+	Hour17      int            `json:"hour17"`
+	Hour18      int            `json:"hour18"`
+	Hour19      int            `json:"hour19"`
+	Hour20      int            `json:"hour20"`
+	Hour21      int            `json:"hour21"`
+	Hour22      int            `json:"hour22"`
+	Hour23      int            `json:"hour23"`
+}
+
+// ClickCursor is a position in a code's click log, which is read newest
+// first: the At and ID of the last event seen. The zero value starts at the
+// newest event.
+type ClickCursor struct {
+	At time.Time
+	ID int64
+}
+
+// IsZero reports whether c is the start of the log.
+func (c ClickCursor) IsZero() bool {
+	return c.At.IsZero() && c.ID == 0
+}
+
+// before reports whether e comes after c in newest-first order.
+func (c ClickCursor) before(e ClickEvent) bool {
+	return c.IsZero() || e.At.Before(c.At) || e.At.Equal(c.At) && e.ID < c.ID
 }
 
+// Store methods stop when ctx ends and then return ctx.Err(), so callers can
+// tell a timeout or cancellation apart from a failed query with errors.Is.
 type Store interface {
-	RecordClick(event ClickEvent) error
-	GetStats(qrCodeID string) (ClickStats, error)
-	GetDaily(qrCodeID string, day time.Time) (DailyClickStats, error)
-	GetDailyBatch(qrCodeID string, days []time.Time) (map[string]DailyClickStats, error)
+	RecordClick(ctx context.Context, event ClickEvent) error
+	GetStats(ctx context.Context, qrCodeID string) (ClickStats, error)
+	GetDaily(ctx context.Context, qrCodeID string, day time.Time) (DailyClickStats, error)
+	GetDailyBatch(ctx context.Context, qrCodeID string, days []time.Time) (map[string]DailyClickStats, error)
+	// ListClicks returns up to limit of qrCodeID's raw events after cursor,
+	// newest first.
+	ListClicks(ctx context.Context, qrCodeID string, cursor ClickCursor, limit int) ([]ClickEvent, error)
+	// PurgeClicks deletes raw events recorded before cutoff. Daily stats are
+	// kept.
+	PurgeClicks(ctx context.Context, cutoff time.Time) error
+}
+
+// eventTime is the instant an event is logged at: UTC, to the microsecond
+// Postgres keeps, so cursors compare the same in every store.
+func eventTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
 }
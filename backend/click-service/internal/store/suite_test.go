@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// runStoreSuite checks the behavior every Store implementation must share.
+// newStore returns an empty store for each subtest.
+func runStoreSuite(t *testing.T, newStore func(t *testing.T) Store) {
+	t.Run("RecordAndStats", func(t *testing.T) { testRecordAndStats(t, newStore(t)) })
+	t.Run("DailyBreakdown", func(t *testing.T) { testDailyBreakdown(t, newStore(t)) })
+	t.Run("ConcurrentClicksAllCount", func(t *testing.T) { testConcurrentClicks(t, newStore(t)) })
+	t.Run("EndedContext", func(t *testing.T) { testEndedContext(t, newStore(t)) })
+	t.Run("ClickLog", func(t *testing.T) { testClickLog(t, newStore(t)) })
+}
+
+func testRecordAndStats(t *testing.T, s Store) {
+	if _, err := s.GetStats(context.Background(), "abc"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	e := ClickEvent{QrCodeID: "abc", At: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), Country: "US"}
+	if err := s.RecordClick(context.Background(), e); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	st, err := s.GetStats(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	if st.Total != 1 {
+		t.Fatalf("expected total=1, got %d", st.Total)
+	}
+	if st.LastCountry != "US" {
+		t.Fatalf("expected lastCountry=US, got %q", st.LastCountry)
+	}
+	if st.LastAtIso != "2026-01-02T00:00:00Z" {
+		t.Fatalf("expected lastAtIso, got %q", st.LastAtIso)
+	}
+
+	// A later click on another day moves the last click along.
+	if err := s.RecordClick(context.Background(), ClickEvent{QrCodeID: "abc", At: time.Date(2026, 1, 3, 9, 30, 0, 0, time.UTC), Country: "DE"}); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	if st, _ = s.GetStats(context.Background(), "abc"); st.Total != 2 || st.LastCountry != "DE" || st.LastAtIso != "2026-01-03T09:30:00Z" {
+		t.Fatalf("unexpected stats after second click: %+v", st)
+	}
+}
+
+func testDailyBreakdown(t *testing.T, s Store) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		hour           int
+		country, route string
+	}{{9, "US", RouteIOSApp}, {9, "US", RouteIOSApp}, {14, "FR", RouteAndroidStore}, {23, "", ""}, {14, `we"ird`, RouteWeb}} {
+		if err := s.RecordClick(context.Background(), ClickEvent{QrCodeID: "abc", At: day.Add(time.Duration(c.hour) * time.Hour), Country: c.country, Route: c.route}); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+	if err := s.RecordClick(context.Background(), ClickEvent{QrCodeID: "abc", At: day.AddDate(0, 0, 1)}); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	// Any time on the day finds it.
+	d, err := s.GetDaily(context.Background(), "abc", day.Add(17*time.Hour))
+	if err != nil {
+		t.Fatalf("daily: %v", err)
+	}
+	if d.DayIso != "2026-03-02" || d.Total != 5 || d.Hour09 != 2 || d.Hour14 != 2 || d.Hour23 != 1 || d.Hour00 != 0 {
+		t.Fatalf("unexpected daily %+v", d)
+	}
+	if len(d.RegionCounts) != 3 || d.RegionCounts["US"] != 2 || d.RegionCounts["FR"] != 1 || d.RegionCounts[`we"ird`] != 1 {
+		t.Fatalf("unexpected region counts %v", d.RegionCounts)
+	}
+	if len(d.RouteCounts) != 3 || d.RouteCounts[RouteIOSApp] != 2 || d.RouteCounts[RouteAndroidStore] != 1 || d.RouteCounts[RouteWeb] != 1 {
+		t.Fatalf("unexpected route counts %v", d.RouteCounts)
+	}
+	if _, err := s.GetDaily(context.Background(), "abc", day.AddDate(0, 0, 5)); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("empty day: expected not found, got %v", err)
+	}
+
+	batch, err := s.GetDailyBatch(context.Background(), "abc", []time.Time{day, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2)})
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if len(batch) != 2 || batch["2026-03-02"].Total != 5 || batch["2026-03-03"].Total != 1 || batch["2026-03-03"].RegionCounts != nil || batch["2026-03-02"].RouteCounts[RouteIOSApp] != 2 || batch["2026-03-03"].RouteCounts != nil {
+		t.Fatalf("unexpected batch %+v", batch)
+	}
+}
+
+func testConcurrentClicks(t *testing.T, s Store) {
+	const workers, each = 8, 25
+	at := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*each)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < each; i++ {
+				errs <- s.RecordClick(context.Background(), ClickEvent{QrCodeID: "abc", At: at, Country: "US"})
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+	d, err := s.GetDaily(context.Background(), "abc", at)
+	if err != nil || d.Total != workers*each || d.Hour10 != workers*each || d.RegionCounts["US"] != workers*each {
+		t.Fatalf("expected %d clicks, got %+v %v", workers*each, d, err)
+	}
+}
+
+func testEndedContext(t *testing.T, s Store) {
+	e := ClickEvent{QrCodeID: "abc", At: time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)}
+	if err := s.RecordClick(context.Background(), e); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.RecordClick(ctx, e); !errors.Is(err, context.Canceled) {
+		t.Fatalf("record: expected context.Canceled, got %v", err)
+	}
+	if _, err := s.GetStats(ctx, "abc"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("stats: expected context.Canceled, got %v", err)
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, err := s.GetDaily(expired, "abc", e.At); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("daily: expected context.DeadlineExceeded, got %v", err)
+	}
+	if _, err := s.GetDailyBatch(expired, "abc", []time.Time{e.At}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("daily batch: expected context.DeadlineExceeded, got %v", err)
+	}
+
+	// The cancelled click wasn't counted.
+	if st, err := s.GetStats(context.Background(), "abc"); err != nil || st.Total != 1 {
+		t.Fatalf("expected 1 click, got %+v %v", st, err)
+	}
+}
+
+func testClickLog(t *testing.T, s Store) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	full := ClickEvent{
+		QrCodeID: "abc", At: day.Add(9*time.Hour + 123456789*time.Nanosecond), IP: "203.0.113.7", UserAgent: "Mozilla/5.0",
+		Referer: "https://example.com/", Country: "US", RequestID: "req-1", AcceptLang: "en-US", TargetURL: "https://example.com/menu", Route: RouteWeb,
+	}
+	// Recorded out of order, two at the same instant, and one for another
+	// code, in an earlier month.
+	for _, e := range []ClickEvent{
+		full,
+		{QrCodeID: "abc", At: day.Add(12 * time.Hour)},
+		{QrCodeID: "abc", At: day.AddDate(0, -1, 0)},
+		{QrCodeID: "abc", At: day.Add(12 * time.Hour)},
+		{QrCodeID: "other", At: day},
+	} {
+		if err := s.RecordClick(context.Background(), e); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+
+	page, err := s.ListClicks(context.Background(), "abc", ClickCursor{}, 2)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(page) != 2 || !page[0].At.Equal(day.Add(12*time.Hour)) || !page[1].At.Equal(page[0].At) || page[0].ID <= page[1].ID {
+		t.Fatalf("expected the two newest, later ID first, got %+v", page)
+	}
+	last := page[1]
+	rest, err := s.ListClicks(context.Background(), "abc", ClickCursor{At: last.At, ID: last.ID}, 10)
+	if err != nil || len(rest) != 2 {
+		t.Fatalf("expected two more, got %+v %v", rest, err)
+	}
+	got := rest[0]
+	if !got.At.Equal(full.At.Truncate(time.Microsecond)) || got.AtIso != "2026-03-02T09:00:00.123456Z" || got.IP != full.IP || got.UserAgent != full.UserAgent ||
+		got.Referer != full.Referer || got.Country != "US" || got.RequestID != "req-1" || got.AcceptLang != "en-US" || got.TargetURL != full.TargetURL || got.Route != RouteWeb {
+		t.Fatalf("expected every field kept, got %+v", got)
+	}
+	if !rest[1].At.Equal(day.AddDate(0, -1, 0)) {
+		t.Fatalf("expected the oldest last, got %+v", rest[1])
+	}
+
+	// Purging drops old events but not the daily stats built from them.
+	if err := s.PurgeClicks(context.Background(), day); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	all, _ := s.ListClicks(context.Background(), "abc", ClickCursor{}, 10)
+	if len(all) != 3 {
+		t.Fatalf("expected the month-old event purged, got %+v", all)
+	}
+	if other, _ := s.ListClicks(context.Background(), "other", ClickCursor{}, 10); len(other) != 1 {
+		t.Fatalf("expected an event at the cutoff kept, got %+v", other)
+	}
+	if st, err := s.GetStats(context.Background(), "abc"); err != nil || st.Total != 4 {
+		t.Fatalf("expected stats to keep 4 clicks, got %+v %v", st, err)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"qr-service/internal/seed"
+	"qr-service/internal/store"
+)
+
+// manifestEntry is what click-service's seeder reads to attribute clicks.
+type manifestEntry struct {
+	ID      string `json:"id"`
+	OwnerID string `json:"ownerId,omitempty"`
+	Label   string `json:"label"`
+}
+
+func main() {
+	fixturePath := flag.String("fixture", "default", `fixture file, or "default" for the bundled fixture`)
+	userID := flag.String("user", "sample-user", "owner ID for created codes")
+	seedValue := flag.Int64("seed", 1, "random seed; the same seed yields the same IDs (and, with -now, dates)")
+	nowValue := flag.String("now", "", "date (YYYY-MM-DD) or RFC 3339 time creation dates count back from; empty uses the current time")
+	manifestPath := flag.String("manifest", "", "write the fixture's codes, created or already present, as JSON to this file")
+	databaseURL := flag.String("database-url", os.Getenv("DATABASE_URL"), "postgres or sqlite:// URL; empty does a dry run against memory")
+	skipSettings := flag.Bool("skip-settings", false, "do not write the fixture's settings")
+	flag.Parse()
+
+	now, err := seed.ParseNow(strings.TrimSpace(*nowValue))
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	fixture, err := seed.LoadFile(*fixturePath)
+	if err != nil {
+		log.Fatalf("load fixture: %v", err)
+	}
+	if *skipSettings {
+		fixture = fixture.WithoutSettings()
+	}
+
+	var st store.Store
+	if dbURL := strings.TrimSpace(*databaseURL); dbURL != "" {
+		db, err := store.Open(context.Background(), dbURL)
+		if err != nil {
+			log.Fatalf("%s init failed: %v", store.BackendName(dbURL), err)
+		}
+		defer db.Close()
+		st = db
+	} else {
+		log.Printf("DATABASE_URL not set; seeding in-memory store (dry run)")
+		st = store.NewMemoryStore()
+	}
+
+	result, err := seed.Apply(context.Background(), st, fixture, seed.Options{OwnerID: *userID, Seed: *seedValue, Now: now})
+	if err != nil {
+		log.Fatalf("seed failed: %v", err)
+	}
+	for _, q := range result.Created {
+		fmt.Printf("Created: %s (ID: %s)\n", q.Label, q.ID)
+	}
+	fmt.Printf("\nCreated %d QR codes for user %s (%d already present)\n", len(result.Created), *userID, len(result.Skipped))
+
+	if *manifestPath != "" {
+		// Codes from an earlier run are listed too, so re-running still
+		// hands click-service every code.
+		entries := make([]manifestEntry, 0, len(result.Created)+len(result.Skipped))
+		for _, q := range slices.Concat(result.Created, result.Skipped) {
+			entries = append(entries, manifestEntry{ID: q.ID, OwnerID: q.OwnerID, Label: q.Label})
+		}
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			log.Fatalf("encode manifest: %v", err)
+		}
+		if err := os.WriteFile(*manifestPath, append(data, '\n'), 0o644); err != nil {
+			log.Fatalf("write manifest: %v", err)
+		}
+	}
+}
@@ -3,16 +3,28 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/apitoken"
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+	"google.golang.org/grpc"
+
+	"qr-service/internal/clickcache"
+	"qr-service/internal/events"
+	"qr-service/internal/grpcapi"
 	"qr-service/internal/httpapi"
 	"qr-service/internal/middleware"
+	"qr-service/internal/rendercache"
+	"qr-service/internal/seed"
 	"qr-service/internal/store"
+	"qr-service/internal/userplans"
 )
 
 func main() {
@@ -20,30 +32,105 @@ func main() {
 	allowedOrigins := splitCSV(envOr("CORS_ALLOW_ORIGINS", "http://localhost:5173"))
 	databaseURL := strings.TrimSpace(os.Getenv("DATABASE_URL"))
 	adminKey := envOr("ADMIN_API_KEY", "")
+	clickBaseURL := envOr("CLICK_BASE_URL", "https://qr-dragonfly.com")
+	// GRPC_PORT enables the internal API used by click-service; keep it off
+	// the public internet.
+	grpcPort := envOr("GRPC_PORT", "")
 
 	ctx := context.Background()
 
 	var st store.Store
 	var closeStore func()
 	if databaseURL != "" {
-		pg, err := store.NewPostgresStore(ctx, databaseURL)
+		db, err := store.Open(ctx, databaseURL)
 		if err != nil {
-			log.Fatalf("postgres init failed: %v", err)
+			log.Fatalf("%s init failed: %v", store.BackendName(databaseURL), err)
 		}
-		st = pg
-		closeStore = func() { _ = pg.Close() }
-		log.Printf("qr-service using postgres storage")
+		st = db
+		closeStore = func() { _ = db.Close() }
+		log.Printf("qr-service using %s storage", store.BackendName(databaseURL))
 	} else {
 		st = store.NewMemoryStore()
 		closeStore = func() {}
 		log.Printf("qr-service using in-memory storage (set DATABASE_URL to persist)")
 	}
 
-	router := httpapi.NewRouter(httpapi.Server{Store: st, AdminAPIKey: adminKey})
+	// SEED_FIXTURE ("default" or a file path) populates the store at startup,
+	// mostly so the in-memory backend has something to show.
+	if fixturePath := envOr("SEED_FIXTURE", ""); fixturePath != "" {
+		fixture, err := seed.LoadFile(fixturePath)
+		if err != nil {
+			log.Fatalf("seed fixture: %v", err)
+		}
+		seedValue, _ := strconv.ParseInt(envOr("SEED", "1"), 10, 64)
+		now, err := seed.ParseNow(envOr("SEED_NOW", ""))
+		if err != nil {
+			log.Fatalf("SEED_NOW: %v", err)
+		}
+		result, err := seed.Apply(context.Background(), st, fixture, seed.Options{OwnerID: envOr("SEED_USER_ID", "sample-user"), Seed: seedValue, Now: now})
+		if err != nil {
+			log.Fatalf("seed failed: %v", err)
+		}
+		log.Printf("seeded %d QR codes (%d already present)", len(result.Created), len(result.Skipped))
+	}
+
+	apiServer := httpapi.Server{Store: st, AdminAPIKey: adminKey, ClickBaseURL: clickBaseURL}
+	// Workspace roles, plans, API tokens and login sessions live in user-service;
+	// without it every caller is anonymous, codes in a workspace are only
+	// reachable with the admin key and Bearer tokens are refused.
+	var tokens, sessions apitoken.Verifier
+	if userServiceURL := envOr("USER_SERVICE_BASE_URL", ""); userServiceURL != "" {
+		userServiceKey := envOr("USER_SERVICE_ADMIN_KEY", "")
+		apiServer.Workspaces = workspace.NewClient(userServiceURL, userServiceKey)
+		apiServer.Plans = userplans.NewClient(userServiceURL, userServiceKey)
+		tokens = apitoken.NewClient(userServiceURL, userServiceKey)
+		sessions = apitoken.NewSessionClient(userServiceURL)
+	}
+	// click-service caches redirects; tell it when codes change so scans don't
+	// follow a stale destination until its TTL runs out.
+	if clickServiceURL := envOr("CLICK_SERVICE_BASE_URL", ""); clickServiceURL != "" {
+		apiServer.RedirectCache = clickcache.NewClient(clickServiceURL, envOr("CLICK_SERVICE_ADMIN_KEY", ""))
+	}
+	// Postgres announces committed change events with NOTIFY, so waiting
+	// /api/events requests answer as soon as any instance writes one. The
+	// other backends are polled.
+	listenCtx, stopListening := context.WithCancel(ctx)
+	defer stopListening()
+	if databaseURL != "" && !store.IsSQLiteURL(databaseURL) {
+		apiServer.EventHub = &events.Hub{}
+		go store.ListenForEvents(listenCtx, databaseURL, apiServer.EventHub.Notify)
+	}
+	// The change feed keeps events for EVENT_RETENTION_DAYS (0 keeps them
+	// forever); a subscriber away longer resumes at the oldest one left.
+	if days, err := strconv.Atoi(envOr("EVENT_RETENTION_DAYS", "7")); err != nil || days < 0 {
+		log.Fatalf("EVENT_RETENTION_DAYS must be a whole number of days, got %q", os.Getenv("EVENT_RETENTION_DAYS"))
+	} else if days > 0 {
+		go purgeEvents(listenCtx, st, time.Duration(days)*24*time.Hour)
+		log.Printf("qr-service keeping change events for %d days", days)
+	}
+	// RENDER_CACHE_DIR keeps rendered images on disk, up to
+	// RENDER_CACHE_MAX_MB, so popular codes aren't drawn on every request.
+	if renderCacheDir := envOr("RENDER_CACHE_DIR", ""); renderCacheDir != "" {
+		maxMB, err := strconv.ParseInt(envOr("RENDER_CACHE_MAX_MB", "256"), 10, 64)
+		if err != nil || maxMB <= 0 {
+			log.Fatalf("RENDER_CACHE_MAX_MB must be a positive number of megabytes")
+		}
+		cache, err := rendercache.Open(renderCacheDir, maxMB<<20)
+		if err != nil {
+			log.Fatalf("render cache init failed: %v", err)
+		}
+		apiServer.RenderCache = cache
+		log.Printf("qr-service caching rendered images in %s (up to %d MB)", renderCacheDir, maxMB)
+	}
+	router := httpapi.NewRouter(apiServer)
 
 	// Apply middleware layers (order matters!)
 	var handler http.Handler = router
 
+	// 0. API tokens (Authorization: Bearer) and login sessions become the
+	// caller's identity; X-User-Id and X-User-Type from clients are dropped.
+	handler = apitoken.Middleware(tokens, sessions, httpapi.RequiredTokenScope)(handler)
+
 	// 1. CORS (outermost)
 	handler = httpapi.NewCorsMiddleware(httpapi.CorsOptions{
 		AllowedOrigins:   allowedOrigins,
@@ -70,9 +157,28 @@ func main() {
 		}
 	}()
 
+	var grpcSrv *grpc.Server
+	if grpcPort != "" {
+		lis, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			log.Fatalf("grpc listen failed: %v", err)
+		}
+		grpcSrv = grpcapi.NewServer(&grpcapi.Server{Store: st})
+		go func() {
+			log.Printf("qr-service internal gRPC listening on :%s", grpcPort)
+			if err := grpcSrv.Serve(lis); err != nil {
+				log.Fatalf("grpc server error: %v", err)
+			}
+		}()
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
+	if grpcSrv != nil {
+		grpcSrv.GracefulStop()
+	}
+	stopListening()
 	closeStore()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -80,6 +186,28 @@ func main() {
 	_ = srv.Shutdown(ctx)
 }
 
+// purgeInterval is how often change events past retention are deleted.
+const purgeInterval = time.Hour
+
+// purgeEvents deletes change events older than retention at startup and
+// then every purgeInterval, until ctx ends. A failed run is retried on the
+// next tick.
+func purgeEvents(ctx context.Context, st store.Store, retention time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		cutoff := time.Now().UTC().Add(-retention)
+		if err := st.PurgeEvents(ctx, cutoff); err != nil && ctx.Err() == nil {
+			log.Printf("change event purge failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func envOr(key, fallback string) string {
 	v := strings.TrimSpace(os.Getenv(key))
 	if v == "" {
@@ -1,7 +1,8 @@
 #!/bin/bash
 
-# Generate sample QR codes for testing
-# Usage: ./generate-samples.sh [user-id]
+# Seed sample QR codes for testing
+# Usage: ./generate-samples.sh [user-id] [extra seed flags...]
+# e.g.   ./generate-samples.sh alice -seed 42 -manifest /tmp/codes.json
 
 set -e
 
@@ -13,9 +14,10 @@ if [ -f .env ]; then
 fi
 
 USER_ID="${1:-sample-user}"
+shift || true
 
-echo "Generating sample QR codes for user: $USER_ID"
-echo "Using database: $DATABASE_URL"
+echo "Seeding sample QR codes for user: $USER_ID"
+echo "Using database: ${DATABASE_URL:-<memory, dry run>}"
 echo ""
 
-go run cmd/generate-samples/main.go "$USER_ID"
+go run ./cmd/seed -user "$USER_ID" "$@"
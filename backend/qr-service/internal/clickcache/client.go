@@ -0,0 +1,71 @@
+// Package clickcache tells click-service to drop cached redirects when codes
+// or settings change, so scans follow the change straight away.
+package clickcache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxIDsPerRequest matches click-service's limit for one invalidation.
+const maxIDsPerRequest = 1000
+
+type Client struct {
+	BaseURL string
+	// AdminKey is click-service's ADMIN_API_KEY.
+	AdminKey string
+	HTTP     *http.Client
+}
+
+func NewClient(baseURL, adminKey string) *Client {
+	return &Client{
+		BaseURL:  strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		AdminKey: adminKey,
+		HTTP:     &http.Client{Timeout: 2 * time.Second},
+	}
+}
+
+// Invalidate drops ids from click-service's cache.
+func (c *Client) Invalidate(ctx context.Context, ids []string) error {
+	for len(ids) > 0 {
+		n := min(len(ids), maxIDsPerRequest)
+		if err := c.post(ctx, map[string]any{"ids": ids[:n]}); err != nil {
+			return err
+		}
+		ids = ids[n:]
+	}
+	return nil
+}
+
+// InvalidateAll empties click-service's cache.
+func (c *Client) InvalidateAll(ctx context.Context) error {
+	return c.post(ctx, map[string]any{"all": true})
+}
+
+func (c *Client) post(ctx context.Context, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/api/admin/redirect-cache/invalidate", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Key", c.AdminKey)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("click-service returned %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package clickcache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_InvalidateSplitsLargeBatches(t *testing.T) {
+	var got []map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Admin-Key") != "k" || r.URL.Path != "/api/admin/redirect-cache/invalidate" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		got = append(got, body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	c := NewClient(ts.URL+"/", "k")
+	ids := make([]string, maxIDsPerRequest+1)
+	for i := range ids {
+		ids[i] = "id"
+	}
+	if err := c.Invalidate(context.Background(), ids); err != nil {
+		t.Fatalf("invalidate: %v", err)
+	}
+	if len(got) != 2 || len(got[0]["ids"].([]any)) != maxIDsPerRequest || len(got[1]["ids"].([]any)) != 1 {
+		t.Fatalf("unexpected requests %d", len(got))
+	}
+	if err := c.InvalidateAll(context.Background()); err != nil || got[2]["all"] != true {
+		t.Fatalf("invalidate all: %v %v", got[len(got)-1], err)
+	}
+
+	c.AdminKey = "wrong"
+	if err := c.InvalidateAll(context.Background()); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected a 401 error, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+// Package events wakes change-feed readers when new events are committed.
+package events
+
+import "sync"
+
+// Hub is a broadcast wake-up: everyone waiting is released by the next
+// Notify. It carries no events; readers go back to the store for them. The
+// zero value is ready to use.
+type Hub struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+// Wait returns a channel that's closed by the next Notify. Take it before
+// reading the store, so an event committed in between isn't missed.
+func (h *Hub) Wait() <-chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.ch == nil {
+		h.ch = make(chan struct{})
+	}
+	return h.ch
+}
+
+// Notify wakes everyone waiting.
+func (h *Hub) Notify() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.ch != nil {
+		close(h.ch)
+		h.ch = nil
+	}
+}
@@ -0,0 +1,26 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHub_NotifyWakesEveryWaiter(t *testing.T) {
+	var h Hub
+	a, b := h.Wait(), h.Wait()
+	h.Notify()
+	for _, ch := range []<-chan struct{}{a, b} {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("waiter not woken")
+		}
+	}
+
+	// Later waiters wait for the next Notify.
+	select {
+	case <-h.Wait():
+		t.Fatal("woken without a Notify")
+	default:
+	}
+}
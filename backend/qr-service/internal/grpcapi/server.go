@@ -0,0 +1,123 @@
+// Package grpcapi serves qr-service's internal gRPC API to click-service.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+type Server struct {
+	redirectpb.UnimplementedRedirectServiceServer
+
+	Store store.Store
+}
+
+// NewServer returns a gRPC server with the redirect service registered.
+func NewServer(srv *Server) *grpc.Server {
+	gs := grpc.NewServer()
+	redirectpb.RegisterRedirectServiceServer(gs, srv)
+	return gs
+}
+
+func (s *Server) ResolveRedirect(ctx context.Context, req *redirectpb.ResolveRedirectRequest) (*redirectpb.ResolveRedirectResponse, error) {
+	id := strings.TrimSpace(req.GetQrCodeId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "qr_code_id required")
+	}
+	q, err := s.Store.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not_found")
+		}
+		return nil, storeError(err, "get_failed")
+	}
+	settings, err := s.Store.GetSettings(ctx, q.OwnerID)
+	if err != nil {
+		return nil, storeError(err, "failed_to_get_settings")
+	}
+	return &redirectpb.ResolveRedirectResponse{
+		QrCode:   qrCodeToProto(q.NormalizeForResponse()),
+		Settings: settingsToProto(settings),
+	}, nil
+}
+
+// storeError reports a failed store call as DeadlineExceeded or Canceled when
+// its context ended, so click-service can tell a slow database from a broken
+// one, and as Internal otherwise.
+func storeError(err error, msg string) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, msg)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, msg)
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
+func qrCodeToProto(q model.QrCode) *redirectpb.QrCode {
+	out := &redirectpb.QrCode{
+		Id:              q.ID,
+		OwnerId:         q.OwnerID,
+		Label:           q.Label,
+		Url:             q.URL,
+		Active:          q.Active,
+		Campaign:        q.Campaign,
+		DestinationType: q.DestinationType,
+		WorkspaceId:     q.WorkspaceID,
+		FallbackUrl:     q.FallbackURL,
+		DisabledReason:  q.DisabledReason,
+	}
+	if q.Utm != nil {
+		out.Utm = utmToProto(*q.Utm)
+	}
+	if q.AppLink != nil {
+		out.AppLink = &redirectpb.AppLink{
+			IosUrl:       q.AppLink.IOSURL,
+			AndroidUrl:   q.AppLink.AndroidURL,
+			AppStoreUrl:  q.AppLink.AppStoreURL,
+			PlayStoreUrl: q.AppLink.PlayStoreURL,
+		}
+	}
+	if p := q.LandingPage; p != nil {
+		out.LandingPage = &redirectpb.LandingPage{
+			Title:      p.Title,
+			Message:    p.Message,
+			LogoUrl:    p.LogoURL,
+			ButtonText: p.ButtonText,
+			ButtonUrl:  p.ButtonURL,
+		}
+	}
+	return out
+}
+
+func utmToProto(t model.UtmTemplate) *redirectpb.UtmTemplate {
+	return &redirectpb.UtmTemplate{
+		Source:   t.Source,
+		Medium:   t.Medium,
+		Campaign: t.Campaign,
+		Content:  t.Content,
+		Term:     t.Term,
+	}
+}
+
+func settingsToProto(s model.UserSettings) *redirectpb.Settings {
+	out := &redirectpb.Settings{DefaultRedirectUrl: s.DefaultRedirectURL}
+	if len(s.CampaignUtm) > 0 {
+		out.CampaignUtm = make(map[string]*redirectpb.UtmTemplate, len(s.CampaignUtm))
+		for name, t := range s.CampaignUtm {
+			out.CampaignUtm[name] = utmToProto(t)
+		}
+	}
+	return out
+}
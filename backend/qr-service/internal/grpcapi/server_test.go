@@ -0,0 +1,104 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func dial(t *testing.T, st store.Store) redirectpb.RedirectServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	gs := NewServer(&Server{Store: st})
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return redirectpb.NewRedirectServiceClient(conn)
+}
+
+func TestResolveRedirect_ReturnsCodeAndSettings(t *testing.T) {
+	st := store.NewMemoryStore()
+	created, _ := st.Create(context.Background(), store.CreateInput{
+		OwnerID:     "u1",
+		WorkspaceID: "ws1",
+		Label:       "Menu",
+		URL:         "https://example.com/menu",
+		Campaign:    "spring",
+		Utm:         &model.UtmTemplate{Source: "qr"},
+		FallbackURL: "https://example.com/closed",
+		LandingPage: &model.LandingPage{Title: "Closed"},
+	})
+	_ = st.UpdateSettings(context.Background(), "u1", model.UserSettings{
+		DefaultRedirectURL: "https://example.com",
+		CampaignUtm:        map[string]model.UtmTemplate{"spring": {Medium: "print"}},
+	})
+
+	resp, err := dial(t, st).ResolveRedirect(context.Background(), &redirectpb.ResolveRedirectRequest{QrCodeId: created.ID})
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	q := resp.GetQrCode()
+	if q.GetId() != created.ID || q.GetUrl() != "https://example.com/menu" || !q.GetActive() || q.GetOwnerId() != "u1" || q.GetWorkspaceId() != "ws1" {
+		t.Fatalf("unexpected code %+v", q)
+	}
+	if q.GetDestinationType() != model.DestinationURL || q.GetUtm().GetSource() != "qr" {
+		t.Fatalf("expected normalized destination and utm, got %+v", q)
+	}
+	if q.GetFallbackUrl() != "https://example.com/closed" || q.GetLandingPage().GetTitle() != "Closed" {
+		t.Fatalf("expected fallback and landing page, got %+v", q)
+	}
+	if resp.GetSettings().GetDefaultRedirectUrl() != "https://example.com" || resp.GetSettings().GetCampaignUtm()["spring"].GetMedium() != "print" {
+		t.Fatalf("unexpected settings %+v", resp.GetSettings())
+	}
+	reason := "Phishing"
+	if _, err := st.Update(context.Background(), created.ID, store.UpdateInput{DisabledReason: &reason}); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	resp, err = dial(t, st).ResolveRedirect(context.Background(), &redirectpb.ResolveRedirectRequest{QrCodeId: created.ID})
+	if err != nil || resp.GetQrCode().GetDisabledReason() != "Phishing" {
+		t.Fatalf("expected the disable reason, got %+v %v", resp.GetQrCode(), err)
+	}
+}
+
+func TestResolveRedirect_NotFound(t *testing.T) {
+	_, err := dial(t, store.NewMemoryStore()).ResolveRedirect(context.Background(), &redirectpb.ResolveRedirectRequest{QrCodeId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
+
+// expiredStore fails the way a database store does once an operation's
+// deadline has passed.
+type expiredStore struct {
+	store.Store
+}
+
+func (expiredStore) Get(context.Context, string) (model.QrCode, error) {
+	return model.QrCode{}, context.DeadlineExceeded
+}
+
+func TestResolveRedirect_StoreDeadline(t *testing.T) {
+	_, err := dial(t, expiredStore{store.NewMemoryStore()}).ResolveRedirect(context.Background(), &redirectpb.ResolveRedirectRequest{QrCodeId: "abc"})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,384 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+	"qr-service/internal/userplans"
+)
+
+const (
+	defaultAdminSearchLimit = 100
+	maxAdminSearchLimit     = 1000
+)
+
+// requireAdmin writes 401 and reports false unless the request carries the
+// configured admin key.
+func (srv *Server) requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if srv.AdminAPIKey == "" || r.Header.Get("X-Admin-Key") != srv.AdminAPIKey {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return false
+	}
+	return true
+}
+
+// adminSearch narrows codes across all owners. Zero-value fields match everything.
+type adminSearch struct {
+	qrCodeFilter
+	OwnerID string
+	// Domain matches the destination host or any of its subdomains.
+	Domain string
+	// Label matches a case-insensitive substring of the label only.
+	Label    string
+	Disabled *bool
+}
+
+func (s adminSearch) matches(q model.QrCode) bool {
+	if !s.qrCodeFilter.matches(q) {
+		return false
+	}
+	if s.OwnerID != "" && q.OwnerID != s.OwnerID {
+		return false
+	}
+	if s.Label != "" && !strings.Contains(strings.ToLower(q.Label), strings.ToLower(s.Label)) {
+		return false
+	}
+	if s.Disabled != nil && q.IsModerated() != *s.Disabled {
+		return false
+	}
+	if s.Domain != "" {
+		u, err := url.Parse(q.URL)
+		if err != nil {
+			return false
+		}
+		host, domain := strings.ToLower(u.Hostname()), strings.ToLower(strings.TrimPrefix(s.Domain, "."))
+		if host != domain && !strings.HasSuffix(host, "."+domain) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseBoolQuery(v string) (*bool, bool) {
+	if v == "" {
+		return nil, true
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, false
+	}
+	return &b, true
+}
+
+// adminSearchHandler lists codes across all owners, newest first.
+func (srv *Server) adminSearchHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !srv.requireAdmin(w, r) {
+		return
+	}
+
+	qs := r.URL.Query()
+	search := adminSearch{
+		qrCodeFilter: qrCodeFilter{Campaign: strings.TrimSpace(qs.Get("campaign")), Search: qs.Get("q")},
+		OwnerID:      strings.TrimSpace(qs.Get("ownerId")),
+		Domain:       strings.TrimSpace(qs.Get("domain")),
+		Label:        strings.TrimSpace(qs.Get("label")),
+	}
+	var ok bool
+	if search.Active, ok = parseBoolQuery(qs.Get("active")); !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "active_invalid"})
+		return
+	}
+	if search.Disabled, ok = parseBoolQuery(qs.Get("disabled")); !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "disabled_invalid"})
+		return
+	}
+	limit := defaultAdminSearchLimit
+	if v := qs.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxAdminSearchLimit {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "limit_invalid"})
+			return
+		}
+		limit = n
+	}
+
+	all, err := srv.Store.List(r.Context())
+	if err != nil {
+		writeStoreError(w, err, "list_failed")
+		return
+	}
+	items := make([]model.QrCode, 0)
+	for _, q := range all {
+		if !search.matches(q) {
+			continue
+		}
+		items = append(items, q.NormalizeForResponse())
+		if len(items) == limit {
+			break
+		}
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+type adminDisableRequest struct {
+	Reason string `json:"reason"`
+}
+
+// adminQrCodeHandler serves POST /api/admin/qr-codes/{id}/disable and /enable.
+func (srv *Server) adminQrCodeHandler(w http.ResponseWriter, r *http.Request) {
+	rest := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/admin/qr-codes/"), "/")
+	id, action, _ := strings.Cut(rest, "/")
+	if id == "" || (action != "disable" && action != "enable") {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !srv.requireAdmin(w, r) {
+		return
+	}
+
+	var input store.UpdateInput
+	if action == "disable" {
+		var req adminDisableRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		reason := strings.TrimSpace(req.Reason)
+		if reason == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "reason_required"})
+			return
+		}
+		inactive := false
+		input = store.UpdateInput{Active: &inactive, DisabledReason: &reason}
+	} else {
+		// Lifting a disable leaves the code inactive; the owner reactivates
+		// it, which goes through their quota as usual.
+		cleared := ""
+		input = store.UpdateInput{DisabledReason: &cleared}
+	}
+
+	updated, err := srv.Store.Update(r.Context(), id, input)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+			return
+		}
+		writeStoreError(w, err, "update_failed")
+		return
+	}
+	srv.invalidateRedirects(r, id)
+	writeJSON(w, http.StatusOK, updated.NormalizeForResponse())
+}
+
+type adminTransferRequest struct {
+	// IDs lists the codes to move; FromOwnerID moves all of an owner's codes
+	// instead. Exactly one is required.
+	IDs         []string `json:"ids,omitempty"`
+	FromOwnerID string   `json:"fromOwnerId,omitempty"`
+	ToOwnerID   string   `json:"toOwnerId"`
+}
+
+// adminTransferHandler reassigns codes to another owner. Quotas are not
+// enforced against the recipient; check /api/admin/usage afterwards.
+func (srv *Server) adminTransferHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !srv.requireAdmin(w, r) {
+		return
+	}
+
+	var req adminTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	req.ToOwnerID = strings.TrimSpace(req.ToOwnerID)
+	req.FromOwnerID = strings.TrimSpace(req.FromOwnerID)
+	if req.ToOwnerID == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "to_owner_required"})
+		return
+	}
+	if (len(req.IDs) == 0) == (req.FromOwnerID == "") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "ids_or_from_owner_required"})
+		return
+	}
+
+	var items []model.QrCode
+	if len(req.IDs) > 0 {
+		var missing []string
+		var err error
+		items, missing, err = srv.selectQrCodes(r.Context(), req.IDs, nil)
+		if err != nil {
+			writeStoreError(w, err, "list_failed")
+			return
+		}
+		if len(missing) > 0 {
+			writeJSON(w, http.StatusNotFound, map[string]any{"error": "not_found", "ids": missing})
+			return
+		}
+	} else {
+		all, err := srv.Store.List(r.Context())
+		if err != nil {
+			writeStoreError(w, err, "list_failed")
+			return
+		}
+		for _, q := range all {
+			if q.OwnerID == req.FromOwnerID {
+				items = append(items, q)
+			}
+		}
+	}
+
+	// Every code moves or none does, so a failure never leaves an owner's
+	// codes split between two accounts.
+	moved := make([]string, len(items))
+	changes := make([]store.BatchChange, len(items))
+	for i, q := range items {
+		moved[i] = q.ID
+		changes[i] = store.BatchChange{ID: q.ID, Update: store.UpdateInput{OwnerID: &req.ToOwnerID}}
+	}
+	if len(changes) > 0 {
+		if err := srv.Store.ApplyBatch(r.Context(), changes); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				// A code was deleted between selecting and moving.
+				writeJSON(w, http.StatusConflict, map[string]string{"error": "transfer_conflict"})
+				return
+			}
+			writeStoreError(w, err, "transfer_failed")
+			return
+		}
+	}
+	srv.invalidateRedirects(r, moved...)
+	writeJSON(w, http.StatusOK, map[string]any{"transferred": len(moved), "ids": moved})
+}
+
+type ownerUsage struct {
+	OwnerID  string `json:"ownerId"`
+	UserType string `json:"userType"`
+	Total    int    `json:"total"`
+	Active   int    `json:"active"`
+	Disabled int    `json:"disabled"`
+
+	MaxTotal  int  `json:"maxTotal"`
+	MaxActive int  `json:"maxActive"`
+	OverQuota bool `json:"overQuota"`
+}
+
+// adminUsageHandler reports per-owner counts against each owner's own plan,
+// read from user-service. Without user-service every owner is measured
+// against ?userType= (default free). With it, ?userType= keeps only the
+// owners on that plan.
+func (srv *Server) adminUsageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !srv.requireAdmin(w, r) {
+		return
+	}
+
+	userType := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("userType")))
+	switch userType {
+	case "", "free", "basic", "enterprise", "admin":
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "user_type_invalid"})
+		return
+	}
+	ownerFilter := strings.TrimSpace(r.URL.Query().Get("ownerId"))
+
+	all, err := srv.Store.List(r.Context())
+	if err != nil {
+		writeStoreError(w, err, "list_failed")
+		return
+	}
+	byOwner := map[string]*ownerUsage{}
+	for _, q := range all {
+		if ownerFilter != "" && q.OwnerID != ownerFilter {
+			continue
+		}
+		u, ok := byOwner[q.OwnerID]
+		if !ok {
+			u = &ownerUsage{OwnerID: q.OwnerID}
+			byOwner[q.OwnerID] = u
+		}
+		u.Total++
+		if q.Active {
+			u.Active++
+		}
+		if q.IsModerated() {
+			u.Disabled++
+		}
+	}
+
+	for ownerID, u := range byOwner {
+		plan, err := srv.ownerPlan(r, ownerID, userType)
+		if err != nil {
+			writeJSON(w, http.StatusBadGateway, map[string]string{"error": "plan_lookup_failed"})
+			return
+		}
+		if srv.Plans != nil && userType != "" && plan != userType {
+			delete(byOwner, ownerID)
+			continue
+		}
+		qt := quotaForUserType(plan)
+		u.UserType, u.MaxTotal, u.MaxActive = plan, qt.maxTotal, qt.maxActive
+	}
+
+	usage := make([]ownerUsage, 0, len(byOwner))
+	for _, u := range byOwner {
+		u.OverQuota = u.Total > u.MaxTotal || u.Active > u.MaxActive
+		usage = append(usage, *u)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Total != usage[j].Total {
+			return usage[i].Total > usage[j].Total
+		}
+		return usage[i].OwnerID < usage[j].OwnerID
+	})
+	writeJSON(w, http.StatusOK, usage)
+}
+
+// ownerPlan returns ownerID's plan: from user-service when it's configured,
+// otherwise fallback (default free). Owners user-service doesn't know, and
+// codes made without an owner, count as free.
+func (srv *Server) ownerPlan(r *http.Request, ownerID, fallback string) (string, error) {
+	if srv.Plans == nil {
+		if fallback == "" {
+			return "free", nil
+		}
+		return fallback, nil
+	}
+	if ownerID == "" {
+		return "free", nil
+	}
+	plan, err := srv.Plans.UserType(r.Context(), ownerID)
+	if errors.Is(err, userplans.ErrUnknownUser) {
+		return "free", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	switch plan = strings.ToLower(strings.TrimSpace(plan)); plan {
+	case "basic", "enterprise", "admin":
+		return plan, nil
+	default:
+		return "free", nil
+	}
+}
@@ -0,0 +1,221 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+	"qr-service/internal/userplans"
+)
+
+func adminRequest(method, path string, body any) *http.Request {
+	req := jsonRequest(method, path, body)
+	req.Header.Set("X-Admin-Key", "k")
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req
+}
+
+func seedOwnedCodes(t *testing.T, s store.Store) (alice, bob model.QrCode) {
+	t.Helper()
+	var err error
+	if alice, err = s.Create(context.Background(), store.CreateInput{OwnerID: "alice", Label: "Spring menu", URL: "https://shop.example.com/menu"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if bob, err = s.Create(context.Background(), store.CreateInput{OwnerID: "bob", Label: "Promo", URL: "https://phish.test/login"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	return alice, bob
+}
+
+func TestAdmin_RequiresKey(t *testing.T) {
+	r := NewRouter(Server{Store: store.NewMemoryStore(), AdminAPIKey: "k"})
+	for _, path := range []string{"/api/admin/qr-codes", "/api/admin/usage"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, jsonRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("%s: expected %d, got %d", path, http.StatusUnauthorized, w.Code)
+		}
+	}
+}
+
+func TestAdmin_SearchAcrossOwners(t *testing.T) {
+	s := store.NewMemoryStore()
+	alice, bob := seedOwnedCodes(t, s)
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+
+	cases := []struct {
+		query string
+		want  string
+	}{
+		{"domain=example.com", alice.ID},
+		{"domain=phish.test", bob.ID},
+		{"label=menu", alice.ID},
+		{"q=login", bob.ID},
+		{"ownerId=bob", bob.ID},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, adminRequest(http.MethodGet, "/api/admin/qr-codes?"+tc.query, nil))
+		var items []model.QrCode
+		_ = json.NewDecoder(w.Body).Decode(&items)
+		if w.Code != http.StatusOK || len(items) != 1 || items[0].ID != tc.want {
+			t.Fatalf("%s: expected only %s, got %d %+v", tc.query, tc.want, w.Code, items)
+		}
+	}
+}
+
+func TestAdmin_DisableBlocksOwnerReactivation(t *testing.T) {
+	s := store.NewMemoryStore()
+	_, bob := seedOwnedCodes(t, s)
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodPost, "/api/admin/qr-codes/"+bob.ID+"/disable", map[string]string{}))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected reason_required, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodPost, "/api/admin/qr-codes/"+bob.ID+"/disable", map[string]string{"reason": "Phishing"}))
+	var disabled model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&disabled)
+	if w.Code != http.StatusOK || disabled.Active || disabled.DisabledReason != "Phishing" || disabled.DisabledAtIso == "" {
+		t.Fatalf("unexpected disable result %d %+v", w.Code, disabled)
+	}
+
+	// The owner sees the reason and can't switch the code back on.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPatch, "/api/qr-codes/"+bob.ID, map[string]any{"active": true}), "bob", ""))
+	var resp errResp
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if w.Code != http.StatusForbidden || resp.Error != "disabled_by_admin" {
+		t.Fatalf("expected disabled_by_admin, got %d %q", w.Code, resp.Error)
+	}
+
+	// Nor point its scans somewhere else while it's disabled.
+	for _, patch := range []map[string]any{
+		{"fallbackUrl": "https://phish.test/closed"},
+		{"landingPage": map[string]any{"title": "Log in", "buttonUrl": "https://phish.test"}},
+	} {
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(jsonRequest(http.MethodPatch, "/api/qr-codes/"+bob.ID, patch), "bob", ""))
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("%v: expected %d, got %d", patch, http.StatusForbidden, w.Code)
+		}
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPatch, "/api/qr-codes/"+bob.ID, map[string]any{"label": "Renamed"}), "bob", ""))
+	if w.Code != http.StatusOK {
+		t.Fatalf("label edit: expected %d, got %d", http.StatusOK, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodPost, "/api/admin/qr-codes/"+bob.ID+"/enable", map[string]string{}))
+	if w.Code != http.StatusOK {
+		t.Fatalf("enable: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPatch, "/api/qr-codes/"+bob.ID, map[string]any{"active": true}), "bob", ""))
+	if w.Code != http.StatusOK {
+		t.Fatalf("reactivate: expected %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestAdmin_TransferAndUsage(t *testing.T) {
+	s := store.NewMemoryStore()
+	alice, bob := seedOwnedCodes(t, s)
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodPost, "/api/admin/qr-codes/transfer", map[string]any{"ids": []string{bob.ID, "missing"}, "toOwnerId": "alice"}))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected %d for unknown id, got %d", http.StatusNotFound, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodPost, "/api/admin/qr-codes/transfer", map[string]any{"fromOwnerId": "bob", "toOwnerId": "alice"}))
+	if w.Code != http.StatusOK {
+		t.Fatalf("transfer: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if got, _ := s.Get(context.Background(), bob.ID); got.OwnerID != "alice" {
+		t.Fatalf("expected owner alice, got %q", got.OwnerID)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodGet, "/api/admin/usage?userType=free", nil))
+	var usage []ownerUsage
+	_ = json.NewDecoder(w.Body).Decode(&usage)
+	if w.Code != http.StatusOK || len(usage) != 1 {
+		t.Fatalf("unexpected usage %d %+v", w.Code, usage)
+	}
+	if u := usage[0]; u.OwnerID != alice.OwnerID || u.Total != 2 || u.Active != 2 || u.MaxTotal != 20 || u.OverQuota {
+		t.Fatalf("unexpected usage row %+v", u)
+	}
+}
+
+// fakePlans maps owner → plan; "broken" fails every lookup.
+type fakePlans map[string]string
+
+func (f fakePlans) UserType(_ context.Context, userID string) (string, error) {
+	if userID == "broken" {
+		return "", errors.New("user-service down")
+	}
+	plan, ok := f[userID]
+	if !ok {
+		return "", userplans.ErrUnknownUser
+	}
+	return plan, nil
+}
+
+func TestAdmin_UsageUsesEachOwnersPlan(t *testing.T) {
+	s := store.NewMemoryStore()
+	seedOwnedCodes(t, s)
+	for i := 0; i < 6; i++ {
+		if _, err := s.Create(context.Background(), store.CreateInput{OwnerID: "alice", URL: "https://example.com"}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k", Plans: fakePlans{"alice": "basic"}})
+
+	usage := func(query string) []ownerUsage {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, adminRequest(http.MethodGet, "/api/admin/usage"+query, nil))
+		var out []ownerUsage
+		_ = json.NewDecoder(w.Body).Decode(&out)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected %d, got %d", query, http.StatusOK, w.Code)
+		}
+		return out
+	}
+
+	// alice's seven active codes fit basic; bob isn't in user-service, so free.
+	got := usage("")
+	if len(got) != 2 {
+		t.Fatalf("unexpected usage %+v", got)
+	}
+	if u := got[0]; u.OwnerID != "alice" || u.UserType != "basic" || u.MaxActive != 50 || u.OverQuota {
+		t.Fatalf("unexpected alice row %+v", u)
+	}
+	if u := got[1]; u.OwnerID != "bob" || u.UserType != "free" || u.MaxTotal != 20 {
+		t.Fatalf("unexpected bob row %+v", u)
+	}
+	if got := usage("?userType=free"); len(got) != 1 || got[0].OwnerID != "bob" {
+		t.Fatalf("free owners: %+v", got)
+	}
+
+	if _, err := s.Create(context.Background(), store.CreateInput{OwnerID: "broken", URL: "https://example.com"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodGet, "/api/admin/usage", nil))
+	if w.Code != http.StatusBadGateway {
+		t.Fatalf("lookup failure: expected %d, got %d", http.StatusBadGateway, w.Code)
+	}
+}
@@ -0,0 +1,77 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/store"
+)
+
+func TestAppLink_Validation(t *testing.T) {
+	cases := []struct {
+		name    string
+		body    map[string]any
+		status  int
+		errCode string
+	}{
+		{
+			name:   "valid custom scheme with stores",
+			status: http.StatusCreated,
+			body: map[string]any{
+				"url":             "https://example.com/app",
+				"destinationType": "app_link",
+				"appLink": map[string]string{
+					"iosUrl":       "myapp://open/promo",
+					"appStoreUrl":  "https://apps.apple.com/app/id123",
+					"playStoreUrl": "https://play.google.com/store/apps/details?id=com.example",
+				},
+			},
+		},
+		{
+			name:    "app link missing",
+			status:  http.StatusBadRequest,
+			errCode: "app_link_required",
+			body:    map[string]any{"url": "https://example.com", "destinationType": "app_link"},
+		},
+		{
+			name:    "javascript scheme rejected",
+			status:  http.StatusBadRequest,
+			errCode: "app_link_invalid",
+			body: map[string]any{
+				"url":             "https://example.com",
+				"destinationType": "app_link",
+				"appLink":         map[string]string{"androidUrl": "javascript:alert(1)"},
+			},
+		},
+		{
+			name:    "unknown destination type",
+			status:  http.StatusBadRequest,
+			errCode: "destination_type_invalid",
+			body:    map[string]any{"url": "https://example.com", "destinationType": "sms"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRouter(Server{Store: store.NewMemoryStore()})
+			body, _ := json.Marshal(tc.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/qr-codes", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tc.status {
+				t.Fatalf("expected %d, got %d", tc.status, w.Code)
+			}
+			if tc.errCode != "" {
+				var resp errResp
+				_ = json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Error != tc.errCode {
+					t.Fatalf("expected %s, got %q", tc.errCode, resp.Error)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package httpapi
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+)
+
+// backupImage is how a ZIP export draws each code: GET /image's defaults.
+var backupImage = imageOptions{sizeMm: defaultImageSizeMm, dpi: defaultImageDPI, quietZone: -1}
+
+// csvExportHeader starts with the columns POST /api/qr-codes/import reads, so
+// a CSV export can be imported again.
+var csvExportHeader = []string{"label", "url", "active", "tags", "campaign", "id", "destinationType", "utm", "appLink", "workspaceId", "createdAtIso", "disabledReason", "fallbackUrl", "landingPage", "style", "scannability", "symbology", "frame"}
+
+// backupWriteTimeout is how long an export may go without writing. Each write
+// pushes the server's write deadline this far out, so a large export isn't
+// cut off partway while a client that stops reading still is.
+const backupWriteTimeout = 15 * time.Second
+
+// deadlineWriter extends the response's write deadline before every write.
+type deadlineWriter struct {
+	w  io.Writer
+	rc *http.ResponseController
+}
+
+func (d deadlineWriter) Write(p []byte) (int, error) {
+	// Writers without deadlines, like test recorders, return ErrNotSupported.
+	_ = d.rc.SetWriteDeadline(time.Now().Add(backupWriteTimeout))
+	return d.w.Write(p)
+}
+
+// backupExportHandler serves GET /api/qr-codes/export?format=csv|json|zip, a
+// full offline copy of the codes in the request's workspace scope (the same
+// codes the list shows) and the caller's settings. Codes are streamed from
+// the store as they're written, so the response is never held in memory; an
+// error partway through can only be logged, and leaves a truncated file.
+func (srv *Server) backupExportHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	format := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format")))
+	if format == "" {
+		format = "json"
+	}
+	switch format {
+	case "csv", "json":
+	case "zip":
+		if srv.ClickBaseURL == "" {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "click_base_url_not_configured"})
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "format_invalid"})
+		return
+	}
+	scope := workspaceFromRequest(r)
+	acc := srv.accessFor(r)
+	if !acc.allow(w, scope, workspace.RoleViewer) {
+		return
+	}
+	// Load settings first: it's the last point where an error can still be
+	// answered with a status code.
+	settings, err := srv.Store.GetSettings(r.Context(), acc.userID)
+	if err != nil {
+		writeStoreError(w, err, "failed_to_get_settings")
+		return
+	}
+
+	exportedAt := time.Now().UTC()
+	contentTypes := map[string]string{"csv": "text/csv; charset=utf-8", "json": "application/json", "zip": "application/zip"}
+	w.Header().Set("Content-Type", contentTypes[format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="qr-codes-%s.%s"`, exportedAt.Format("20060102"), format))
+	w.WriteHeader(http.StatusOK)
+
+	out := deadlineWriter{w: w, rc: http.NewResponseController(w)}
+	switch format {
+	case "csv":
+		err = srv.writeCSVBackup(r.Context(), out, acc, scope)
+	case "json":
+		err = srv.writeJSONBackup(r.Context(), out, acc, scope, settings, exportedAt)
+	case "zip":
+		err = srv.writeZIPBackup(r.Context(), out, acc, scope, settings, exportedAt)
+	}
+	if err != nil {
+		log.Printf("export failed format=%s workspace=%q err=%v", format, scope, err)
+	}
+}
+
+// forEachInScope is Store.ForEach limited to the codes acc lists in scope:
+// outside a workspace, only the caller's own.
+func (srv *Server) forEachInScope(ctx context.Context, acc *access, scope string, fn func(model.QrCode) error) error {
+	return srv.Store.ForEach(ctx, scope, func(q model.QrCode) error {
+		if !acc.listed(q.WorkspaceID, q.OwnerID, scope) {
+			return nil
+		}
+		return fn(q)
+	})
+}
+
+// writeCSVBackup writes one row per code. Settings don't fit the format; use
+// JSON or ZIP for a complete backup.
+func (srv *Server) writeCSVBackup(ctx context.Context, w io.Writer, acc *access, scope string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvExportHeader); err != nil {
+		return err
+	}
+	err := srv.forEachInScope(ctx, acc, scope, func(q model.QrCode) error {
+		q = q.NormalizeForResponse()
+		return cw.Write([]string{
+			q.Label, q.URL, strconv.FormatBool(q.Active), strings.Join(q.Tags, ";"), q.Campaign,
+			q.ID, q.DestinationType, jsonCell(q.Utm), jsonCell(q.AppLink), q.WorkspaceID, q.CreatedAtIso, q.DisabledReason,
+			q.FallbackURL, jsonCell(q.LandingPage), jsonCell(q.Style), jsonCell(q.Scannability), q.Symbology, jsonCell(q.Frame),
+		})
+	})
+	cw.Flush()
+	if err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// jsonCell encodes a nested value for a CSV cell, or "" when it's unset.
+func jsonCell[T any](v *T) string {
+	if v == nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// writeJSONBackup writes {"exportedAtIso", "workspaceId", "settings",
+// "qrCodes": [...]}, encoding each code as it's read.
+func (srv *Server) writeJSONBackup(ctx context.Context, w io.Writer, acc *access, scope string, settings model.UserSettings, exportedAt time.Time) error {
+	head, err := json.Marshal(map[string]any{
+		"exportedAtIso": exportedAt.Format(time.RFC3339),
+		"workspaceId":   scope,
+		"settings":      settings,
+	})
+	if err != nil {
+		return err
+	}
+	// Reopen the object to append the streamed array.
+	if _, err := fmt.Fprintf(w, "%s,\"qrCodes\":[", head[:len(head)-1]); err != nil {
+		return err
+	}
+	first := true
+	err = srv.forEachInScope(ctx, acc, scope, func(q model.QrCode) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		b, err := json.Marshal(q.NormalizeForResponse())
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
+
+// writeZIPBackup writes codes.json (as writeJSONBackup) and, per code, a PNG
+// and an SVG under images/, drawn in its style and frame as GET /image draws
+// them. Entries are written in sequence, so the store is read twice.
+func (srv *Server) writeZIPBackup(ctx context.Context, w io.Writer, acc *access, scope string, settings model.UserSettings, exportedAt time.Time) error {
+	zw := zip.NewWriter(w)
+	header := func(name string) *zip.FileHeader {
+		return &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: exportedAt}
+	}
+	f, err := zw.CreateHeader(header("codes.json"))
+	if err != nil {
+		return err
+	}
+	if err := srv.writeJSONBackup(ctx, f, acc, scope, settings, exportedAt); err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+	err = srv.forEachInScope(ctx, acc, scope, func(q model.QrCode) error {
+		a, err := srv.artworkFor(q, backupImage)
+		if err != nil {
+			return err
+		}
+		name := uniqueName(names, backupSlug(q))
+		png, err := zw.CreateHeader(header("images/" + name + ".png"))
+		if err != nil {
+			return err
+		}
+		if err := a.WritePNG(png, backupImage.dpi); err != nil {
+			return err
+		}
+		svg, err := zw.CreateHeader(header("images/" + name + ".svg"))
+		if err != nil {
+			return err
+		}
+		return a.WriteSVG(svg)
+	})
+	if err != nil {
+		// Leave the central directory off so the archive reads as broken
+		// rather than as a complete backup.
+		return err
+	}
+	return zw.Close()
+}
+
+// backupSlug names a code's image files after its label, falling back to its
+// ID when the label has no usable characters.
+func backupSlug(q model.QrCode) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(q.Label) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+			dash = false
+		case b.Len() > 0 && !dash:
+			b.WriteByte('-')
+			dash = true
+		}
+		if b.Len() >= 60 {
+			break
+		}
+	}
+	slug := strings.Trim(b.String(), "-")
+	if slug == "" {
+		return q.ID
+	}
+	return slug
+}
+
+// uniqueName suffixes repeated names with -2, -3 and so on.
+func uniqueName(seen map[string]bool, name string) string {
+	candidate := name
+	for n := 2; seen[candidate]; n++ {
+		candidate = fmt.Sprintf("%s-%d", name, n)
+	}
+	seen[candidate] = true
+	return candidate
+}
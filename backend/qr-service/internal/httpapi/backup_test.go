@@ -0,0 +1,210 @@
+package httpapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func seedBackupCodes(t *testing.T, s store.Store) {
+	t.Helper()
+	_ = s.UpdateSettings(context.Background(), "", model.UserSettings{DefaultRedirectURL: "https://example.com"})
+	for _, in := range []store.CreateInput{
+		{Label: "Spring Menu!", URL: "https://example.com/menu", Tags: []string{"print", "menu"}},
+		{Label: "Spring menu", URL: "https://example.com/menu2"},
+		{Label: "★", URL: "https://example.com/star"},
+		{Label: "Team", URL: "https://example.com/team", WorkspaceID: "ws1"},
+	} {
+		if _, err := s.Create(context.Background(), in); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+}
+
+func TestBackupExport_JSONAndCSV(t *testing.T) {
+	s := store.NewMemoryStore()
+	seedBackupCodes(t, s)
+	r := NewRouter(Server{Store: s})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=json", nil))
+	var doc struct {
+		Settings model.UserSettings `json:"settings"`
+		QrCodes  []model.QrCode     `json:"qrCodes"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode: %v: %s", err, w.Body.String())
+	}
+	// Workspace codes stay out of a personal export.
+	if w.Code != http.StatusOK || len(doc.QrCodes) != 3 || doc.Settings.DefaultRedirectURL != "https://example.com" {
+		t.Fatalf("json: unexpected %d %+v", w.Code, doc)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=csv", nil))
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil || len(records) != 4 || !slices.Equal(records[0][:4], []string{"label", "url", "active", "tags"}) {
+		t.Fatalf("csv: unexpected %v %v", records, err)
+	}
+	if !slices.ContainsFunc(records, func(rec []string) bool { return rec[3] == "print;menu" }) {
+		t.Fatalf("csv: tags missing: %v", records)
+	}
+
+	// A CSV export imports back as-is.
+	w = httptest.NewRecorder()
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	_ = cw.WriteAll(records)
+	req := importRequest("text/csv", "?dryRun=true", buf.Bytes())
+	req.Header.Set("X-User-Type", "enterprise")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("re-import: expected %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=xml", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("bad format: expected %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestBackupExport_ZIPHasImagesPerCode(t *testing.T) {
+	s := store.NewMemoryStore()
+	seedBackupCodes(t, s)
+	styled, err := s.Create(context.Background(), store.CreateInput{
+		Label: "Styled", URL: "https://example.com/styled",
+		Style: &model.QrStyle{Foreground: "#1565c0"},
+		Frame: &model.QrFrame{Border: model.FrameBorderLine, Caption: "Scan me", Color: "#c62828"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=zip", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/zip" {
+		t.Fatalf("unexpected %d %s", w.Code, w.Header().Get("Content-Type"))
+	}
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	if len(names) != 9 || names[0] != "codes.json" {
+		t.Fatalf("unexpected entries %v", names)
+	}
+	for _, want := range []string{"images/spring-menu.png", "images/spring-menu-2.svg"} {
+		if !slices.Contains(names, want) {
+			t.Fatalf("missing %s in %v", want, names)
+		}
+	}
+
+	// Images are drawn as GET /image draws them, in the code's style and frame.
+	img := httptest.NewRecorder()
+	r.ServeHTTP(img, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+styled.ID+"/image?format=svg", nil))
+	f, err := zr.Open("images/styled.svg")
+	if err != nil {
+		t.Fatalf("open svg: %v", err)
+	}
+	svg, _ := io.ReadAll(f)
+	if !bytes.Equal(svg, img.Body.Bytes()) || !bytes.Contains(svg, []byte(`fill="#c62828"`)) {
+		t.Fatalf("expected the styled, framed image, got %.300s", svg)
+	}
+
+	w = httptest.NewRecorder()
+	NewRouter(Server{Store: s}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=zip", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("zip without a click base URL: expected %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestBackupExport_PersonalCodesAndSettingsAreTheCallers(t *testing.T) {
+	s := store.NewMemoryStore()
+	alice, _ := seedOwnedCodes(t, s)
+	_ = s.UpdateSettings(context.Background(), "alice", model.UserSettings{DefaultRedirectURL: "https://example.com/alice"})
+	_ = s.UpdateSettings(context.Background(), "bob", model.UserSettings{DefaultRedirectURL: "https://example.com/bob"})
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=json", nil), "alice", ""))
+	var doc struct {
+		Settings model.UserSettings `json:"settings"`
+		QrCodes  []model.QrCode     `json:"qrCodes"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode: %v: %s", err, w.Body.String())
+	}
+	if len(doc.QrCodes) != 1 || doc.QrCodes[0].ID != alice.ID || doc.Settings.DefaultRedirectURL != "https://example.com/alice" {
+		t.Fatalf("json: unexpected %+v", doc)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=csv", nil), "alice", ""))
+	if records, err := csv.NewReader(w.Body).ReadAll(); err != nil || len(records) != 2 || records[1][5] != alice.ID {
+		t.Fatalf("csv: unexpected %v %v", records, err)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(httptest.NewRequest(http.MethodGet, "/api/qr-codes/export?format=zip", nil), "alice", ""))
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil || len(zr.File) != 3 {
+		t.Fatalf("zip: expected codes.json and one code's images, got %v", err)
+	}
+}
+
+// slowStore takes delay to read each code, as a large account's export does.
+type slowStore struct {
+	store.Store
+	delay time.Duration
+}
+
+func (s slowStore) ForEach(ctx context.Context, workspaceID string, fn func(model.QrCode) error) error {
+	return s.Store.ForEach(ctx, workspaceID, func(q model.QrCode) error {
+		time.Sleep(s.delay)
+		return fn(q)
+	})
+}
+
+func TestBackupExport_OutlastsTheServerWriteTimeout(t *testing.T) {
+	s := store.NewMemoryStore()
+	seedBackupCodes(t, s)
+	// Three personal codes, read twice for a ZIP: 600ms against a 300ms
+	// write timeout.
+	ts := httptest.NewUnstartedServer(NewRouter(Server{Store: slowStore{s, 100 * time.Millisecond}, ClickBaseURL: "https://click.example.com"}))
+	ts.Config.WriteTimeout = 300 * time.Millisecond
+	ts.Start()
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/api/qr-codes/export?format=zip")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("expected a complete archive, got %d bytes: %v", len(body), err)
+	}
+	if len(zr.File) != 7 {
+		t.Fatalf("expected 7 entries, got %d", len(zr.File))
+	}
+}
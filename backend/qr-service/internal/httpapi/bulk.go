@@ -0,0 +1,309 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+// maxBulkCodes bounds one bulk request; larger campaigns split into batches.
+const maxBulkCodes = 1000
+
+const (
+	bulkActivate   = "activate"
+	bulkDeactivate = "deactivate"
+	bulkDelete     = "delete"
+	bulkTag        = "tag"
+	bulkReplaceURL = "replace_url"
+)
+
+// Per-item outcomes in a bulk report.
+const (
+	bulkUpdated   = "updated"
+	bulkDeleted   = "deleted"
+	bulkUnchanged = "unchanged"
+	bulkFailed    = "failed"
+)
+
+type bulkRequest struct {
+	Action string        `json:"action"`
+	IDs    []string      `json:"ids,omitempty"`
+	Filter *qrCodeFilter `json:"filter,omitempty"`
+	// Tags is required for the tag action.
+	Tags *bulkTags `json:"tags,omitempty"`
+	// Replace is required for the replace_url action.
+	Replace *bulkURLReplace `json:"replace,omitempty"`
+	// DryRun reports what would change without applying anything.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+type bulkTags struct {
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+// bulkURLReplace swaps the host of every destination URL whose host is Find
+// (case-insensitively) for Replace, keeping the path and query.
+type bulkURLReplace struct {
+	Find    string `json:"find"`
+	Replace string `json:"replace"`
+}
+
+type bulkResult struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// URL is the new destination for replace_url.
+	URL string `json:"url,omitempty"`
+}
+
+type bulkResponse struct {
+	Action  string       `json:"action"`
+	DryRun  bool         `json:"dryRun"`
+	Changed int          `json:"changed"`
+	Results []bulkResult `json:"results"`
+}
+
+// bulkHandler serves POST /api/qr-codes/bulk. Every selected code is planned
+// first; if any of them can't take the action, nothing is applied and the
+// report says why. Otherwise the changes are written in one transaction after
+// a single quota check.
+func (srv *Server) bulkHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req bulkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	if code := req.validate(); code != "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+		return
+	}
+	if len(req.IDs) == 0 && !req.Filter.narrows() {
+		// Never act on every code by accident.
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "selection_required"})
+		return
+	}
+	if len(req.IDs) > maxBulkCodes {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "too_many_codes"})
+		return
+	}
+
+	acc := srv.accessFor(r)
+	items, missing, err := srv.selectQrCodes(r.Context(), dedupeIDs(req.IDs), req.Filter)
+	if err != nil {
+		writeStoreError(w, err, "list_failed")
+		return
+	}
+	if len(req.IDs) == 0 {
+		scope := workspaceFromRequest(r)
+		if !acc.allow(w, scope, workspace.RoleEditor) {
+			return
+		}
+		items = acc.inScope(items, scope)
+	} else {
+		// Codes the caller can't see are reported as missing.
+		visible := items[:0]
+		for _, q := range items {
+			if acc.canView(q) {
+				visible = append(visible, q)
+			} else {
+				missing = append(missing, q.ID)
+			}
+		}
+		items = visible
+	}
+	if len(missing) > 0 {
+		writeJSON(w, http.StatusNotFound, map[string]any{"error": "not_found", "ids": missing})
+		return
+	}
+	if len(items) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "no_codes_selected"})
+		return
+	}
+	if len(items) > maxBulkCodes {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "too_many_codes"})
+		return
+	}
+
+	results := make([]bulkResult, 0, len(items))
+	changes := make([]store.BatchChange, 0, len(items))
+	failed, activations := false, 0
+	for _, q := range items {
+		res := bulkResult{ID: q.ID}
+		if _, code := acc.checkCode(q, workspace.RoleEditor); code != "" {
+			res.Status, res.Error = bulkFailed, code
+		} else if change, status, code := req.plan(q); code != "" {
+			res.Status, res.Error = bulkFailed, code
+		} else {
+			res.Status = status
+			if status != bulkUnchanged {
+				changes = append(changes, change)
+			}
+			if req.Action == bulkActivate && status == bulkUpdated {
+				activations++
+			}
+			if change.Update.URL != nil {
+				res.URL = *change.Update.URL
+			}
+		}
+		failed = failed || res.Status == bulkFailed
+		results = append(results, res)
+	}
+	if failed {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "bulk_items_failed", "results": results})
+		return
+	}
+
+	if activations > 0 {
+		qt := quotaForUserType(userTypeFromRequest(r))
+		if !srv.checkActiveQuota(w, r, qt, activations) {
+			return
+		}
+	}
+	if !req.DryRun && len(changes) > 0 {
+		if err := srv.Store.ApplyBatch(r.Context(), changes); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				// A code was deleted between planning and applying.
+				writeJSON(w, http.StatusConflict, map[string]string{"error": "bulk_conflict"})
+				return
+			}
+			writeStoreError(w, err, "bulk_failed")
+			return
+		}
+		ids := make([]string, len(changes))
+		for i, c := range changes {
+			ids[i] = c.ID
+		}
+		srv.invalidateRedirects(r, ids...)
+	}
+	writeJSON(w, http.StatusOK, bulkResponse{Action: req.Action, DryRun: req.DryRun, Changed: len(changes), Results: results})
+}
+
+// validate checks the action and its parameters, normalizing them in place,
+// and returns an error code or "".
+func (req *bulkRequest) validate() string {
+	req.Action = strings.ToLower(strings.TrimSpace(req.Action))
+	switch req.Action {
+	case bulkActivate, bulkDeactivate, bulkDelete:
+	case bulkTag:
+		if req.Tags == nil {
+			return "tags_required"
+		}
+		add, okAdd := cleanTags(req.Tags.Add)
+		remove, okRemove := cleanTags(req.Tags.Remove)
+		if !okAdd || !okRemove {
+			return "tags_invalid"
+		}
+		if len(add) == 0 && len(remove) == 0 {
+			return "tags_required"
+		}
+		req.Tags.Add, req.Tags.Remove = add, remove
+	case bulkReplaceURL:
+		if req.Replace == nil {
+			return "replace_required"
+		}
+		find, ok := hostOnly(req.Replace.Find)
+		if !ok {
+			return "replace_invalid"
+		}
+		replace, ok := hostOnly(req.Replace.Replace)
+		if !ok || strings.EqualFold(find, replace) {
+			return "replace_invalid"
+		}
+		req.Replace.Find, req.Replace.Replace = find, replace
+	default:
+		return "action_invalid"
+	}
+	return ""
+}
+
+// plan works out the change req makes to q. It returns the outcome status, or
+// an error code when q can't take the action.
+func (req *bulkRequest) plan(q model.QrCode) (store.BatchChange, string, string) {
+	change := store.BatchChange{ID: q.ID}
+	switch req.Action {
+	case bulkActivate:
+		if q.IsModerated() {
+			return change, "", "disabled_by_admin"
+		}
+		if q.Active {
+			return change, bulkUnchanged, ""
+		}
+		active := true
+		change.Update.Active = &active
+	case bulkDeactivate:
+		if !q.Active {
+			return change, bulkUnchanged, ""
+		}
+		active := false
+		change.Update.Active = &active
+	case bulkDelete:
+		change.Delete = true
+		return change, bulkDeleted, ""
+	case bulkTag:
+		tags := make([]string, 0, len(q.Tags)+len(req.Tags.Add))
+		for _, t := range append(slices.Clone(q.Tags), req.Tags.Add...) {
+			if !slices.Contains(req.Tags.Remove, t) && !slices.Contains(tags, t) {
+				tags = append(tags, t)
+			}
+		}
+		if slices.Equal(tags, q.Tags) {
+			return change, bulkUnchanged, ""
+		}
+		if len(tags) > maxTagsPerCode {
+			return change, "", "tags_invalid"
+		}
+		change.Update.Tags = &tags
+	case bulkReplaceURL:
+		u, err := url.Parse(q.URL)
+		if err != nil || !strings.EqualFold(u.Host, req.Replace.Find) {
+			return change, bulkUnchanged, ""
+		}
+		u.Host = req.Replace.Replace
+		next := u.String()
+		if !isValidHTTPURL(next) {
+			return change, "", "url_invalid"
+		}
+		change.Update.URL = &next
+	}
+	return change, bulkUpdated, ""
+}
+
+// hostOnly accepts a bare host (with optional port) and returns it lowercased.
+func hostOnly(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.ContainsAny(raw, "/?#@ ") {
+		return "", false
+	}
+	u, err := url.Parse("https://" + raw)
+	if err != nil || u.Host != raw || u.Hostname() == "" {
+		return "", false
+	}
+	return strings.ToLower(raw), true
+}
+
+// dedupeIDs trims ids and drops repeats, keeping the first occurrence.
+func dedupeIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
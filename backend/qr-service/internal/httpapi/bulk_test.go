@@ -0,0 +1,195 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"qr-service/internal/store"
+)
+
+func bulk(r http.Handler, body map[string]any) (*httptest.ResponseRecorder, bulkResponse) {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", body))
+	var resp bulkResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	return w, resp
+}
+
+func TestBulk_ActivateChecksQuotaOnce(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	off := false
+	var ids []string
+	for i := 0; i < 6; i++ {
+		created, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com", Active: &off, Campaign: "spring"})
+		ids = append(ids, created.ID)
+	}
+
+	// Free plan allows 5 active codes; activating 6 activates none.
+	if w, _ := bulk(r, map[string]any{"action": "activate", "ids": ids}); w.Code != http.StatusForbidden {
+		t.Fatalf("expected %d, got %d: %s", http.StatusForbidden, w.Code, w.Body.String())
+	}
+	if n, _ := s.CountActive(context.Background(), ""); n != 0 {
+		t.Fatalf("expected no codes activated, got %d", n)
+	}
+
+	w, resp := bulk(r, map[string]any{"action": "activate", "ids": ids[:5]})
+	if w.Code != http.StatusOK || resp.Changed != 5 || len(resp.Results) != 5 || resp.Results[0].Status != bulkUpdated {
+		t.Fatalf("activate: unexpected %d %+v", w.Code, resp)
+	}
+
+	// Deactivating by filter reports codes that were already off.
+	w, resp = bulk(r, map[string]any{"action": "deactivate", "filter": map[string]any{"campaign": "spring"}})
+	if w.Code != http.StatusOK || resp.Changed != 5 || len(resp.Results) != 6 {
+		t.Fatalf("deactivate: unexpected %d %+v", w.Code, resp)
+	}
+	if n, _ := s.CountActive(context.Background(), ""); n != 0 {
+		t.Fatalf("expected all codes off, got %d active", n)
+	}
+}
+
+func TestBulk_RequiresASelection(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	if _, err := s.Create(context.Background(), store.CreateInput{URL: "https://example.com"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	for _, body := range []map[string]any{
+		{"action": "delete"},
+		{"action": "delete", "filter": map[string]any{}},
+		{"action": "delete", "filter": map[string]any{"search": "  "}},
+	} {
+		w, _ := bulk(r, body)
+		var resp errResp
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		if w.Code != http.StatusBadRequest || resp.Error != "selection_required" {
+			t.Fatalf("%v: expected selection_required, got %d %q", body, w.Code, resp.Error)
+		}
+	}
+	if all, _ := s.List(context.Background()); len(all) != 1 {
+		t.Fatalf("expected the code to survive, got %d codes", len(all))
+	}
+}
+
+func TestBulk_FailingItemAppliesNothing(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	off := false
+	a, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com", Active: &off})
+	b, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com", Active: &off})
+	reason := "phishing"
+	_, _ = s.Update(context.Background(), b.ID, store.UpdateInput{DisabledReason: &reason})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "activate", "ids": []string{a.ID, b.ID}}))
+	var resp struct {
+		Error   string       `json:"error"`
+		Results []bulkResult `json:"results"`
+	}
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if w.Code != http.StatusBadRequest || resp.Error != "bulk_items_failed" || resp.Results[1].Error != "disabled_by_admin" {
+		t.Fatalf("unexpected %d %+v", w.Code, resp)
+	}
+	if got, _ := s.Get(context.Background(), a.ID); got.Active {
+		t.Fatal("nothing should be applied when an item fails")
+	}
+
+	if w, _ := bulk(r, map[string]any{"action": "delete", "ids": []string{a.ID, "nope"}}); w.Code != http.StatusNotFound {
+		t.Fatalf("unknown id: expected %d, got %d", http.StatusNotFound, w.Code)
+	}
+	if w, _ := bulk(r, map[string]any{"action": "delete"}); w.Code != http.StatusBadRequest {
+		t.Fatalf("no selection: expected %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestBulk_TagReplaceURLAndDelete(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	a, _ := s.Create(context.Background(), store.CreateInput{URL: "https://old.example.com/menu?t=1", Tags: []string{"old"}})
+	b, _ := s.Create(context.Background(), store.CreateInput{URL: "https://other.example.com/"})
+
+	w, resp := bulk(r, map[string]any{"action": "tag", "ids": []string{a.ID, b.ID}, "tags": map[string]any{"add": []string{"spring", "print"}, "remove": []string{"old"}}})
+	if w.Code != http.StatusOK || resp.Changed != 2 {
+		t.Fatalf("tag: unexpected %d %+v", w.Code, resp)
+	}
+	if got, _ := s.Get(context.Background(), a.ID); !slices.Equal(got.Tags, []string{"spring", "print"}) {
+		t.Fatalf("tag: unexpected tags %v", got.Tags)
+	}
+
+	// A dry run reports the new URL without changing anything.
+	replace := map[string]any{"action": "replace_url", "filter": map[string]any{"tag": "spring"}, "replace": map[string]any{"find": "OLD.example.com", "replace": "new.example.com"}, "dryRun": true}
+	w, resp = bulk(r, replace)
+	wantURL := bulkResult{ID: a.ID, Status: bulkUpdated, URL: "https://new.example.com/menu?t=1"}
+	if w.Code != http.StatusOK || !resp.DryRun || resp.Changed != 1 || !slices.Contains(resp.Results, wantURL) {
+		t.Fatalf("dry run: unexpected %d %+v", w.Code, resp)
+	}
+	if got, _ := s.Get(context.Background(), a.ID); got.URL != "https://old.example.com/menu?t=1" {
+		t.Fatalf("dry run changed the URL to %s", got.URL)
+	}
+	delete(replace, "dryRun")
+	if w, _ := bulk(r, replace); w.Code != http.StatusOK {
+		t.Fatalf("replace: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if got, _ := s.Get(context.Background(), a.ID); got.URL != "https://new.example.com/menu?t=1" {
+		t.Fatalf("replace: unexpected URL %s", got.URL)
+	}
+
+	for want, body := range map[string]map[string]any{
+		"action_invalid":  {"action": "archive", "ids": []string{a.ID}},
+		"replace_invalid": {"action": "replace_url", "ids": []string{a.ID}, "replace": map[string]any{"find": "https://a.com/x", "replace": "b.com"}},
+		"tags_required":   {"action": "tag", "ids": []string{a.ID}},
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", body))
+		var e errResp
+		_ = json.NewDecoder(w.Body).Decode(&e)
+		if w.Code != http.StatusBadRequest || e.Error != want {
+			t.Fatalf("expected %s, got %d %q", want, w.Code, e.Error)
+		}
+	}
+
+	w, resp = bulk(r, map[string]any{"action": "delete", "ids": []string{a.ID, b.ID, a.ID}})
+	if w.Code != http.StatusOK || len(resp.Results) != 2 || resp.Results[0].Status != bulkDeleted {
+		t.Fatalf("delete: unexpected %d %+v", w.Code, resp)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 0 {
+		t.Fatalf("expected no codes left, got %d", n)
+	}
+}
+
+func TestBulk_RespectsWorkspaceRoles(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, Workspaces: teamRoles})
+	team, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com", WorkspaceID: "ws1"})
+	personal, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com"})
+
+	send := func(userID, workspaceID string, body map[string]any) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes/bulk", body), userID, workspaceID))
+		return w.Code
+	}
+	deactivate := map[string]any{"action": "deactivate", "ids": []string{team.ID}}
+	if code := send("vic", "", deactivate); code != http.StatusBadRequest {
+		t.Fatalf("viewer: expected %d, got %d", http.StatusBadRequest, code)
+	}
+	if code := send("stranger", "", deactivate); code != http.StatusNotFound {
+		t.Fatalf("outsider: expected %d, got %d", http.StatusNotFound, code)
+	}
+	if code := send("vic", "ws1", map[string]any{"action": "deactivate", "filter": map[string]any{"active": true}}); code != http.StatusForbidden {
+		t.Fatalf("viewer filter: expected %d, got %d", http.StatusForbidden, code)
+	}
+	if code := send("ed", "ws1", map[string]any{"action": "deactivate", "filter": map[string]any{"active": true}}); code != http.StatusOK {
+		t.Fatalf("editor filter: expected %d, got %d", http.StatusOK, code)
+	}
+	if got, _ := s.Get(context.Background(), personal.ID); !got.Active {
+		t.Fatal("a workspace filter must not touch personal codes")
+	}
+	if got, _ := s.Get(context.Background(), team.ID); got.Active || got.WorkspaceID != "ws1" {
+		t.Fatalf("team code not deactivated: %+v", got)
+	}
+}
@@ -0,0 +1,47 @@
+package httpapi
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+)
+
+// RedirectCache drops click-service's cached redirects; clickcache.Client
+// implements it.
+type RedirectCache interface {
+	Invalidate(ctx context.Context, ids []string) error
+	InvalidateAll(ctx context.Context) error
+}
+
+// invalidationTimeout bounds the call to click-service. The call reaches one
+// instance and only saves it waiting for the change feed, which every
+// instance follows, so a lost invalidation costs a few seconds.
+const invalidationTimeout = 2 * time.Second
+
+// invalidateRedirects tells click-service that ids changed. It runs once the
+// write has been saved, so it outlives a cancelled request and only logs
+// failures.
+func (srv *Server) invalidateRedirects(r *http.Request, ids ...string) {
+	if srv.RedirectCache == nil || len(ids) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), invalidationTimeout)
+	defer cancel()
+	if err := srv.RedirectCache.Invalidate(ctx, ids); err != nil {
+		log.Printf("redirect cache invalidation failed codes=%d err=%v", len(ids), err)
+	}
+}
+
+// invalidateAllRedirects is invalidateRedirects for changes, such as
+// settings, that can affect every code.
+func (srv *Server) invalidateAllRedirects(r *http.Request) {
+	if srv.RedirectCache == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), invalidationTimeout)
+	defer cancel()
+	if err := srv.RedirectCache.InvalidateAll(ctx); err != nil {
+		log.Printf("redirect cache invalidation failed all=true err=%v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"qr-service/internal/store"
+)
+
+type invalidatorSpy struct {
+	mu  sync.Mutex
+	ids []string
+	all int
+	err error
+}
+
+func (s *invalidatorSpy) Invalidate(ctx context.Context, ids []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	s.ids = append(s.ids, ids...)
+	return s.err
+}
+
+func (s *invalidatorSpy) InvalidateAll(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.all++
+	return s.err
+}
+
+// take returns and clears the ids invalidated so far.
+func (s *invalidatorSpy) take() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := s.ids
+	s.ids = nil
+	return ids
+}
+
+func TestRedirectCache_InvalidatedAfterChanges(t *testing.T) {
+	s := store.NewMemoryStore()
+	spy := &invalidatorSpy{}
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k", RedirectCache: spy})
+	alice, bob := seedOwnedCodes(t, s)
+
+	serve := func(req *http.Request, want int) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != want {
+			t.Fatalf("%s %s: expected %d, got %d: %s", req.Method, req.URL.Path, want, w.Code, w.Body.String())
+		}
+	}
+
+	serve(asMember(jsonRequest(http.MethodPatch, "/api/qr-codes/"+alice.ID, map[string]any{"url": "https://example.com/new"}), "alice", ""), http.StatusOK)
+	if got := spy.take(); !slices.Equal(got, []string{alice.ID}) {
+		t.Fatalf("patch: invalidated %v", got)
+	}
+
+	serve(adminRequest(http.MethodPost, "/api/admin/qr-codes/"+bob.ID+"/disable", map[string]string{"reason": "Phishing"}), http.StatusOK)
+	if got := spy.take(); !slices.Equal(got, []string{bob.ID}) {
+		t.Fatalf("disable: invalidated %v", got)
+	}
+
+	serve(adminRequest(http.MethodPost, "/api/admin/qr-codes/transfer", map[string]any{"ids": []string{bob.ID}, "toOwnerId": "alice"}), http.StatusOK)
+	if got := spy.take(); !slices.Equal(got, []string{bob.ID}) {
+		t.Fatalf("transfer: invalidated %v", got)
+	}
+
+	// A dry run changes nothing, so nothing is invalidated.
+	serve(asMember(jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "deactivate", "ids": []string{alice.ID}, "dryRun": true}), "alice", ""), http.StatusOK)
+	if got := spy.take(); len(got) != 0 {
+		t.Fatalf("dry run: invalidated %v", got)
+	}
+	serve(asMember(jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "deactivate", "ids": []string{alice.ID}}), "alice", ""), http.StatusOK)
+	if got := spy.take(); !slices.Equal(got, []string{alice.ID}) {
+		t.Fatalf("bulk: invalidated %v", got)
+	}
+
+	serve(jsonRequest(http.MethodPut, "/api/settings", map[string]any{"defaultRedirectUrl": "https://example.com"}), http.StatusOK)
+	if spy.all != 1 {
+		t.Fatalf("settings: expected everything invalidated once, got %d", spy.all)
+	}
+
+	// Invalidation failures are logged; the change itself still succeeds.
+	spy.err = errors.New("click-service down")
+	serve(asMember(jsonRequest(http.MethodDelete, "/api/qr-codes/"+alice.ID, nil), "alice", ""), http.StatusNoContent)
+	if got := spy.take(); !slices.Equal(got, []string{alice.ID}) {
+		t.Fatalf("delete: invalidated %v", got)
+	}
+
+	// Rejected changes don't invalidate anything.
+	serve(asMember(jsonRequest(http.MethodDelete, "/api/qr-codes/"+alice.ID, nil), "alice", ""), http.StatusNotFound)
+	if got := spy.take(); len(got) != 0 {
+		t.Fatalf("failed delete: invalidated %v", got)
+	}
+}
@@ -20,7 +20,7 @@ func NewCorsMiddleware(opts CorsOptions) func(http.Handler) http.Handler {
 	}
 	allowedHeaders := opts.AllowedHeaders
 	if len(allowedHeaders) == 0 {
-		allowedHeaders = []string{"Content-Type", "Authorization"}
+		allowedHeaders = []string{"Content-Type", "Authorization", "X-Workspace-Id"}
 	}
 
 	return func(next http.Handler) http.Handler {
@@ -32,6 +32,8 @@ func NewCorsMiddleware(opts CorsOptions) func(http.Handler) http.Handler {
 					w.Header().Set("Access-Control-Allow-Credentials", "true")
 				}
 				w.Header().Add("Vary", "Origin")
+				// Lets the frontend read an image's content-addressed URL.
+				w.Header().Set("Access-Control-Expose-Headers", "ETag, Content-Location")
 			}
 
 			if r.Method == http.MethodOptions {
@@ -0,0 +1,125 @@
+package httpapi
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"qr-service/internal/model"
+	"qr-service/internal/qrdecode"
+	"qr-service/internal/store"
+)
+
+const (
+	pngContentType  = "image/png"
+	jpegContentType = "image/jpeg"
+
+	maxDecodeBytes = 10 << 20
+	// maxDecodePixels admits 48MP phone photos while keeping a small file
+	// that claims huge dimensions from being decoded into memory.
+	maxDecodePixels = 50_000_000
+)
+
+type decodedSymbol struct {
+	// Text is the symbol's payload.
+	Text string `json:"text"`
+	// QrCodeID is set when Text is one of our tracking links.
+	QrCodeID string `json:"qrCodeId,omitempty"`
+	// QrCode is the linked code, when it belongs to the caller.
+	QrCode *model.QrCode `json:"qrCode,omitempty"`
+}
+
+type decodeResponse struct {
+	Symbols []decodedSymbol `json:"symbols"`
+}
+
+// decodeHandler serves POST /api/qr-codes/decode. The body is a PNG or JPEG
+// photo; every QR code found in it is returned, and tracking links are
+// matched to the caller's codes. Codes the caller doesn't own, or can't see
+// in a workspace, are reported by ID only.
+func (srv *Server) decodeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDecodeBytes))
+	if err != nil {
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "file_too_large"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "image_invalid"})
+		return
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "image_invalid"})
+		return
+	}
+	if cfg.Width*cfg.Height > maxDecodePixels {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "image_too_large"})
+		return
+	}
+	img, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "image_invalid"})
+		return
+	}
+	payloads, err := qrdecode.Decode(img)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "image_invalid"})
+		return
+	}
+
+	acc := srv.accessFor(r)
+	resp := decodeResponse{Symbols: make([]decodedSymbol, 0, len(payloads))}
+	for _, text := range payloads {
+		sym := decodedSymbol{Text: text}
+		if id, ok := srv.trackingID(text); ok {
+			sym.QrCodeID = id
+			q, err := srv.Store.Get(r.Context(), id)
+			switch {
+			case errors.Is(err, store.ErrNotFound):
+			case err != nil:
+				writeStoreError(w, err, "get_failed")
+				return
+			case acc.canView(q):
+				q = q.NormalizeForResponse()
+				sym.QrCode = &q
+			}
+		}
+		resp.Symbols = append(resp.Symbols, sym)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// trackingID returns the code ID when text is a link trackingURL produced:
+// the click-service origin followed by /r/{id}. Query strings and fragments,
+// which some printers append, are ignored.
+func (srv *Server) trackingID(text string) (string, bool) {
+	base, err := url.Parse(strings.TrimRight(srv.ClickBaseURL, "/"))
+	if err != nil || base.Host == "" {
+		return "", false
+	}
+	u, err := url.Parse(strings.TrimSpace(text))
+	if err != nil || !strings.EqualFold(u.Host, base.Host) {
+		return "", false
+	}
+	rest, ok := strings.CutPrefix(u.EscapedPath(), base.EscapedPath()+"/r/")
+	if !ok {
+		return "", false
+	}
+	id, err := url.PathUnescape(strings.TrimSuffix(rest, "/"))
+	if err != nil || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
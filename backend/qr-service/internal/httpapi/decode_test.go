@@ -0,0 +1,134 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/render"
+	"qr-service/internal/store"
+)
+
+// photoOf lays the codes for contents out in a row on a grey background,
+// roughly what a photo of printed labels looks like, and encodes it with enc.
+func photoOf(t *testing.T, enc func(*bytes.Buffer, image.Image) error, contents ...string) []byte {
+	t.Helper()
+	const scale, gap = 6, 40
+	var codes []image.Image
+	width, height := gap, 0
+	for _, c := range contents {
+		m, err := render.Encode(c)
+		if err != nil {
+			t.Fatalf("encode: %v", err)
+		}
+		var buf bytes.Buffer
+		_ = render.WritePNG(&buf, m, scale)
+		img, _ := png.Decode(&buf)
+		codes = append(codes, img)
+		width += img.Bounds().Dx() + gap
+		height = max(height, img.Bounds().Dy()+2*gap)
+	}
+	photo := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(photo, photo.Bounds(), image.NewUniform(color.Gray{Y: 0xe0}), image.Point{}, draw.Src)
+	x := gap
+	for _, img := range codes {
+		draw.Draw(photo, img.Bounds().Add(image.Pt(x, gap)), img, image.Point{}, draw.Src)
+		x += img.Bounds().Dx() + gap
+	}
+	var buf bytes.Buffer
+	if err := enc(&buf, photo); err != nil {
+		t.Fatalf("encode photo: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(buf *bytes.Buffer, img image.Image) error { return png.Encode(buf, img) }
+func encodeJPEG(buf *bytes.Buffer, img image.Image) error {
+	return jpeg.Encode(buf, img, &jpeg.Options{Quality: 90})
+}
+
+func decodeCall(h http.Handler, contentType, userID string, body []byte) (*httptest.ResponseRecorder, decodeResponse) {
+	req := httptest.NewRequest(http.MethodPost, "/api/qr-codes/decode", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-User-Id", userID)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	var resp decodeResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	return w, resp
+}
+
+func TestDecode_MatchesCallersCodes(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://qr.example.com/", Workspaces: teamRoles})
+	mine, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "ed", Label: "Spring menu", Campaign: "spring", URL: "https://example.com/menu"})
+	theirs, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "bob", URL: "https://example.com/bob"})
+	team, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "olga", WorkspaceID: "ws1", URL: "https://example.com/team"})
+
+	photo := photoOf(t, encodeJPEG,
+		"https://QR.example.com/r/"+mine.ID+"?utm_source=print",
+		"https://qr.example.com/r/"+theirs.ID,
+		"https://qr.example.com/r/"+team.ID,
+		"https://elsewhere.example.com/r/"+mine.ID,
+	)
+	w, resp := decodeCall(r, "image/jpeg", "ed", photo)
+	if w.Code != http.StatusOK || len(resp.Symbols) != 4 {
+		t.Fatalf("unexpected %d %s", w.Code, w.Body.String())
+	}
+	byText := map[string]decodedSymbol{}
+	for _, sym := range resp.Symbols {
+		byText[sym.Text] = sym
+	}
+	if sym := byText["https://QR.example.com/r/"+mine.ID+"?utm_source=print"]; sym.QrCode == nil || sym.QrCode.Campaign != "spring" || sym.QrCode.CreatedAtIso == "" {
+		t.Fatalf("own code not matched: %+v", sym)
+	}
+	if sym := byText["https://qr.example.com/r/"+theirs.ID]; sym.QrCodeID != theirs.ID || sym.QrCode != nil {
+		t.Fatalf("someone else's code should be reported by ID only: %+v", sym)
+	}
+	if sym := byText["https://qr.example.com/r/"+team.ID]; sym.QrCode == nil || sym.QrCode.WorkspaceID != "ws1" {
+		t.Fatalf("workspace code not matched for a member: %+v", sym)
+	}
+	if sym := byText["https://elsewhere.example.com/r/"+mine.ID]; sym.QrCodeID != "" {
+		t.Fatalf("foreign link matched: %+v", sym)
+	}
+
+	// Outside the workspace the team code is reported by ID only.
+	_, resp = decodeCall(r, "image/png", "stranger", photoOf(t, encodePNG, "https://qr.example.com/r/"+team.ID))
+	if len(resp.Symbols) != 1 || resp.Symbols[0].QrCodeID != team.ID || resp.Symbols[0].QrCode != nil {
+		t.Fatalf("unexpected %+v", resp)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	r := NewRouter(Server{Store: store.NewMemoryStore(), ClickBaseURL: "https://qr.example.com"})
+
+	blank := image.NewGray(image.Rect(0, 0, 100, 100))
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, blank)
+	if w, resp := decodeCall(r, "image/png", "ed", buf.Bytes()); w.Code != http.StatusOK || resp.Symbols == nil || len(resp.Symbols) != 0 {
+		t.Fatalf("no code: unexpected %d %s", w.Code, w.Body.String())
+	}
+
+	cases := []struct {
+		name, contentType string
+		body              []byte
+		status            int
+	}{
+		{"not an image", "image/png", []byte("hello"), http.StatusBadRequest},
+		{"wrong type", "image/gif", buf.Bytes(), http.StatusUnsupportedMediaType},
+		{"too big", "image/png", make([]byte, maxDecodeBytes+1), http.StatusRequestEntityTooLarge},
+	}
+	for _, tc := range cases {
+		if w, _ := decodeCall(r, tc.contentType, "ed", tc.body); w.Code != tc.status {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.status, w.Code)
+		}
+	}
+}
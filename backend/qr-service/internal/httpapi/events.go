@@ -0,0 +1,110 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"qr-service/internal/model"
+)
+
+const (
+	defaultEventsLimit = 100
+	maxEventsLimit     = 1000
+	// maxEventsWait stays under the server's 15s write timeout.
+	maxEventsWait = 10 * time.Second
+	// eventsPollInterval is how often a waiting request re-reads the store
+	// when no EventHub wakes it, e.g. with SQLite or the memory store.
+	eventsPollInterval = time.Second
+)
+
+type eventsResponse struct {
+	Events []model.ChangeEvent `json:"events"`
+	// Next is the cursor to pass as after on the next call: the last event's
+	// ID, or the request's own cursor when there were none.
+	Next int64 `json:"next"`
+}
+
+// eventsHandler serves GET /api/events, the replayable change feed.
+// Subscribers pass the last ID they handled as after; with wait set, a call
+// that finds nothing new holds on until an event is committed or the wait
+// runs out.
+func (srv *Server) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !srv.requireAdmin(w, r) {
+		return
+	}
+
+	qs := r.URL.Query()
+	var after int64
+	if v := qs.Get("after"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "after_invalid"})
+			return
+		}
+		after = n
+	}
+	limit := defaultEventsLimit
+	if v := qs.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxEventsLimit {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "limit_invalid"})
+			return
+		}
+		limit = n
+	}
+	var wait time.Duration
+	if v := qs.Get("wait"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || time.Duration(n)*time.Second > maxEventsWait {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "wait_invalid"})
+			return
+		}
+		wait = time.Duration(n) * time.Second
+	}
+
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+	for {
+		var woken <-chan struct{}
+		if srv.EventHub != nil {
+			woken = srv.EventHub.Wait()
+		}
+		events, err := srv.Store.EventsAfter(r.Context(), after, limit)
+		if err != nil {
+			writeStoreError(w, err, "events_failed")
+			return
+		}
+		if len(events) > 0 || wait == 0 {
+			writeEvents(w, events, after)
+			return
+		}
+
+		poll := time.NewTimer(eventsPollInterval)
+		select {
+		case <-woken:
+		case <-poll.C:
+		case <-deadline.C:
+			poll.Stop()
+			writeEvents(w, nil, after)
+			return
+		case <-r.Context().Done():
+			poll.Stop()
+			return
+		}
+		poll.Stop()
+	}
+}
+
+func writeEvents(w http.ResponseWriter, events []model.ChangeEvent, after int64) {
+	resp := eventsResponse{Events: make([]model.ChangeEvent, 0, len(events)), Next: after}
+	for _, e := range events {
+		resp.Events = append(resp.Events, e.NormalizeForResponse())
+		resp.Next = e.ID
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
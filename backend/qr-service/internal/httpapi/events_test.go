@@ -0,0 +1,96 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"qr-service/internal/events"
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func getEvents(t *testing.T, h http.Handler, query string) eventsResponse {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, adminRequest(http.MethodGet, "/api/events"+query, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("events%s: expected %d, got %d: %s", query, http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp eventsResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return resp
+}
+
+func TestEvents_FeedPagesThroughChanges(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodGet, "/api/events", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected %d without the admin key, got %d", http.StatusUnauthorized, w.Code)
+	}
+
+	created := httptest.NewRecorder()
+	r.ServeHTTP(created, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"label": "Menu", "url": "https://example.com/menu"}))
+	var q model.QrCode
+	_ = json.NewDecoder(created.Body).Decode(&q)
+	r.ServeHTTP(httptest.NewRecorder(), jsonRequest(http.MethodPatch, "/api/qr-codes/"+q.ID, map[string]any{"label": "Menu 2"}))
+	r.ServeHTTP(httptest.NewRecorder(), jsonRequest(http.MethodDelete, "/api/qr-codes/"+q.ID, nil))
+
+	first := getEvents(t, r, "?limit=2")
+	if len(first.Events) != 2 || first.Events[0].Type != model.EventQrCodeCreated || first.Events[0].QrCodeID != q.ID || first.Events[0].AtIso == "" {
+		t.Fatalf("unexpected first page %+v", first)
+	}
+	rest := getEvents(t, r, "?after="+strconv.FormatInt(first.Next, 10))
+	if len(rest.Events) != 1 || rest.Events[0].Type != model.EventQrCodeDeleted {
+		t.Fatalf("unexpected second page %+v", rest)
+	}
+	if empty := getEvents(t, r, "?after="+strconv.FormatInt(rest.Next, 10)); len(empty.Events) != 0 || empty.Next != rest.Next {
+		t.Fatalf("expected an empty page that keeps the cursor, got %+v", empty)
+	}
+
+	for _, query := range []string{"?after=-1", "?limit=0", "?limit=1001", "?wait=11", "?wait=x"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, adminRequest(http.MethodGet, "/api/events"+query, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestEvents_WaitReturnsOnNotify(t *testing.T) {
+	s := store.NewMemoryStore()
+	hub := &events.Hub{}
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k", EventHub: hub})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, adminRequest(http.MethodGet, "/api/events?wait=10", nil))
+		done <- w
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if _, err := s.Create(context.Background(), store.CreateInput{URL: "https://example.com"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	hub.Notify()
+
+	select {
+	case w := <-done:
+		var resp eventsResponse
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+		if w.Code != http.StatusOK || len(resp.Events) != 1 || resp.Next != resp.Events[0].ID {
+			t.Fatalf("unexpected %d %+v", w.Code, resp)
+		}
+	case <-time.After(eventsPollInterval / 2):
+		t.Fatal("waiting request wasn't woken by the hub")
+	}
+}
@@ -0,0 +1,220 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+	"qr-service/internal/render"
+)
+
+// maxPdfExportCodes bounds a single export so one request can't pin a CPU
+// rendering tens of thousands of labels.
+const maxPdfExportCodes = 2000
+
+type pdfExportRequest struct {
+	IDs    []string         `json:"ids,omitempty"`
+	Filter *qrCodeFilter    `json:"filter,omitempty"`
+	Layout pdfLayoutRequest `json:"layout"`
+}
+
+// pdfLayoutRequest starts from a named template (if any) and overrides
+// individual dimensions. Dimensions are in millimetres.
+type pdfLayoutRequest struct {
+	Template string `json:"template,omitempty"`
+	PageSize string `json:"pageSize,omitempty"`
+
+	Columns       *int     `json:"columns,omitempty"`
+	Rows          *int     `json:"rows,omitempty"`
+	LabelWidthMm  *float64 `json:"labelWidthMm,omitempty"`
+	LabelHeightMm *float64 `json:"labelHeightMm,omitempty"`
+	MarginTopMm   *float64 `json:"marginTopMm,omitempty"`
+	MarginLeftMm  *float64 `json:"marginLeftMm,omitempty"`
+	PitchXMm      *float64 `json:"pitchXMm,omitempty"`
+	PitchYMm      *float64 `json:"pitchYMm,omitempty"`
+	BleedMm       *float64 `json:"bleedMm,omitempty"`
+	CodeSizeMm    *float64 `json:"codeSizeMm,omitempty"`
+	FontSizePt    *float64 `json:"fontSizePt,omitempty"`
+
+	// LabelText picks the caption: "label" (default), "url", "id" or "none".
+	LabelText string `json:"labelText,omitempty"`
+}
+
+func (req pdfLayoutRequest) resolve() (render.SheetLayout, error) {
+	var layout render.SheetLayout
+	if name := strings.ToLower(strings.TrimSpace(req.Template)); name != "" {
+		tmpl, ok := render.SheetTemplates[name]
+		if !ok {
+			return render.SheetLayout{}, errors.New("template_unknown")
+		}
+		layout = tmpl
+	}
+	if req.PageSize != "" {
+		w, h, ok := render.PageSize(strings.ToLower(strings.TrimSpace(req.PageSize)))
+		if !ok {
+			return render.SheetLayout{}, errors.New("page_size_unknown")
+		}
+		layout.PageWidthMm, layout.PageHeightMm = w, h
+	}
+
+	setInt := func(dst *int, v *int) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	setFloat := func(dst *float64, v *float64) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	setInt(&layout.Columns, req.Columns)
+	setInt(&layout.Rows, req.Rows)
+	setFloat(&layout.LabelWidthMm, req.LabelWidthMm)
+	setFloat(&layout.LabelHeightMm, req.LabelHeightMm)
+	setFloat(&layout.MarginTopMm, req.MarginTopMm)
+	setFloat(&layout.MarginLeftMm, req.MarginLeftMm)
+	setFloat(&layout.PitchXMm, req.PitchXMm)
+	setFloat(&layout.PitchYMm, req.PitchYMm)
+	setFloat(&layout.BleedMm, req.BleedMm)
+	setFloat(&layout.CodeSizeMm, req.CodeSizeMm)
+	setFloat(&layout.FontSizePt, req.FontSizePt)
+
+	// Butted labels are the common custom case; default the pitch to the label size.
+	if layout.PitchXMm == 0 {
+		layout.PitchXMm = layout.LabelWidthMm
+	}
+	if layout.PitchYMm == 0 {
+		layout.PitchYMm = layout.LabelHeightMm
+	}
+	if layout.PageWidthMm == 0 {
+		layout.PageWidthMm, layout.PageHeightMm, _ = render.PageSize("letter")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(req.LabelText)) {
+	case "", "label", "url", "id":
+		if layout.FontSizePt == 0 && req.FontSizePt == nil {
+			layout.FontSizePt = 8
+		}
+	case "none":
+		layout.FontSizePt = 0
+	default:
+		return render.SheetLayout{}, errors.New("label_text_invalid")
+	}
+
+	if err := layout.Validate(); err != nil {
+		return render.SheetLayout{}, errors.New("layout_invalid")
+	}
+	return layout, nil
+}
+
+func captionFor(q model.QrCode, labelText string) string {
+	switch strings.ToLower(strings.TrimSpace(labelText)) {
+	case "url":
+		return q.URL
+	case "id":
+		return q.ID
+	case "none":
+		return ""
+	default:
+		return q.Label
+	}
+}
+
+func (srv *Server) pdfExportHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.ClickBaseURL == "" {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "click_base_url_not_configured"})
+		return
+	}
+
+	var req pdfExportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	layout, err := req.Layout.resolve()
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	if len(req.IDs) == 0 && !req.Filter.narrows() {
+		// Never print every code by accident.
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "selection_required"})
+		return
+	}
+
+	acc := srv.accessFor(r)
+	scope := workspaceFromRequest(r)
+	if !acc.allow(w, scope, workspace.RoleViewer) {
+		return
+	}
+	items, missing, err := srv.selectQrCodes(r.Context(), req.IDs, req.Filter)
+	if err != nil {
+		writeStoreError(w, err, "list_failed")
+		return
+	}
+	if len(req.IDs) == 0 {
+		items = acc.inScope(items, scope)
+	} else {
+		// Codes the caller can't see are reported as missing.
+		visible := items[:0]
+		for _, q := range items {
+			if acc.canView(q) {
+				visible = append(visible, q)
+			} else {
+				missing = append(missing, q.ID)
+			}
+		}
+		items = visible
+	}
+	if len(missing) > 0 {
+		writeJSON(w, http.StatusNotFound, map[string]any{"error": "not_found", "ids": missing})
+		return
+	}
+	if len(items) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "no_codes_selected"})
+		return
+	}
+	if len(items) > maxPdfExportCodes {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "too_many_codes"})
+		return
+	}
+
+	// Each code is drawn as GET /image draws it, in its style and frame;
+	// the sheet picks the size.
+	labels := make([]render.SheetLabel, 0, len(items))
+	for _, q := range items {
+		a, err := srv.artworkFor(q, imageOptions{sizeMm: defaultImageSizeMm, quietZone: -1})
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "render_failed"})
+			return
+		}
+		labels = append(labels, render.SheetLabel{Artwork: a, Caption: captionFor(q, req.Layout.LabelText)})
+	}
+
+	// Render into memory first so a failure can still produce a JSON error.
+	var buf bytes.Buffer
+	if err := render.WriteSheetPDF(&buf, layout, labels); err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "render_failed"})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `attachment; filename="qr-codes.pdf"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// trackingURL is the click-service redirect encoded into printed codes.
+func (srv *Server) trackingURL(id string) string {
+	return strings.TrimRight(srv.ClickBaseURL, "/") + "/r/" + url.PathEscape(id)
+}
@@ -0,0 +1,97 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/store"
+)
+
+func TestPdfExport_RendersSelectedCodes(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		created, err := s.Create(context.Background(), store.CreateInput{Label: "Store #1", URL: "https://example.com"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	body, _ := json.Marshal(map[string]any{
+		"ids":    ids,
+		"layout": map[string]any{"template": "avery-5160", "bleedMm": 1},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/qr-codes/export/pdf", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/pdf" {
+		t.Fatalf("expected application/pdf, got %q", ct)
+	}
+	if !bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")) {
+		t.Fatalf("expected a PDF document")
+	}
+}
+
+func TestPdfExport_RejectsBadLayouts(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	if _, err := s.Create(context.Background(), store.CreateInput{Label: "x", URL: "https://example.com"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	cases := map[string]map[string]any{
+		"template_unknown": {"template": "avery-0000"},
+		// A 30mm code can't fit on a 1" label.
+		"layout_invalid": {"template": "avery-5160", "codeSizeMm": 30},
+	}
+	for want, layout := range cases {
+		body, _ := json.Marshal(map[string]any{"layout": layout, "filter": map[string]any{"active": true}})
+		req := httptest.NewRequest(http.MethodPost, "/api/qr-codes/export/pdf", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected %d, got %d", want, http.StatusBadRequest, w.Code)
+		}
+		var resp errResp
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+		if resp.Error != want {
+			t.Fatalf("expected %s, got %q", want, resp.Error)
+		}
+	}
+}
+
+func TestPdfExport_RequiresASelection(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	if _, err := s.Create(context.Background(), store.CreateInput{Label: "x", URL: "https://example.com"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// A filter with no conditions would select every code, so it doesn't count.
+	for _, body := range []map[string]any{
+		{"layout": map[string]any{"template": "avery-5160"}},
+		{"layout": map[string]any{"template": "avery-5160"}, "filter": map[string]any{}},
+		{"layout": map[string]any{"template": "avery-5160"}, "filter": map[string]any{"search": "  "}},
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes/export/pdf", body))
+		var resp errResp
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+		if w.Code != http.StatusBadRequest || resp.Error != "selection_required" {
+			t.Fatalf("%v: expected selection_required, got %d %q", body, w.Code, resp.Error)
+		}
+	}
+}
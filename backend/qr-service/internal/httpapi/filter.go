@@ -0,0 +1,79 @@
+package httpapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"qr-service/internal/model"
+)
+
+// qrCodeFilter selects codes for batch endpoints. Zero-value fields match everything.
+type qrCodeFilter struct {
+	Active   *bool  `json:"active,omitempty"`
+	Campaign string `json:"campaign,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	// Search matches a case-insensitive substring of the label or URL.
+	Search string `json:"search,omitempty"`
+}
+
+// narrows reports whether f sets any condition; a nil or empty filter
+// matches every code, so it doesn't count as a selection.
+func (f *qrCodeFilter) narrows() bool {
+	return f != nil && (f.Active != nil || f.Campaign != "" || f.Tag != "" || strings.TrimSpace(f.Search) != "")
+}
+
+func (f qrCodeFilter) matches(q model.QrCode) bool {
+	if f.Active != nil && q.Active != *f.Active {
+		return false
+	}
+	if f.Campaign != "" && q.Campaign != f.Campaign {
+		return false
+	}
+	if f.Tag != "" && !slices.Contains(q.Tags, f.Tag) {
+		return false
+	}
+	if search := strings.ToLower(strings.TrimSpace(f.Search)); search != "" {
+		if !strings.Contains(strings.ToLower(q.Label), search) && !strings.Contains(strings.ToLower(q.URL), search) {
+			return false
+		}
+	}
+	return true
+}
+
+// selectQrCodes resolves an explicit ID list or a filter against the store.
+// IDs keep the caller's order; unknown IDs are reported back.
+func (srv *Server) selectQrCodes(ctx context.Context, ids []string, filter *qrCodeFilter) (items []model.QrCode, missing []string, err error) {
+	all, err := srv.Store.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ids) == 0 {
+		f := qrCodeFilter{}
+		if filter != nil {
+			f = *filter
+		}
+		items = make([]model.QrCode, 0, len(all))
+		for _, q := range all {
+			if f.matches(q) {
+				items = append(items, q)
+			}
+		}
+		return items, nil, nil
+	}
+
+	byID := make(map[string]model.QrCode, len(all))
+	for _, q := range all {
+		byID[q.ID] = q
+	}
+	items = make([]model.QrCode, 0, len(ids))
+	for _, id := range ids {
+		q, ok := byID[strings.TrimSpace(id)]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		items = append(items, q)
+	}
+	return items, missing, nil
+}
@@ -0,0 +1,74 @@
+package httpapi
+
+import (
+	"image/color"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"qr-service/internal/model"
+	"qr-service/internal/render"
+)
+
+// maxCaptionLength keeps a caption to one line at a readable size.
+const maxCaptionLength = 40
+
+// cleanFrame normalizes f in place and reports whether it's usable: a known
+// border, position and font, a #rrggbb color and a printable caption of at
+// most maxCaptionLength characters. An empty frame is fine; it clears the
+// frame.
+func cleanFrame(f *model.QrFrame) bool {
+	for _, v := range []*string{&f.Border, &f.CaptionPosition, &f.Font, &f.Color} {
+		*v = strings.ToLower(strings.TrimSpace(*v))
+	}
+	f.Caption = strings.TrimSpace(f.Caption)
+	if utf8.RuneCountInString(f.Caption) > maxCaptionLength || strings.IndexFunc(f.Caption, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return false
+	}
+	if f.Border != "" && !slices.Contains(model.FrameBorders, f.Border) {
+		return false
+	}
+	if f.Font != "" && !slices.Contains(model.FrameFonts, f.Font) {
+		return false
+	}
+	switch f.CaptionPosition {
+	case "", model.CaptionBelow, model.CaptionAbove:
+	default:
+		return false
+	}
+	if f.Color != "" {
+		if _, err := render.ParseHexColor(f.Color); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// frameFor draws q's frame in its own color, else the code's foreground.
+// The printed URL drops the scheme; phones add it back.
+func (srv *Server) frameFor(q model.QrCode, fg color.RGBA, fgInk render.CMYK) (*render.Frame, error) {
+	if q.Frame == nil {
+		return nil, nil
+	}
+	f := &render.Frame{
+		Border:       q.Frame.Border,
+		Caption:      q.Frame.Caption,
+		CaptionAbove: q.Frame.CaptionPosition == model.CaptionAbove,
+		Font:         q.Frame.FontOrDefault(),
+		Color:        fg,
+		Ink:          fgInk,
+	}
+	if q.Frame.Color != "" {
+		c, err := render.ParseHexColor(q.Frame.Color)
+		if err != nil {
+			return nil, err
+		}
+		f.Color, f.Ink = c, render.CMYKFromRGB(c)
+	}
+	if q.Frame.ShowURL {
+		u := srv.trackingURL(q.ID)
+		f.URL = strings.TrimPrefix(strings.TrimPrefix(u, "https://"), "http://")
+	}
+	return f, nil
+}
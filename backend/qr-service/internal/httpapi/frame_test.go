@@ -0,0 +1,91 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/qrdecode"
+	"qr-service/internal/store"
+)
+
+func TestFrame_Validation(t *testing.T) {
+	cases := []struct {
+		name  string
+		frame map[string]any
+	}{
+		{"unknown border", map[string]any{"border": "dashed"}},
+		{"unknown font", map[string]any{"font": "comic"}},
+		{"unknown position", map[string]any{"captionPosition": "left"}},
+		{"named color", map[string]any{"color": "red"}},
+		{"long caption", map[string]any{"caption": strings.Repeat("a", maxCaptionLength+1)}},
+		{"control characters", map[string]any{"caption": "Scan\nme"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRouter(Server{Store: store.NewMemoryStore()})
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "frame": tc.frame}))
+			var resp map[string]string
+			_ = json.Unmarshal(w.Body.Bytes(), &resp)
+			if w.Code != http.StatusBadRequest || resp["error"] != "frame_invalid" {
+				t.Fatalf("expected 400 frame_invalid, got %d %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestFrame_SavedAndRendered(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{
+		"url":   "https://example.com",
+		"frame": map[string]any{"border": " Banner ", "caption": " Scan me ", "captionPosition": "above", "color": "#C62828", "showUrl": true},
+	}))
+	var created model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &created)
+	if w.Code != http.StatusCreated || created.Frame == nil || created.Frame.Border != model.FrameBorderBanner || created.Frame.Caption != "Scan me" || created.Frame.Color != "#c62828" {
+		t.Fatalf("unexpected create %d %s", w.Code, w.Body.String())
+	}
+
+	// The frame makes the PNG taller than wide; the code inside still reads.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?size=60", nil))
+	img, err := png.Decode(bytes.NewReader(w.Body.Bytes()))
+	if w.Code != http.StatusOK || err != nil || img.Bounds().Dy() <= img.Bounds().Dx() {
+		t.Fatalf("unexpected png response %d %v", w.Code, err)
+	}
+	texts, err := qrdecode.Decode(img)
+	if err != nil || len(texts) != 1 || texts[0] != "https://click.example.com/r/"+created.ID {
+		t.Fatalf("expected the tracking url to decode, got %v %v", texts, err)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=svg", nil))
+	if !strings.Contains(w.Body.String(), `fill="#c62828"`) {
+		t.Fatalf("expected the frame color in the svg, got %.300s", w.Body.String())
+	}
+
+	// Clones keep the frame; an empty frame removes it.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes/"+created.ID+"/clone", map[string]any{}))
+	var clone model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &clone)
+	if clone.Frame == nil || clone.Frame.Caption != "Scan me" {
+		t.Fatalf("expected the clone to keep the frame, got %s", w.Body.String())
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"frame": map[string]any{}}))
+	var patched model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &patched)
+	if w.Code != http.StatusOK || patched.Frame != nil {
+		t.Fatalf("expected the frame cleared, got %d %s", w.Code, w.Body.String())
+	}
+}
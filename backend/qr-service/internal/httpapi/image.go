@@ -0,0 +1,310 @@
+package httpapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+	"qr-service/internal/render"
+)
+
+// Bounds on GET /api/qr-codes/{id}/image. Billboards aside, a metre covers
+// anything a code is printed on.
+const (
+	defaultImageSizeMm = 40
+	maxImageSizeMm     = 1000
+	defaultImageDPI    = 300
+	minImageDPI        = 72
+	maxImageDPI        = 2400
+	maxQuietZone       = 16
+)
+
+type imageFormat struct {
+	contentType string
+	ext         string
+}
+
+var imageFormats = map[string]imageFormat{
+	"png": {"image/png", "png"},
+	"svg": {"image/svg+xml", "svg"},
+	"eps": {"application/postscript", "eps"},
+	"pdf": {"application/pdf", "pdf"},
+}
+
+type imageOptions struct {
+	format string
+	sizeMm float64
+	dpi    float64
+	// quietZone is -1 for the symbology's own.
+	quietZone int
+}
+
+// parseImageOptions reads format, size, unit (mm or in), dpi and quietZone,
+// returning the error code for the first bad one.
+func parseImageOptions(r *http.Request) (imageOptions, string) {
+	q := r.URL.Query()
+	opts := imageOptions{format: "png", sizeMm: defaultImageSizeMm, dpi: defaultImageDPI, quietZone: -1}
+	if v := strings.ToLower(strings.TrimSpace(q.Get("format"))); v != "" {
+		if _, ok := imageFormats[v]; !ok {
+			return imageOptions{}, "format_invalid"
+		}
+		opts.format = v
+	}
+	unitMm := 1.0
+	switch strings.ToLower(strings.TrimSpace(q.Get("unit"))) {
+	case "", "mm":
+	case "in":
+		unitMm = 25.4
+	default:
+		return imageOptions{}, "unit_invalid"
+	}
+	if v := q.Get("size"); v != "" {
+		size, err := strconv.ParseFloat(v, 64)
+		if err != nil || !(size > 0) || size*unitMm > maxImageSizeMm {
+			return imageOptions{}, "size_invalid"
+		}
+		opts.sizeMm = size * unitMm
+	}
+	if v := q.Get("dpi"); v != "" {
+		dpi, err := strconv.ParseFloat(v, 64)
+		if err != nil || dpi < minImageDPI || dpi > maxImageDPI {
+			return imageOptions{}, "dpi_invalid"
+		}
+		opts.dpi = dpi
+	}
+	if v := q.Get("quietZone"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > maxQuietZone {
+			return imageOptions{}, "quiet_zone_invalid"
+		}
+		opts.quietZone = n
+	}
+	return opts, ""
+}
+
+// artworkFor draws q's tracking URL as its symbology in its style and frame.
+// Print inks are the style's CMYK colors, else converted from its screen
+// colors.
+func (srv *Server) artworkFor(q model.QrCode, opts imageOptions) (render.Artwork, error) {
+	var style model.QrStyle
+	if q.Style != nil {
+		style = *q.Style
+	}
+	fg, err := render.ParseHexColor(style.ForegroundOrDefault())
+	if err != nil {
+		return render.Artwork{}, err
+	}
+	bg, err := render.ParseHexColor(style.BackgroundOrDefault())
+	if err != nil {
+		return render.Artwork{}, err
+	}
+	m, err := render.EncodeSymbol(srv.trackingURL(q.ID), q.Symbology, style.ErrorCorrectionOrDefault())
+	if err != nil {
+		return render.Artwork{}, err
+	}
+	a := render.Artwork{
+		Matrix:    m,
+		Style:     render.Style{Foreground: fg, Background: bg, LogoScale: style.LogoScale},
+		QuietZone: m.QuietZone,
+		SizeMm:    opts.sizeMm,
+	}
+	if opts.quietZone >= 0 {
+		a.QuietZone = opts.quietZone
+	}
+	a.Ink.Foreground, a.Ink.Background = inkOf(style.ForegroundCmyk, fg), inkOf(style.BackgroundCmyk, bg)
+	if a.Frame, err = srv.frameFor(q, fg, a.Ink.Foreground); err != nil {
+		return render.Artwork{}, err
+	}
+	return a, nil
+}
+
+func inkOf(c *model.CmykColor, fallback color.RGBA) render.CMYK {
+	if c == nil {
+		return render.CMYKFromRGB(fallback)
+	}
+	return render.CMYK{C: c.C / 100, M: c.M / 100, Y: c.Y / 100, K: c.K / 100}
+}
+
+// RenderCache keeps rendered images by digest; rendercache.Cache implements
+// it.
+type RenderCache interface {
+	Get(key string) ([]byte, bool)
+	Put(key string, data []byte) error
+}
+
+// renderVersion is part of every image digest. Bump it when rendering
+// changes, so cached images, on disk and in browsers, are replaced.
+const renderVersion = 1
+
+// immutableMaxAge is how long a content-addressed image may be cached: a
+// year, the longest caches honour.
+const immutableMaxAge = 365 * 24 * 60 * 60
+
+// imageDigest is a hash of everything that decides q's image: the encoded
+// payload, symbology, style and frame, and the requested format and size.
+func (srv *Server) imageDigest(q model.QrCode, opts imageOptions) string {
+	key := struct {
+		Version   int            `json:"v"`
+		Payload   string         `json:"payload"`
+		Symbology string         `json:"symbology"`
+		Style     *model.QrStyle `json:"style,omitempty"`
+		Frame     *model.QrFrame `json:"frame,omitempty"`
+		Format    string         `json:"format"`
+		SizeMm    float64        `json:"sizeMm"`
+		DPI       float64        `json:"dpi,omitempty"`
+		QuietZone int            `json:"quietZone"`
+	}{renderVersion, srv.trackingURL(q.ID), q.NormalizeForResponse().Symbology, q.Style, q.Frame, opts.format, opts.sizeMm, 0, opts.quietZone}
+	if opts.format == "png" {
+		key.DPI = opts.dpi
+	}
+	b, _ := json.Marshal(key)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+// imagePath is the content-addressed URL of q's image; its query string
+// lets the image be rendered again after the render cache drops it.
+func imagePath(id, digest string, opts imageOptions) string {
+	v := url.Values{}
+	v.Set("format", opts.format)
+	v.Set("size", strconv.FormatFloat(opts.sizeMm, 'f', -1, 64))
+	if opts.format == "png" {
+		v.Set("dpi", strconv.FormatFloat(opts.dpi, 'f', -1, 64))
+	}
+	if opts.quietZone >= 0 {
+		v.Set("quietZone", strconv.Itoa(opts.quietZone))
+	}
+	return "/api/qr-codes/" + url.PathEscape(id) + "/image/" + digest + "?" + v.Encode()
+}
+
+// etagMatches reports whether an If-None-Match header lists etag. Weak
+// validators match too, as RFC 9110 asks for If-None-Match.
+func etagMatches(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// imageHandler serves GET /api/qr-codes/{id}/image: the code in its style as
+// PNG, SVG, EPS or PDF at an exact printed width. The response names its
+// content-addressed URL, /api/qr-codes/{id}/image/{digest}, in
+// Content-Location; that URL never changes content, so it's served as
+// immutable. A digest that no longer matches the code redirects to the
+// current one.
+func (srv *Server) imageHandler(w http.ResponseWriter, r *http.Request, id, digest string) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.ClickBaseURL == "" {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "click_base_url_not_configured"})
+		return
+	}
+	opts, code := parseImageOptions(r)
+	if code != "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+		return
+	}
+	q, ok := srv.getAuthorized(w, r, id, workspace.RoleViewer)
+	if !ok {
+		return
+	}
+	current := srv.imageDigest(q, opts)
+	location := imagePath(q.ID, current, opts)
+	if digest != "" && digest != current {
+		w.Header().Set("Cache-Control", "no-cache")
+		http.Redirect(w, r, location, http.StatusFound)
+		return
+	}
+
+	etag := `"` + current + `"`
+	cacheControl := "private, no-cache"
+	if digest != "" {
+		cacheControl = fmt.Sprintf("private, max-age=%d, immutable", immutableMaxAge)
+	}
+	setCaching := func() {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", cacheControl)
+		w.Header().Set("Content-Location", location)
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		setCaching()
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	body, ok := srv.renderImage(w, q, opts, current)
+	if !ok {
+		return
+	}
+	f := imageFormats[opts.format]
+	setCaching()
+	w.Header().Set("Content-Type", f.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s.%s"`, backupSlug(q), f.ext))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// renderImage draws q, or reads it from the render cache under digest, and
+// writes a JSON error when it can't.
+func (srv *Server) renderImage(w http.ResponseWriter, q model.QrCode, opts imageOptions, digest string) ([]byte, bool) {
+	if srv.RenderCache != nil {
+		if body, ok := srv.RenderCache.Get(digest); ok {
+			return body, true
+		}
+	}
+	a, err := srv.artworkFor(q, opts)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "render_failed"})
+		return nil, false
+	}
+	if width, height := a.RasterSize(opts.dpi); opts.format == "png" && max(width, height) > render.MaxRasterSide {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "image_too_large"})
+		return nil, false
+	}
+
+	// Render into memory first so a failure can still produce a JSON error.
+	var buf bytes.Buffer
+	switch opts.format {
+	case "png":
+		err = a.WritePNG(&buf, opts.dpi)
+	case "svg":
+		err = a.WriteSVG(&buf)
+	case "eps":
+		err = a.WriteEPS(&buf)
+	case "pdf":
+		err = a.WritePDF(&buf)
+	}
+	if errors.Is(err, render.ErrArtworkInvalid) {
+		// Fewer pixels than modules.
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "size_invalid"})
+		return nil, false
+	}
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "render_failed"})
+		return nil, false
+	}
+	if srv.RenderCache != nil {
+		// A full disk only costs the next request a render.
+		if err := srv.RenderCache.Put(digest, buf.Bytes()); err != nil {
+			log.Printf("render cache put failed key=%s err=%v", digest, err)
+		}
+	}
+	return buf.Bytes(), true
+}
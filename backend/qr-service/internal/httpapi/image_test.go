@@ -0,0 +1,188 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/qrdecode"
+	"qr-service/internal/rendercache"
+	"qr-service/internal/store"
+)
+
+func TestImage_Formats(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	created, _ := s.Create(context.Background(), store.CreateInput{Label: "Spring menu", URL: "https://example.com", Style: &model.QrStyle{
+		Foreground:     "#1a237e",
+		ForegroundCmyk: &model.CmykColor{C: 100, M: 80, K: 20},
+	}})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?size=1&unit=in&dpi=300", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "image/png" || !strings.Contains(w.Header().Get("Content-Disposition"), `filename="spring-menu.png"`) {
+		t.Fatalf("unexpected png response %d %v", w.Code, w.Header())
+	}
+	img, err := png.Decode(bytes.NewReader(w.Body.Bytes()))
+	if err != nil || img.Bounds().Dx() != 300 {
+		t.Fatalf("expected a 300 px png, got %v %v", img.Bounds(), err)
+	}
+	texts, err := qrdecode.Decode(img)
+	if err != nil || len(texts) != 1 || texts[0] != "https://click.example.com/r/"+created.ID {
+		t.Fatalf("expected the tracking url to decode, got %v %v", texts, err)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=eps&size=30&quietZone=0", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/postscript" {
+		t.Fatalf("unexpected eps response %d %s", w.Code, w.Body.String())
+	}
+	// 30 mm is 85.04 pt; the code's own CMYK wins over its hex color.
+	if body := w.Body.String(); !strings.Contains(body, "%%HiResBoundingBox: 0 0 85.0394 85.0394") || !strings.Contains(body, "1 0.8 0 0.2 setcmykcolor") {
+		t.Fatalf("unexpected eps:\n%.400s", body)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=pdf&size=2&unit=in", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/pdf" || !bytes.Contains(w.Body.Bytes(), []byte("/MediaBox [0 0 144.00 144.00]")) {
+		t.Fatalf("unexpected pdf response %d %.200s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=svg&size=25", nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `width="25mm"`) || !strings.Contains(w.Body.String(), `fill="#1a237e"`) {
+		t.Fatalf("unexpected svg response %d %.200s", w.Code, w.Body.String())
+	}
+}
+
+func TestImage_Validation(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	created, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com"})
+
+	cases := []struct {
+		query   string
+		errCode string
+	}{
+		{"format=gif", "format_invalid"},
+		{"unit=cm", "unit_invalid"},
+		{"size=0", "size_invalid"},
+		{"size=2000", "size_invalid"},
+		{"dpi=20", "dpi_invalid"},
+		{"quietZone=-1", "quiet_zone_invalid"},
+		{"size=1000&dpi=2400", "image_too_large"},
+		// Fewer pixels than modules.
+		{"size=2&dpi=72", "size_invalid"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?"+tc.query, nil))
+			var resp map[string]string
+			_ = json.Unmarshal(w.Body.Bytes(), &resp)
+			if w.Code != http.StatusBadRequest || resp["error"] != tc.errCode {
+				t.Fatalf("expected 400 %s, got %d %s", tc.errCode, w.Code, w.Body.String())
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/missing/image", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestImage_Symbologies(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	matrix, _ := s.Create(context.Background(), store.CreateInput{Label: "Crate", URL: "https://example.com", Symbology: model.SymbologyDataMatrix})
+	linear, _ := s.Create(context.Background(), store.CreateInput{Label: "Shelf", URL: "https://example.com", Symbology: model.SymbologyCode128})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+matrix.ID+"/image?size=30", nil))
+	img, err := png.Decode(bytes.NewReader(w.Body.Bytes()))
+	if w.Code != http.StatusOK || err != nil {
+		t.Fatalf("unexpected png response %d %v", w.Code, err)
+	}
+	text, ok, err := qrdecode.DecodeSymbol(img, model.SymbologyDataMatrix)
+	if !ok || err != nil || text != "https://click.example.com/r/"+matrix.ID {
+		t.Fatalf("expected the tracking url to decode, got %q %v %v", text, ok, err)
+	}
+
+	// Code 128 is wider than it's tall; size sets the width.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+linear.ID+"/image?size=300", nil))
+	img, err = png.Decode(bytes.NewReader(w.Body.Bytes()))
+	if w.Code != http.StatusOK || err != nil || img.Bounds().Dx() <= img.Bounds().Dy() {
+		t.Fatalf("unexpected png response %d %v %v", w.Code, err, img)
+	}
+	text, ok, _ = qrdecode.DecodeSymbol(img, model.SymbologyCode128)
+	if !ok || text != "https://click.example.com/r/"+linear.ID {
+		t.Fatalf("expected the tracking url to decode, got %q %v", text, ok)
+	}
+}
+
+func TestImage_Caching(t *testing.T) {
+	s := store.NewMemoryStore()
+	cache, err := rendercache.Open(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatalf("open cache: %v", err)
+	}
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com", RenderCache: cache})
+	created, _ := s.Create(context.Background(), store.CreateInput{Label: "Poster", URL: "https://example.com"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=eps&size=30", nil))
+	etag, location := w.Header().Get("ETag"), w.Header().Get("Content-Location")
+	if w.Code != http.StatusOK || etag == "" || w.Header().Get("Cache-Control") != "private, no-cache" || !strings.HasPrefix(location, "/api/qr-codes/"+created.ID+"/image/") {
+		t.Fatalf("unexpected response %d %v", w.Code, w.Header())
+	}
+	body := w.Body.String()
+	if cache.Size() == 0 {
+		t.Fatal("expected the image cached")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=eps&size=30", nil)
+	req.Header.Set("If-None-Match", `W/"other", `+etag)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 || w.Header().Get("ETag") != etag {
+		t.Fatalf("expected 304, got %d %v", w.Code, w.Header())
+	}
+
+	// The content-addressed URL serves the same bytes, cacheable for good.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, location, nil))
+	if w.Code != http.StatusOK || w.Body.String() != body || w.Header().Get("ETag") != etag || !strings.Contains(w.Header().Get("Cache-Control"), "immutable") {
+		t.Fatalf("unexpected hashed response %d %v", w.Code, w.Header())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=eps&size=31", nil))
+	if w.Header().Get("ETag") == etag {
+		t.Fatal("expected another size to have another etag")
+	}
+
+	// Restyling moves the image; the old URL redirects to the new one.
+	if _, err := s.Update(context.Background(), created.ID, store.UpdateInput{Style: &model.QrStyle{Foreground: "#1a237e"}}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, location, nil))
+	moved := w.Header().Get("Location")
+	if w.Code != http.StatusFound || moved == location || !strings.HasPrefix(moved, "/api/qr-codes/"+created.ID+"/image/") {
+		t.Fatalf("expected a redirect to the new image, got %d %q", w.Code, moved)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, moved, nil))
+	if w.Code != http.StatusOK || w.Body.String() == body || !strings.Contains(w.Body.String(), "setcmykcolor") {
+		t.Fatalf("expected the restyled image, got %d", w.Code)
+	}
+}
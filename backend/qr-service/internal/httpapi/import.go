@@ -0,0 +1,275 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+	"github.com/xuri/excelize/v2"
+
+	"qr-service/internal/store"
+)
+
+const (
+	csvContentType  = "text/csv"
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	maxImportRows  = 1000
+	maxImportBytes = 5 << 20
+)
+
+// Per-row outcomes in an import report.
+const (
+	importValid   = "valid"
+	importCreated = "created"
+	importFailed  = "failed"
+)
+
+type importRow struct {
+	// Row is the spreadsheet row number; the header is row 1.
+	Row    int    `json:"row"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Label  string `json:"label,omitempty"`
+	URL    string `json:"url,omitempty"`
+	// ID is set once the code has been created.
+	ID string `json:"id,omitempty"`
+}
+
+type importReport struct {
+	DryRun  bool        `json:"dryRun"`
+	Created int         `json:"created"`
+	Rows    []importRow `json:"rows"`
+}
+
+// importHandler serves POST /api/qr-codes/import. The body is a CSV or XLSX
+// file whose first row names the columns: url (required), label, active,
+// campaign and tags (separated by ";" or ","). Every row is validated like a
+// create and counted against the quota; if any row fails nothing is created.
+// Otherwise, unless ?dryRun=true, all rows are created in one transaction.
+func (srv *Server) importHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	dryRun := false
+	if v := r.URL.Query().Get("dryRun"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "dry_run_invalid"})
+			return
+		}
+		dryRun = b
+	}
+	scope := workspaceFromRequest(r)
+	if !srv.accessFor(r).allow(w, scope, workspace.RoleEditor) {
+		return
+	}
+
+	ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	records, err := readImportRecords(ct, http.MaxBytesReader(w, r.Body, maxImportBytes))
+	if err != nil {
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "file_too_large"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "file_invalid"})
+		return
+	}
+	if len(records) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "no_rows"})
+		return
+	}
+	cols := importColumns(records[0])
+	if _, ok := cols["url"]; !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "url_column_missing"})
+		return
+	}
+
+	type pending struct {
+		row   int
+		input store.CreateInput
+	}
+	var rows []importRow
+	var inputs []pending
+	for i, rec := range records[1:] {
+		if isBlankRecord(rec) {
+			continue
+		}
+		if len(rows) == maxImportRows {
+			writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "too_many_rows"})
+			return
+		}
+		row, input := parseImportRecord(i+2, rec, cols)
+		if row.Status != importFailed {
+			input.OwnerID = userIDFromRequest(r)
+			input.WorkspaceID = scope
+			inputs = append(inputs, pending{row: len(rows), input: input})
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "no_rows"})
+		return
+	}
+
+	// Count every valid row against the quota in file order, so the report
+	// shows exactly which rows don't fit.
+	qt := quotaForUserType(userTypeFromRequest(r))
+	total, err := srv.Store.CountTotal(r.Context(), userIDFromRequest(r))
+	if err != nil {
+		writeStoreError(w, err, "quota_check_failed")
+		return
+	}
+	active, err := srv.Store.CountActive(r.Context(), userIDFromRequest(r))
+	if err != nil {
+		writeStoreError(w, err, "quota_check_failed")
+		return
+	}
+	failed := len(inputs) < len(rows)
+	for _, p := range inputs {
+		isActive := p.input.Active == nil || *p.input.Active
+		switch {
+		case total >= qt.maxTotal:
+			rows[p.row].Status, rows[p.row].Error = importFailed, "quota_total_exceeded"
+		case isActive && active >= qt.maxActive:
+			rows[p.row].Status, rows[p.row].Error = importFailed, "quota_active_exceeded"
+		default:
+			total++
+			if isActive {
+				active++
+			}
+			continue
+		}
+		failed = true
+	}
+	if failed {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "import_rows_failed", "rows": rows})
+		return
+	}
+	if dryRun {
+		writeJSON(w, http.StatusOK, importReport{DryRun: true, Rows: rows})
+		return
+	}
+
+	batch := make([]store.CreateInput, 0, len(inputs))
+	for _, p := range inputs {
+		batch = append(batch, p.input)
+	}
+	created, err := srv.Store.CreateBatch(r.Context(), batch)
+	if err != nil {
+		writeStoreError(w, err, "create_failed")
+		return
+	}
+	for i, q := range created {
+		row := &rows[inputs[i].row]
+		row.Status, row.ID, row.Label = importCreated, q.ID, q.Label
+	}
+	writeJSON(w, http.StatusCreated, importReport{Created: len(created), Rows: rows})
+}
+
+// readImportRecords returns the cells of a CSV file or the first sheet of an
+// XLSX workbook.
+func readImportRecords(contentType string, body io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	switch contentType {
+	case csvContentType:
+		cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+		cr.FieldsPerRecord = -1
+		cr.TrimLeadingSpace = true
+		return cr.ReadAll()
+	case xlsxContentType:
+		f, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, nil
+		}
+		return f.GetRows(sheets[0])
+	}
+	return nil, errors.New("unsupported content type")
+}
+
+// importColumns maps lowercased header names to their column index.
+func importColumns(header []string) map[string]int {
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, dup := cols[name]; !dup && name != "" {
+			cols[name] = i
+		}
+	}
+	return cols
+}
+
+// parseImportRecord validates one data row with the create handler's rules.
+func parseImportRecord(rowNum int, rec []string, cols map[string]int) (importRow, store.CreateInput) {
+	cell := func(name string) string {
+		i, ok := cols[name]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+	row := importRow{Row: rowNum, Status: importValid, Label: cell("label"), URL: cell("url")}
+	fail := func(code string) (importRow, store.CreateInput) {
+		row.Status, row.Error = importFailed, code
+		return row, store.CreateInput{}
+	}
+
+	if row.URL == "" {
+		return fail("url_required")
+	}
+	if !isValidHTTPURL(row.URL) {
+		return fail("url_invalid")
+	}
+	input := store.CreateInput{Label: row.Label, URL: row.URL, Campaign: cell("campaign")}
+	if v := cell("active"); v != "" {
+		active, ok := parseImportBool(v)
+		if !ok {
+			return fail("active_invalid")
+		}
+		input.Active = &active
+	}
+	if v := cell("tags"); v != "" {
+		tags, ok := cleanTags(strings.FieldsFunc(v, func(r rune) bool { return r == ';' || r == ',' }))
+		if !ok {
+			return fail("tags_invalid")
+		}
+		input.Tags = tags
+	}
+	return row, input
+}
+
+// parseImportBool accepts the spellings spreadsheets commonly produce.
+func parseImportBool(v string) (bool, bool) {
+	switch strings.ToLower(v) {
+	case "true", "yes", "y", "1":
+		return true, true
+	case "false", "no", "n", "0":
+		return false, true
+	}
+	return false, false
+}
+
+func isBlankRecord(rec []string) bool {
+	for _, v := range rec {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"qr-service/internal/store"
+)
+
+func importRequest(contentType, query string, body []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/qr-codes/import"+query, bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+type importFailure struct {
+	Error string      `json:"error"`
+	Rows  []importRow `json:"rows"`
+}
+
+func TestImport_CSVDryRunThenCommit(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	csv := []byte("\xef\xbb\xbfLabel,URL,Active,Tags\n" +
+		"Menu,https://example.com/menu,yes,print;menu\n" +
+		",,,\n" +
+		"Door,https://example.com/door,false,\n")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, importRequest("text/csv; charset=utf-8", "?dryRun=true", csv))
+	var report importReport
+	_ = json.NewDecoder(w.Body).Decode(&report)
+	if w.Code != http.StatusOK || !report.DryRun || len(report.Rows) != 2 || report.Rows[1].Row != 4 || report.Rows[1].Status != importValid {
+		t.Fatalf("dry run: unexpected %d %+v", w.Code, report)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 0 {
+		t.Fatalf("dry run created %d codes", n)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, importRequest("text/csv", "", csv))
+	report = importReport{}
+	_ = json.NewDecoder(w.Body).Decode(&report)
+	if w.Code != http.StatusCreated || report.Created != 2 || report.Rows[0].ID == "" {
+		t.Fatalf("commit: unexpected %d %+v", w.Code, report)
+	}
+	menu, _ := s.Get(context.Background(), report.Rows[0].ID)
+	if !menu.Active || !slices.Equal(menu.Tags, []string{"print", "menu"}) {
+		t.Fatalf("commit: unexpected code %+v", menu)
+	}
+	if door, _ := s.Get(context.Background(), report.Rows[1].ID); door.Active {
+		t.Fatal("commit: active=false not applied")
+	}
+}
+
+func TestImport_BadRowsCreateNothing(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+
+	var b strings.Builder
+	b.WriteString("url,active\nhttps://example.com/ok,\nhttp://example.com/plain,\nhttps://example.com/x,maybe\n")
+	// The free plan allows 5 active codes; the sixth valid row doesn't fit.
+	for i := 0; i < 5; i++ {
+		b.WriteString("https://example.com/more,true\n")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, importRequest("text/csv", "", []byte(b.String())))
+	var resp importFailure
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if w.Code != http.StatusBadRequest || resp.Error != "import_rows_failed" {
+		t.Fatalf("unexpected %d %+v", w.Code, resp)
+	}
+	errs := make([]string, 0, len(resp.Rows))
+	for _, row := range resp.Rows {
+		errs = append(errs, row.Error)
+	}
+	want := []string{"", "url_invalid", "active_invalid", "", "", "", "", "quota_active_exceeded"}
+	if !slices.Equal(errs, want) {
+		t.Fatalf("row errors: got %q, want %q", errs, want)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 0 {
+		t.Fatalf("expected nothing created, got %d", n)
+	}
+
+	for _, tc := range []struct {
+		contentType string
+		body        string
+		want        int
+	}{
+		{"application/json", `{"url":"https://example.com"}`, http.StatusUnsupportedMediaType},
+		{"text/csv", "label\nMenu\n", http.StatusBadRequest},
+		{"text/csv", "url\n", http.StatusBadRequest},
+		{xlsxContentType, "not a workbook", http.StatusBadRequest},
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, importRequest(tc.contentType, "", []byte(tc.body)))
+		if w.Code != tc.want {
+			t.Fatalf("%s %q: expected %d, got %d", tc.contentType, tc.body, tc.want, w.Code)
+		}
+	}
+}
+
+func TestImport_XLSX(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+
+	f := excelize.NewFile()
+	defer f.Close()
+	_ = f.SetSheetRow("Sheet1", "A1", &[]any{"URL", "Label", "Active", "Tags"})
+	_ = f.SetSheetRow("Sheet1", "A2", &[]any{"https://example.com/a", "Poster", true, "spring, print"})
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatalf("write xlsx: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, importRequest(xlsxContentType, "", buf.Bytes()))
+	var report importReport
+	_ = json.NewDecoder(w.Body).Decode(&report)
+	if w.Code != http.StatusCreated || report.Created != 1 {
+		t.Fatalf("unexpected %d %s", w.Code, w.Body.String())
+	}
+	q, _ := s.Get(context.Background(), report.Rows[0].ID)
+	if q.Label != "Poster" || !q.Active || !slices.Equal(q.Tags, []string{"spring", "print"}) {
+		t.Fatalf("unexpected code %+v", q)
+	}
+}
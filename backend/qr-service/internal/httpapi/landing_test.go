@@ -0,0 +1,72 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func TestInactiveHandling_Validation(t *testing.T) {
+	cases := []struct {
+		name    string
+		body    map[string]any
+		errCode string
+	}{
+		{"fallback must be https", map[string]any{"fallbackUrl": "http://example.com"}, "fallback_url_invalid"},
+		{"logo must be https", map[string]any{"landingPage": map[string]string{"logoUrl": "data:image/png;base64,AA"}}, "landing_page_invalid"},
+		{"button must be https", map[string]any{"landingPage": map[string]string{"buttonUrl": "javascript:alert(1)"}}, "landing_page_invalid"},
+		{"button text needs a url", map[string]any{"landingPage": map[string]string{"buttonText": "Go"}}, "landing_page_invalid"},
+		{"title too long", map[string]any{"landingPage": map[string]string{"title": strings.Repeat("x", maxLandingTitleLen+1)}}, "landing_page_invalid"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRouter(Server{Store: store.NewMemoryStore()})
+			tc.body["url"] = "https://example.com"
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", tc.body))
+			var resp map[string]string
+			_ = json.Unmarshal(w.Body.Bytes(), &resp)
+			if w.Code != http.StatusBadRequest || resp["error"] != tc.errCode {
+				t.Fatalf("expected 400 %s, got %d %s", tc.errCode, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestInactiveHandling_PatchAndClone(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	created, _ := s.Create(context.Background(), store.CreateInput{Label: "Menu", URL: "https://example.com/menu"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"fallbackUrl": " https://example.com/closed ",
+		"landingPage": map[string]string{"title": " Closed ", "buttonText": "Shop", "buttonUrl": "https://example.com/shop"},
+	}))
+	var got model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &got)
+	if w.Code != http.StatusOK || got.FallbackURL != "https://example.com/closed" || got.LandingPage == nil || got.LandingPage.Title != "Closed" {
+		t.Fatalf("patch: unexpected %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes/"+created.ID+"/clone", map[string]any{}))
+	var clone model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &clone)
+	if w.Code != http.StatusCreated || clone.FallbackURL != got.FallbackURL || clone.LandingPage == nil || *clone.LandingPage != *got.LandingPage {
+		t.Fatalf("clone: unexpected %d %s", w.Code, w.Body.String())
+	}
+
+	// Empty values clear both.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"fallbackUrl": "", "landingPage": map[string]string{}}))
+	if q, _ := s.Get(context.Background(), created.ID); w.Code != http.StatusOK || q.FallbackURL != "" || q.LandingPage != nil {
+		t.Fatalf("clear: unexpected %d %+v", w.Code, q)
+	}
+}
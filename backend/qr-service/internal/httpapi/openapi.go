@@ -0,0 +1,22 @@
+package httpapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPISpec documents every route registered in NewRouter. Keep it in step
+// with the handlers; openapi_test.go validates live traffic against it.
+//
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+func openAPIHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(openAPISpec)
+}
@@ -0,0 +1,279 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func init() {
+	openapi3filter.RegisterBodyDecoder("application/pdf", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder(xlsxContentType, openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/zip", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/postscript", openapi3filter.FileBodyDecoder)
+}
+
+// specRouter loads openapi.yaml and fails the test if the document is invalid.
+func specRouter(t *testing.T) routers.Router {
+	t.Helper()
+	doc, err := openapi3.NewLoader().LoadFromData(openAPISpec)
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("invalid spec: %v", err)
+	}
+	// Match requests regardless of host.
+	doc.Servers = nil
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("router: %v", err)
+	}
+	return router
+}
+
+// serveValidated runs req through h and checks both sides of the exchange
+// against the spec.
+func serveValidated(t *testing.T, spec routers.Router, h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	route, params, err := spec.FindRoute(req)
+	if err != nil {
+		t.Fatalf("%s %s: not in spec: %v", req.Method, req.URL.Path, err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	in := &openapi3filter.RequestValidationInput{
+		Request: req, PathParams: params, Route: route,
+		// Bearer tokens are checked by apitoken.Middleware, not here.
+		Options: &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}
+	if err := openapi3filter.ValidateRequest(req.Context(), in); err != nil {
+		t.Fatalf("%s %s: request does not match spec: %v", req.Method, req.URL.Path, err)
+	}
+	out := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: in,
+		Status:                 w.Code,
+		Header:                 w.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(w.Body.Bytes())),
+	}
+	if err := openapi3filter.ValidateResponse(req.Context(), out); err != nil {
+		t.Fatalf("%s %s: %d response does not match spec: %v", req.Method, req.URL.Path, w.Code, err)
+	}
+	return w
+}
+
+func jsonRequest(method, path string, body any) *http.Request {
+	var r io.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		r = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, path, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req
+}
+
+func TestOpenAPI_TrafficMatchesSpec(t *testing.T) {
+	spec := specRouter(t)
+	h := NewRouter(Server{Store: store.NewMemoryStore(), AdminAPIKey: "k", ClickBaseURL: "https://click.example.com"})
+
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/healthz", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/openapi.yaml", nil))
+
+	w := serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{
+		"label":    "Menu",
+		"url":      "https://example.com/menu",
+		"campaign": "spring",
+		"tags":     []string{"menu"},
+		"utm":      map[string]string{"source": "qr", "content": "{qr_id}"},
+	}))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create: expected %d, got %d", http.StatusCreated, w.Code)
+	}
+	var created struct {
+		ID string `json:"id"`
+	}
+	_ = json.Unmarshal(w.Body.Bytes(), &created)
+
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{
+		"url":             "https://example.com/app",
+		"destinationType": "app_link",
+		"appLink":         map[string]string{"iosUrl": "myapp://open", "appStoreUrl": "https://apps.apple.com/app/id1"},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "ftp://nope"}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/"+created.ID, nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/missing", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"active": false}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"fallbackUrl": "https://example.com/closed",
+		"landingPage": map[string]string{"title": "Closed", "buttonText": "Shop", "buttonUrl": "https://example.com/shop"},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"landingPage": map[string]string{"logoUrl": "javascript:alert(1)"},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"style": map[string]any{"foreground": "#1a237e", "errorCorrection": "H", "logoScale": 0.2},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"style": map[string]any{"errorCorrection": "L", "logoScale": 0.4},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"style": map[string]any{"foregroundCmyk": map[string]float64{"c": 100, "m": 80, "y": 0, "k": 20}},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=eps&size=1&unit=in&quietZone=2", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?size=1000&dpi=2400", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"symbology": "code128"}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"frame": map[string]any{"border": "rounded", "caption": "Scan me", "font": "italic", "showUrl": true},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"frame": map[string]any{"caption": "Scan\tme"}}))
+	w = serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/"+created.ID+"/image?format=eps", nil))
+	hashed := w.Header().Get("Content-Location")
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, hashed, nil))
+	notModified := jsonRequest(http.MethodGet, hashed, nil)
+	notModified.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	serveValidated(t, spec, h, notModified)
+	serveValidated(t, spec, h, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"frame": map[string]any{}}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, hashed, nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "symbology": "data_matrix", "style": map[string]any{"logoScale": 0.2}}))
+	long := NewRouter(Server{Store: store.NewMemoryStore(), ClickBaseURL: "https://scans.a-rather-long-tracking-domain.example.com"})
+	serveValidated(t, spec, long, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "symbology": "code128"}))
+
+	serveValidated(t, spec, h, jsonRequest(http.MethodPut, "/api/settings", map[string]any{
+		"defaultRedirectUrl": "https://example.com",
+		"campaignUtm":        map[string]any{"spring": map[string]string{"medium": "print"}},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/settings", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/settings?ownerId=alice", nil))
+
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/"+created.ID+"/clone", map[string]any{"label": "Menu 2"}))
+	w = serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-templates", map[string]any{
+		"name":         "Doors",
+		"labelPattern": "{city} door",
+		"urlPattern":   "https://example.com/{city}",
+	}))
+	var tmpl struct {
+		ID string `json:"id"`
+	}
+	_ = json.Unmarshal(w.Body.Bytes(), &tmpl)
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-templates", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPut, "/api/qr-templates/"+tmpl.ID, map[string]any{
+		"name":       "Doors",
+		"urlPattern": "https://example.com/s/{city}",
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{
+		"instances": []map[string]any{{"variables": map[string]string{"city": "oslo"}}},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{
+		"instances": []map[string]any{{"label": "no city"}},
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-templates/"+tmpl.ID, nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodDelete, "/api/qr-templates/"+tmpl.ID, nil))
+
+	w = serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/export/pdf", map[string]any{
+		"ids":    []string{created.ID},
+		"layout": map[string]any{"template": "avery-5160"},
+	}))
+	if w.Code != http.StatusOK {
+		t.Fatalf("export: expected %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/export/pdf", map[string]any{
+		"ids": []string{"missing"},
+	}))
+
+	w = serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{
+		"action": "tag",
+		"filter": map[string]any{"tag": "menu"},
+		"tags":   map[string]any{"add": []string{"print"}},
+	}))
+	if w.Code != http.StatusOK {
+		t.Fatalf("bulk: expected %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{
+		"action":  "replace_url",
+		"ids":     []string{created.ID},
+		"replace": map[string]string{"find": "example.com", "replace": "example.org"},
+		"dryRun":  true,
+	}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "delete", "ids": []string{"missing"}}))
+
+	csv := []byte("label,url,tags\nImported,https://example.com/imported,menu\n")
+	if w := serveValidated(t, spec, h, importRequest("text/csv", "?dryRun=true", csv)); w.Code != http.StatusOK {
+		t.Fatalf("import dry run: expected %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if w := serveValidated(t, spec, h, importRequest("text/csv", "", csv)); w.Code != http.StatusCreated {
+		t.Fatalf("import: expected %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	serveValidated(t, spec, h, importRequest("text/csv", "", []byte("url\nftp://nope\n")))
+
+	decode := func(contentType string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/qr-codes/decode", bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("X-User-Id", "u1")
+		return req
+	}
+	if w := serveValidated(t, spec, h, decode("image/png", photoOf(t, encodePNG, "https://click.example.com/r/"+created.ID, "hello"))); w.Code != http.StatusOK {
+		t.Fatalf("decode: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	serveValidated(t, spec, h, decode("image/jpeg", []byte("not a photo")))
+
+	for _, format := range []string{"json", "csv", "zip"} {
+		serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/export?format="+format, nil))
+	}
+
+	admin := jsonRequest(http.MethodPost, "/api/admin/generate-sample-data", nil)
+	admin.Header.Set("X-Admin-Key", "k")
+	admin.Header.Set("Content-Type", "application/json")
+	serveValidated(t, spec, h, admin)
+	serveValidated(t, spec, h, adminRequest(http.MethodGet, "/api/admin/qr-codes?domain=example.com&limit=5", nil))
+	serveValidated(t, spec, h, adminRequest(http.MethodPost, "/api/admin/qr-codes/"+created.ID+"/disable", map[string]string{"reason": "spam"}))
+	serveValidated(t, spec, h, adminRequest(http.MethodPost, "/api/admin/qr-codes/"+created.ID+"/enable", map[string]string{}))
+	serveValidated(t, spec, h, adminRequest(http.MethodPost, "/api/admin/qr-codes/transfer", map[string]any{"ids": []string{created.ID}, "toOwnerId": "u2"}))
+	serveValidated(t, spec, h, adminRequest(http.MethodGet, "/api/admin/usage?userType=basic", nil))
+	serveValidated(t, spec, h, adminRequest(http.MethodGet, "/api/events?after=0&limit=2", nil))
+	serveValidated(t, spec, h, adminRequest(http.MethodGet, "/api/events?after=100000&wait=0", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "activate", "ids": []string{created.ID}}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodDelete, "/api/qr-codes/"+created.ID, nil))
+
+	// Workspace-scoped traffic.
+	h = NewRouter(Server{Store: store.NewMemoryStore(), Workspaces: teamRoles})
+	w = serveValidated(t, spec, h, asMember(jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com/team"}), "ed", "ws1"))
+	var team model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&team)
+	if w.Code != http.StatusCreated || team.WorkspaceID != "ws1" {
+		t.Fatalf("workspace create: %d %+v", w.Code, team)
+	}
+	serveValidated(t, spec, h, asMember(jsonRequest(http.MethodGet, "/api/qr-codes", nil), "vic", "ws1"))
+	serveValidated(t, spec, h, asMember(jsonRequest(http.MethodGet, "/api/qr-templates", nil), "vic", "ws1"))
+	serveValidated(t, spec, h, asMember(jsonRequest(http.MethodPatch, "/api/qr-codes/"+team.ID, map[string]any{"label": "x"}), "vic", ""))
+	serveValidated(t, spec, h, asMember(jsonRequest(http.MethodGet, "/api/qr-codes/"+team.ID, nil), "mallory", ""))
+	serveValidated(t, spec, h, asMember(jsonRequest(http.MethodGet, "/api/qr-codes", nil), "broken", "ws1"))
+	serveValidated(t, spec, NewRouter(Server{Store: store.NewMemoryStore()}), asMember(jsonRequest(http.MethodGet, "/api/qr-codes", nil), "ed", "ws1"))
+
+	// Store deadlines.
+	h = NewRouter(Server{Store: expiredStore{store.NewMemoryStore()}})
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/qr-codes/"+created.ID, nil))
+}
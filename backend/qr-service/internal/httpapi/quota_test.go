@@ -2,6 +2,7 @@ package httpapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -113,3 +114,49 @@ func TestQuota_Free_ActiveExceededOnActivate(t *testing.T) {
 		t.Fatalf("expected quota_active_exceeded, got %q", resp.Error)
 	}
 }
+
+func TestQuota_CountsOnlyTheCallersCodes(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	off := false
+	var bobs []string
+	for i := 0; i < 20; i++ {
+		created, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "bob", URL: "https://example.com", Active: &off})
+		bobs = append(bobs, created.ID)
+	}
+	for i := 0; i < 5; i++ {
+		_, _ = s.Create(context.Background(), store.CreateInput{OwnerID: "carol", URL: "https://example.com"})
+	}
+
+	// Other owners' codes don't use up alice's free plan.
+	send := func(req *http.Request) (int, string) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(req, "alice", ""))
+		var resp errResp
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp.Error
+	}
+	if code, e := send(jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com"})); code != http.StatusCreated {
+		t.Fatalf("create: expected %d, got %d %s", http.StatusCreated, code, e)
+	}
+	if code, e := send(importRequest("text/csv", "", []byte("Label,URL\nMenu,https://example.com/menu\n"))); code != http.StatusCreated {
+		t.Fatalf("import: expected %d, got %d %s", http.StatusCreated, code, e)
+	}
+	if n, _ := s.CountTotal(context.Background(), "alice"); n != 2 {
+		t.Fatalf("expected alice to own 2 codes, got %d", n)
+	}
+
+	// bob's own codes fill his.
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com"}), "bob", ""))
+	var resp errResp
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if w.Code != http.StatusForbidden || resp.Error != "quota_total_exceeded" {
+		t.Fatalf("bob: expected quota_total_exceeded, got %d %q", w.Code, resp.Error)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "activate", "ids": bobs[:5]}), "bob", ""))
+	if w.Code != http.StatusOK {
+		t.Fatalf("bob bulk activate: expected %d, got %d %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
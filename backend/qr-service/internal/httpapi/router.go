@@ -1,27 +1,67 @@
 package httpapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/events"
 	"qr-service/internal/middleware"
 	"qr-service/internal/model"
+	"qr-service/internal/seed"
 	"qr-service/internal/store"
 )
 
 type Server struct {
 	Store       store.Store
 	AdminAPIKey string
+	// ClickBaseURL is the public click-service origin encoded into rendered codes.
+	ClickBaseURL string
+	// Workspaces looks up team roles; nil makes workspace codes unavailable
+	// to everyone but admins.
+	Workspaces WorkspaceRoles
+	// Plans looks up owners' plans for the admin usage report; nil measures
+	// every owner against the plan the request names.
+	Plans OwnerPlans
+	// RedirectCache is told about changed codes so click-service stops
+	// serving them from its cache; nil skips that.
+	RedirectCache RedirectCache
+	// EventHub wakes /api/events requests that are waiting for new events;
+	// without it they re-read the store every second.
+	EventHub *events.Hub
+	// RenderCache keeps rendered images between requests; nil renders every
+	// request.
+	RenderCache RenderCache
 }
 
+const (
+	maxTagsPerCode = 20
+	maxTagLen      = 50
+
+	maxLandingTitleLen   = 100
+	maxLandingMessageLen = 1000
+	maxLandingButtonLen  = 40
+)
+
 type quota struct {
 	maxActive int
 	maxTotal  int
 }
 
+// OwnerPlans reads an owner's plan (userType); userplans.Client implements
+// it against user-service.
+type OwnerPlans interface {
+	UserType(ctx context.Context, userID string) (string, error)
+}
+
 func userTypeFromRequest(r *http.Request) string {
 	v := strings.TrimSpace(strings.ToLower(r.Header.Get("X-User-Type")))
 	if v == "" {
@@ -35,6 +75,13 @@ func userTypeFromRequest(r *http.Request) string {
 	}
 }
 
+// userIDFromRequest returns the caller's user ID, which apitoken.Middleware
+// sets from a verified API token or login session, or "" for anonymous
+// requests.
+func userIDFromRequest(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get("X-User-Id"))
+}
+
 func quotaForUserType(userType string) quota {
 	switch userType {
 	case "basic":
@@ -51,16 +98,82 @@ func quotaForUserType(userType string) quota {
 	}
 }
 
+// checkQuota reports whether newTotal more codes, newActive of them active,
+// fit within qt on top of the codes the caller owns (in any workspace, as
+// /api/admin/usage counts them); otherwise it writes the 403 (or 5xx) and
+// returns false.
+func (srv *Server) checkQuota(w http.ResponseWriter, r *http.Request, qt quota, newTotal, newActive int) bool {
+	total, err := srv.Store.CountTotal(r.Context(), userIDFromRequest(r))
+	if err != nil {
+		writeStoreError(w, err, "quota_check_failed")
+		return false
+	}
+	if total+newTotal > qt.maxTotal {
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "quota_total_exceeded"})
+		return false
+	}
+	return newActive == 0 || srv.checkActiveQuota(w, r, qt, newActive)
+}
+
+// checkActiveQuota is checkQuota for codes being switched on, which doesn't
+// change the total.
+func (srv *Server) checkActiveQuota(w http.ResponseWriter, r *http.Request, qt quota, newActive int) bool {
+	active, err := srv.Store.CountActive(r.Context(), userIDFromRequest(r))
+	if err != nil {
+		writeStoreError(w, err, "quota_check_failed")
+		return false
+	}
+	if active+newActive > qt.maxActive {
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "quota_active_exceeded"})
+		return false
+	}
+	return true
+}
+
 type createQrCodeRequest struct {
-	Label  string `json:"label"`
-	URL    string `json:"url"`
-	Active *bool  `json:"active,omitempty"`
+	Label    string             `json:"label"`
+	URL      string             `json:"url"`
+	Active   *bool              `json:"active,omitempty"`
+	Campaign string             `json:"campaign,omitempty"`
+	Tags     []string           `json:"tags,omitempty"`
+	Utm      *model.UtmTemplate `json:"utm,omitempty"`
+
+	DestinationType string         `json:"destinationType,omitempty"`
+	AppLink         *model.AppLink `json:"appLink,omitempty"`
+
+	FallbackURL string             `json:"fallbackUrl,omitempty"`
+	LandingPage *model.LandingPage `json:"landingPage,omitempty"`
+
+	Symbology string         `json:"symbology,omitempty"`
+	Style     *model.QrStyle `json:"style,omitempty"`
+	Frame     *model.QrFrame `json:"frame,omitempty"`
 }
 
 type updateQrCodeRequest struct {
-	Label  *string `json:"label"`
-	URL    *string `json:"url"`
-	Active *bool   `json:"active,omitempty"`
+	Label    *string            `json:"label"`
+	URL      *string            `json:"url"`
+	Active   *bool              `json:"active,omitempty"`
+	Campaign *string            `json:"campaign,omitempty"`
+	Tags     *[]string          `json:"tags,omitempty"`
+	Utm      *model.UtmTemplate `json:"utm,omitempty"`
+
+	DestinationType *string        `json:"destinationType,omitempty"`
+	AppLink         *model.AppLink `json:"appLink,omitempty"`
+
+	FallbackURL *string            `json:"fallbackUrl,omitempty"`
+	LandingPage *model.LandingPage `json:"landingPage,omitempty"`
+
+	Symbology *string        `json:"symbology,omitempty"`
+	Style     *model.QrStyle `json:"style,omitempty"`
+	Frame     *model.QrFrame `json:"frame,omitempty"`
+}
+
+// updateSettingsRequest leaves the stored campaign templates alone when
+// campaignUtm is omitted, so a form that only edits the default redirect
+// doesn't wipe them. Send {} to clear them.
+type updateSettingsRequest struct {
+	DefaultRedirectURL string                        `json:"defaultRedirectUrl"`
+	CampaignUtm        *map[string]model.UtmTemplate `json:"campaignUtm,omitempty"`
 }
 
 func NewRouter(srv Server) http.Handler {
@@ -73,7 +186,17 @@ func NewRouter(srv Server) http.Handler {
 	collectionHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			items := srv.Store.List()
+			scope := workspaceFromRequest(r)
+			acc := srv.accessFor(r)
+			if !acc.allow(w, scope, workspace.RoleViewer) {
+				return
+			}
+			all, err := srv.Store.List(r.Context())
+			if err != nil {
+				writeStoreError(w, err, "list_failed")
+				return
+			}
+			items := acc.inScope(all, scope)
 			for i := range items {
 				items[i] = items[i].NormalizeForResponse()
 			}
@@ -81,6 +204,10 @@ func NewRouter(srv Server) http.Handler {
 			return
 
 		case http.MethodPost:
+			scope := workspaceFromRequest(r)
+			if !srv.accessFor(r).allow(w, scope, workspace.RoleEditor) {
+				return
+			}
 			qt := quotaForUserType(userTypeFromRequest(r))
 			var req createQrCodeRequest
 			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -97,35 +224,77 @@ func NewRouter(srv Server) http.Handler {
 				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "url_invalid"})
 				return
 			}
-
-			requestedActive := true
-			if req.Active != nil {
-				requestedActive = *req.Active
+			req.Campaign = strings.TrimSpace(req.Campaign)
+			tags, ok := cleanTags(req.Tags)
+			if !ok {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "tags_invalid"})
+				return
+			}
+			if req.Utm != nil && !isValidUtmTemplate(*req.Utm) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "utm_invalid"})
+				return
+			}
+			req.DestinationType = strings.TrimSpace(req.DestinationType)
+			if code := validateDestination(req.DestinationType, req.AppLink); code != "" {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+				return
+			}
+			req.FallbackURL = strings.TrimSpace(req.FallbackURL)
+			if req.FallbackURL != "" && !isValidHTTPURL(req.FallbackURL) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "fallback_url_invalid"})
+				return
+			}
+			if req.LandingPage != nil && !cleanLandingPage(req.LandingPage) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "landing_page_invalid"})
+				return
+			}
+			if req.Style != nil && !cleanStyle(req.Style) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "style_invalid"})
+				return
+			}
+			if !cleanSymbology(&req.Symbology) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "symbology_invalid"})
+				return
+			}
+			if req.Frame != nil && !cleanFrame(req.Frame) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "frame_invalid"})
+				return
 			}
 
-			total, err := srv.Store.CountTotal()
-			if err != nil {
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "quota_check_failed"})
+			newActive := 1
+			if req.Active != nil && !*req.Active {
+				newActive = 0
+			}
+			if !srv.checkQuota(w, r, qt, 1, newActive) {
 				return
 			}
-			if total >= qt.maxTotal {
-				writeJSON(w, http.StatusForbidden, map[string]string{"error": "quota_total_exceeded"})
+			if !srv.checkSymbology(w, placeholderID, req.Symbology, req.Style) {
 				return
 			}
-			if requestedActive {
-				active, err := srv.Store.CountActive()
-				if err != nil {
-					writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "quota_check_failed"})
-					return
-				}
-				if active >= qt.maxActive {
-					writeJSON(w, http.StatusForbidden, map[string]string{"error": "quota_active_exceeded"})
-					return
-				}
+			report, ok := srv.checkStyle(w, placeholderID, req.Symbology, req.Style)
+			if !ok {
+				return
 			}
-			created, err := srv.Store.Create(store.CreateInput{Label: req.Label, URL: req.URL, Active: req.Active})
+			created, err := srv.Store.Create(r.Context(), store.CreateInput{
+				OwnerID:         userIDFromRequest(r),
+				WorkspaceID:     scope,
+				Label:           req.Label,
+				URL:             req.URL,
+				Active:          req.Active,
+				Campaign:        req.Campaign,
+				Tags:            tags,
+				Utm:             req.Utm,
+				DestinationType: req.DestinationType,
+				AppLink:         req.AppLink,
+				FallbackURL:     req.FallbackURL,
+				LandingPage:     req.LandingPage,
+				Symbology:       req.Symbology,
+				Style:           req.Style,
+				Scannability:    report,
+				Frame:           req.Frame,
+			})
 			if err != nil {
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "create_failed"})
+				writeStoreError(w, err, "create_failed")
 				return
 			}
 			writeJSON(w, http.StatusCreated, created.NormalizeForResponse())
@@ -143,21 +312,32 @@ func NewRouter(srv Server) http.Handler {
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
+		if sourceID, ok := strings.CutSuffix(id, "/clone"); ok {
+			srv.cloneHandler(w, r, sourceID)
+			return
+		}
+		if codeID, ok := strings.CutSuffix(id, "/image"); ok {
+			srv.imageHandler(w, r, codeID, "")
+			return
+		}
+		if codeID, digest, ok := strings.Cut(id, "/image/"); ok {
+			srv.imageHandler(w, r, codeID, digest)
+			return
+		}
 
 		switch r.Method {
 		case http.MethodGet:
-			item, err := srv.Store.Get(id)
-			if err != nil {
-				if errors.Is(err, store.ErrNotFound) {
-					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
-					return
-				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "get_failed"})
+			item, ok := srv.getAuthorized(w, r, id, workspace.RoleViewer)
+			if !ok {
 				return
 			}
 			writeJSON(w, http.StatusOK, item.NormalizeForResponse())
 			return
 		case http.MethodPatch:
+			item, ok := srv.getAuthorized(w, r, id, workspace.RoleEditor)
+			if !ok {
+				return
+			}
 			qt := quotaForUserType(userTypeFromRequest(r))
 			var req updateQrCodeRequest
 			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -180,52 +360,139 @@ func NewRouter(srv Server) http.Handler {
 				v := strings.TrimSpace(*req.Label)
 				req.Label = &v
 			}
+			if req.Campaign != nil {
+				v := strings.TrimSpace(*req.Campaign)
+				req.Campaign = &v
+			}
+			if req.Tags != nil {
+				tags, ok := cleanTags(*req.Tags)
+				if !ok {
+					writeJSON(w, http.StatusBadRequest, map[string]string{"error": "tags_invalid"})
+					return
+				}
+				req.Tags = &tags
+			}
+			if req.Utm != nil && !isValidUtmTemplate(*req.Utm) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "utm_invalid"})
+				return
+			}
+			if req.FallbackURL != nil {
+				v := strings.TrimSpace(*req.FallbackURL)
+				req.FallbackURL = &v
+				if v != "" && !isValidHTTPURL(v) {
+					writeJSON(w, http.StatusBadRequest, map[string]string{"error": "fallback_url_invalid"})
+					return
+				}
+			}
+			if req.LandingPage != nil && !cleanLandingPage(req.LandingPage) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "landing_page_invalid"})
+				return
+			}
+			// Scans of a moderated code never reach its fallback or landing
+			// page, and the owner can't set them up for when it's enabled.
+			if (req.FallbackURL != nil || req.LandingPage != nil) && item.IsModerated() {
+				writeJSON(w, http.StatusForbidden, map[string]string{"error": "disabled_by_admin"})
+				return
+			}
+			if req.Style != nil && !cleanStyle(req.Style) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "style_invalid"})
+				return
+			}
+			if req.Symbology != nil && !cleanSymbology(req.Symbology) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "symbology_invalid"})
+				return
+			}
+			if req.Frame != nil && !cleanFrame(req.Frame) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "frame_invalid"})
+				return
+			}
+			if req.DestinationType != nil || req.AppLink != nil {
+				// Validate the destination as it will look after the patch.
+				destType, link := item.DestinationType, item.AppLink
+				if req.DestinationType != nil {
+					v := strings.TrimSpace(*req.DestinationType)
+					req.DestinationType = &v
+					destType = v
+				}
+				if req.AppLink != nil {
+					link = req.AppLink
+				}
+				if code := validateDestination(destType, link); code != "" {
+					writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+					return
+				}
+			}
 
 			if req.Active != nil && *req.Active {
-				current, err := srv.Store.Get(id)
-				if err != nil {
-					if errors.Is(err, store.ErrNotFound) {
-						writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
-						return
-					}
-					writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "get_failed"})
+				if item.IsModerated() {
+					writeJSON(w, http.StatusForbidden, map[string]string{"error": "disabled_by_admin"})
 					return
 				}
 
 				// Only enforce if we're transitioning false -> true.
-				if !current.Active {
-					active, err := srv.Store.CountActive()
-					if err != nil {
-						writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "quota_check_failed"})
-						return
-					}
-					if active >= qt.maxActive {
-						writeJSON(w, http.StatusForbidden, map[string]string{"error": "quota_active_exceeded"})
-						return
-					}
+				if !item.Active && !srv.checkActiveQuota(w, r, qt, 1) {
+					return
+				}
+			}
+			var report *model.ScanReport
+			if req.Style != nil || req.Symbology != nil {
+				// Check the code as it will look after the patch.
+				symbology, style := item.NormalizeForResponse().Symbology, item.Style
+				if req.Symbology != nil {
+					symbology = *req.Symbology
+				}
+				if req.Style != nil {
+					style = req.Style
+				}
+				if !srv.checkSymbology(w, id, symbology, style) {
+					return
+				}
+				var ok bool
+				if report, ok = srv.checkStyle(w, id, symbology, style); !ok {
+					return
 				}
 			}
-			updated, err := srv.Store.Update(id, store.UpdateInput{Label: req.Label, URL: req.URL, Active: req.Active})
+			updated, err := srv.Store.Update(r.Context(), id, store.UpdateInput{
+				Label:           req.Label,
+				URL:             req.URL,
+				Active:          req.Active,
+				Campaign:        req.Campaign,
+				Tags:            req.Tags,
+				Utm:             req.Utm,
+				DestinationType: req.DestinationType,
+				AppLink:         req.AppLink,
+				FallbackURL:     req.FallbackURL,
+				LandingPage:     req.LandingPage,
+				Symbology:       req.Symbology,
+				Style:           req.Style,
+				Scannability:    report,
+				Frame:           req.Frame,
+			})
 			if err != nil {
 				if errors.Is(err, store.ErrNotFound) {
 					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 					return
 				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "update_failed"})
+				writeStoreError(w, err, "update_failed")
 				return
 			}
+			srv.invalidateRedirects(r, id)
 			writeJSON(w, http.StatusOK, updated.NormalizeForResponse())
 			return
 		case http.MethodDelete:
-			err := srv.Store.Delete(id)
+			if _, ok := srv.getAuthorized(w, r, id, workspace.RoleEditor); !ok {
+				return
+			}
+			err := srv.Store.Delete(r.Context(), id)
 			if err != nil {
 				if errors.Is(err, store.ErrNotFound) {
 					writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 					return
 				}
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "delete_failed"})
+				writeStoreError(w, err, "delete_failed")
 				return
 			}
+			srv.invalidateRedirects(r, id)
 			w.WriteHeader(http.StatusNoContent)
 			return
 		default:
@@ -237,24 +504,52 @@ func NewRouter(srv Server) http.Handler {
 	settingsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			settings, err := srv.Store.GetSettings()
+			// The admin key may read any owner's, as click-service does to
+			// resolve a code's redirect.
+			ownerID := userIDFromRequest(r)
+			if r.URL.Query().Has("ownerId") {
+				if !srv.accessFor(r).isAdmin {
+					writeJSON(w, http.StatusForbidden, map[string]string{"error": "forbidden"})
+					return
+				}
+				ownerID = r.URL.Query().Get("ownerId")
+			}
+			settings, err := srv.Store.GetSettings(r.Context(), ownerID)
 			if err != nil {
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "failed_to_get_settings"})
+				writeStoreError(w, err, "failed_to_get_settings")
 				return
 			}
 			writeJSON(w, http.StatusOK, settings)
 			return
 		case http.MethodPut:
-			var req model.UserSettings
+			var req updateSettingsRequest
 			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
 				return
 			}
-			if err := srv.Store.UpdateSettings(req); err != nil {
-				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "failed_to_update_settings"})
+			settings := model.UserSettings{DefaultRedirectURL: req.DefaultRedirectURL}
+			if req.CampaignUtm != nil {
+				for campaign, tmpl := range *req.CampaignUtm {
+					if strings.TrimSpace(campaign) == "" || !isValidUtmTemplate(tmpl) {
+						writeJSON(w, http.StatusBadRequest, map[string]string{"error": "utm_invalid"})
+						return
+					}
+				}
+				settings.CampaignUtm = *req.CampaignUtm
+			} else {
+				current, err := srv.Store.GetSettings(r.Context(), userIDFromRequest(r))
+				if err != nil {
+					writeStoreError(w, err, "failed_to_update_settings")
+					return
+				}
+				settings.CampaignUtm = current.CampaignUtm
+			}
+			if err := srv.Store.UpdateSettings(r.Context(), userIDFromRequest(r), settings); err != nil {
+				writeStoreError(w, err, "failed_to_update_settings")
 				return
 			}
-			writeJSON(w, http.StatusOK, req)
+			srv.invalidateAllRedirects(r)
+			writeJSON(w, http.StatusOK, settings)
 			return
 		default:
 			w.WriteHeader(http.StatusMethodNotAllowed)
@@ -265,6 +560,9 @@ func NewRouter(srv Server) http.Handler {
 	wrap := func(h http.Handler) http.Handler {
 		return middleware.Recoverer(middleware.RequestID(middleware.ExposeResponseHeaders(middleware.EnforceJSONHandler(h))))
 	}
+	wrapUpload := func(h http.Handler, types ...string) http.Handler {
+		return middleware.Recoverer(middleware.RequestID(middleware.ExposeResponseHeaders(middleware.EnforceUploadHandler(h, types...))))
+	}
 
 	adminSampleDataHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -272,41 +570,19 @@ func NewRouter(srv Server) http.Handler {
 			return
 		}
 
-		// Check admin key
-		if srv.AdminAPIKey == "" || r.Header.Get("X-Admin-Key") != srv.AdminAPIKey {
-			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		if !srv.requireAdmin(w, r) {
 			return
 		}
 
-		// Generate sample QR codes
-		sampleData := []struct {
-			label  string
-			url    string
-			active bool
-		}{
-			{"Product Landing Page", "https://example.com/products/widget-pro", true},
-			{"Marketing Campaign", "https://example.com/promo/summer-sale", true},
-			{"Event Registration", "https://example.com/events/conference-2026", true},
-			{"Menu QR Code", "https://restaurant.example.com/menu", true},
-			{"Business Card", "https://example.com/contact/john-smith", false},
-			{"Feedback Survey", "https://forms.example.com/feedback/q1-2026", true},
-			{"App Download", "https://app.example.com/download", true},
-			{"Support Portal", "https://support.example.com", false},
-			{"Newsletter Signup", "https://example.com/newsletter", true},
-			{"Social Media Profile", "https://social.example.com/company", true},
-		}
-
-		created := 0
-		for _, data := range sampleData {
-			_, err := srv.Store.Create(store.CreateInput{
-				Label:  data.label,
-				URL:    data.url,
-				Active: &data.active,
-			})
-			if err == nil {
-				created++
-			}
+		result, err := seed.Apply(r.Context(), srv.Store, seed.Default().WithoutSettings(), seed.Options{
+			OwnerID: userIDFromRequest(r),
+			Seed:    time.Now().UnixNano(),
+		})
+		if err != nil {
+			writeStoreError(w, err, "seed_failed")
+			return
 		}
+		created := len(result.Created)
 
 		writeJSON(w, http.StatusOK, map[string]any{
 			"message": "sample data generated",
@@ -315,10 +591,23 @@ func NewRouter(srv Server) http.Handler {
 	})
 
 	mux.Handle("/healthz", wrap(healthHandler))
+	mux.Handle("/openapi.yaml", wrap(http.HandlerFunc(openAPIHandler)))
 	mux.Handle("/api/qr-codes", wrap(collectionHandler))
 	mux.Handle("/api/qr-codes/", wrap(itemHandler))
+	mux.Handle("/api/qr-codes/export", wrap(http.HandlerFunc(srv.backupExportHandler)))
+	mux.Handle("/api/qr-codes/export/pdf", wrap(http.HandlerFunc(srv.pdfExportHandler)))
+	mux.Handle("/api/qr-codes/bulk", wrap(http.HandlerFunc(srv.bulkHandler)))
+	mux.Handle("/api/qr-codes/import", wrapUpload(http.HandlerFunc(srv.importHandler), csvContentType, xlsxContentType))
+	mux.Handle("/api/qr-codes/decode", wrapUpload(http.HandlerFunc(srv.decodeHandler), pngContentType, jpegContentType))
 	mux.Handle("/api/settings", wrap(settingsHandler))
+	mux.Handle("/api/qr-templates", wrap(http.HandlerFunc(srv.templateCollectionHandler)))
+	mux.Handle("/api/qr-templates/", wrap(http.HandlerFunc(srv.templateItemHandler)))
 	mux.Handle("/api/admin/generate-sample-data", wrap(adminSampleDataHandler))
+	mux.Handle("/api/admin/qr-codes", wrap(http.HandlerFunc(srv.adminSearchHandler)))
+	mux.Handle("/api/admin/qr-codes/", wrap(http.HandlerFunc(srv.adminQrCodeHandler)))
+	mux.Handle("/api/admin/qr-codes/transfer", wrap(http.HandlerFunc(srv.adminTransferHandler)))
+	mux.Handle("/api/admin/usage", wrap(http.HandlerFunc(srv.adminUsageHandler)))
+	mux.Handle("/api/events", wrap(http.HandlerFunc(srv.eventsHandler)))
 	mux.Handle("/api/dev/generate-sample-data", wrap(http.HandlerFunc(srv.devSampleDataHandler)))
 
 	return mux
@@ -330,42 +619,21 @@ func (srv *Server) devSampleDataHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Get user ID from context (set by auth middleware)
-	userID, ok := r.Context().Value("user_id").(string)
-	if !ok || userID == "" {
+	userID := userIDFromRequest(r)
+	if userID == "" {
 		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
 		return
 	}
 
-	// Generate sample QR codes for the authenticated user
-	sampleData := []struct {
-		label  string
-		url    string
-		active bool
-	}{
-		{"Product Landing Page", "https://example.com/products/widget-pro", true},
-		{"Marketing Campaign", "https://example.com/promo/summer-sale", true},
-		{"Event Registration", "https://example.com/events/conference-2026", true},
-		{"Menu QR Code", "https://restaurant.example.com/menu", true},
-		{"Contact Card", "https://example.com/contact/john-doe", true},
-		{"WiFi Access", "https://example.com/wifi/guest", true},
-		{"App Download", "https://apps.example.com/download", true},
-		{"Survey Link", "https://forms.example.com/feedback", true},
-		{"Document Share", "https://docs.example.com/guide.pdf", true},
-		{"Video Tutorial", "https://videos.example.com/tutorial", true},
-	}
-
-	created := 0
-	for _, data := range sampleData {
-		_, err := srv.Store.Create(store.CreateInput{
-			Label:  data.label,
-			URL:    data.url,
-			Active: &data.active,
-		})
-		if err == nil {
-			created++
-		}
+	result, err := seed.Apply(r.Context(), srv.Store, seed.Default().WithoutSettings(), seed.Options{
+		OwnerID: userID,
+		Seed:    time.Now().UnixNano(),
+	})
+	if err != nil {
+		writeStoreError(w, err, "seed_failed")
+		return
 	}
+	created := len(result.Created)
 
 	writeJSON(w, http.StatusOK, map[string]any{
 		"message": "sample data generated",
@@ -373,12 +641,154 @@ func (srv *Server) devSampleDataHandler(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// getAuthorized loads a code and checks the caller owns it or holds at least
+// min in its workspace, writing the error response when either fails.
+func (srv *Server) getAuthorized(w http.ResponseWriter, r *http.Request, id string, min workspace.Role) (model.QrCode, bool) {
+	item, err := srv.Store.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+			return model.QrCode{}, false
+		}
+		writeStoreError(w, err, "get_failed")
+		return model.QrCode{}, false
+	}
+	if !srv.accessFor(r).allowCode(w, item, min) {
+		return model.QrCode{}, false
+	}
+	return item, true
+}
+
 func writeJSON(w http.ResponseWriter, status int, payload any) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(payload)
 }
 
+// storeErrorStatus is the status for a failed store call: 504 when the
+// operation hit its deadline, 503 when it was cancelled (the client went away
+// or the server is shutting down), and 500 otherwise.
+func storeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeStoreError answers a failed store call with code, at the status
+// storeErrorStatus picks for err.
+func writeStoreError(w http.ResponseWriter, err error, code string) {
+	writeJSON(w, storeErrorStatus(err), map[string]string{"error": code})
+}
+
+// isValidUtmTemplate rejects templates that reference placeholders click-service
+// can't expand, so typos surface at save time rather than as literal "{...}"
+// text in analytics.
+func isValidUtmTemplate(t model.UtmTemplate) bool {
+	for _, v := range t.Fields() {
+		for {
+			start := strings.Index(v, "{")
+			if start < 0 {
+				break
+			}
+			end := strings.Index(v[start:], "}")
+			if end < 0 {
+				return false
+			}
+			if !slices.Contains(model.UtmPlaceholders, v[start:start+end+1]) {
+				return false
+			}
+			v = v[start+end+1:]
+		}
+	}
+	return true
+}
+
+// validateDestination returns an error code when the destination type or its
+// app routes are unusable, or "" when they're fine.
+func validateDestination(destType string, link *model.AppLink) string {
+	switch destType {
+	case "", model.DestinationURL:
+		return ""
+	case model.DestinationAppLink:
+	default:
+		return "destination_type_invalid"
+	}
+
+	if link == nil || link.IsZero() {
+		return "app_link_required"
+	}
+	for _, raw := range []string{link.IOSURL, link.AndroidURL} {
+		if raw != "" && !isValidAppURL(raw) {
+			return "app_link_invalid"
+		}
+	}
+	for _, raw := range []string{link.AppStoreURL, link.PlayStoreURL} {
+		if raw != "" && !isValidHTTPURL(raw) {
+			return "app_link_invalid"
+		}
+	}
+	return ""
+}
+
+// isValidAppURL accepts https universal links and custom app schemes, but not
+// schemes a browser would execute or read locally.
+func isValidAppURL(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme == "" {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "https":
+		return u.Host != ""
+	case "http", "javascript", "data", "vbscript", "file", "blob", "about":
+		return false
+	}
+	return true
+}
+
+// cleanLandingPage trims p's fields in place and reports whether they're
+// usable: texts within their limits, https logo and button URLs, and button
+// text only alongside a button URL. An empty page is fine; it clears the page.
+func cleanLandingPage(p *model.LandingPage) bool {
+	for _, f := range []*string{&p.Title, &p.Message, &p.LogoURL, &p.ButtonText, &p.ButtonURL} {
+		*f = strings.TrimSpace(*f)
+	}
+	switch {
+	case utf8.RuneCountInString(p.Title) > maxLandingTitleLen,
+		utf8.RuneCountInString(p.Message) > maxLandingMessageLen,
+		utf8.RuneCountInString(p.ButtonText) > maxLandingButtonLen:
+		return false
+	case p.LogoURL != "" && !isValidHTTPURL(p.LogoURL):
+		return false
+	case p.ButtonURL != "" && !isValidHTTPURL(p.ButtonURL):
+		return false
+	case p.ButtonText != "" && p.ButtonURL == "":
+		return false
+	}
+	return true
+}
+
+// cleanTags trims and dedupes tags, keeping their order. It reports false
+// for empty or overlong tags, or too many of them.
+func cleanTags(in []string) ([]string, bool) {
+	out := make([]string, 0, len(in))
+	for _, t := range in {
+		t = strings.TrimSpace(t)
+		if t == "" || len(t) > maxTagLen {
+			return nil, false
+		}
+		if !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+	return out, len(out) <= maxTagsPerCode
+}
+
 func isValidHTTPURL(raw string) bool {
 	u, err := url.Parse(raw)
 	if err != nil {
@@ -0,0 +1,96 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"qr-service/internal/model"
+	"qr-service/internal/render"
+	"qr-service/internal/scannability"
+)
+
+// maxLogoScale keeps a logo from covering more of a code than level H could
+// ever recover.
+const maxLogoScale = 0.4
+
+// placeholderID stands in for a code's ID before it exists. It's as long as
+// a real one, so the tracking URL, and with it the code's size, matches.
+const placeholderID = "00000000-0000-0000-0000-000000000000"
+
+// cleanStyle normalizes s in place and reports whether it's usable: #rrggbb
+// colors, CMYK inks from 0 to 100, a known error correction level and a logo
+// no larger than maxLogoScale. An empty style is fine; it clears the style.
+func cleanStyle(s *model.QrStyle) bool {
+	for _, c := range []*model.CmykColor{s.ForegroundCmyk, s.BackgroundCmyk} {
+		if c == nil {
+			continue
+		}
+		for _, ink := range []float64{c.C, c.M, c.Y, c.K} {
+			if ink < 0 || ink > 100 {
+				return false
+			}
+		}
+	}
+	for _, c := range []*string{&s.Foreground, &s.Background} {
+		*c = strings.ToLower(strings.TrimSpace(*c))
+		if *c == "" {
+			continue
+		}
+		if _, err := render.ParseHexColor(*c); err != nil {
+			return false
+		}
+	}
+	s.ErrorCorrection = strings.ToUpper(strings.TrimSpace(s.ErrorCorrection))
+	switch s.ErrorCorrection {
+	case "", model.ErrorCorrectionL, model.ErrorCorrectionM, model.ErrorCorrectionQ, model.ErrorCorrectionH:
+	default:
+		return false
+	}
+	return s.LogoScale >= 0 && s.LogoScale <= maxLogoScale
+}
+
+// checkStyle measures how well the code id scans as symbology in style and
+// writes a 422 with the report when it can't be read at all, so a broken
+// design never gets saved. An empty style needs no report.
+func (srv *Server) checkStyle(w http.ResponseWriter, id, symbology string, style *model.QrStyle) (*model.ScanReport, bool) {
+	if style == nil || style.IsZero() {
+		return nil, true
+	}
+	report, err := scannability.Check(srv.trackingURL(id), symbology, *style)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "style_invalid"})
+		return nil, false
+	}
+	if !report.Readable {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]any{"error": "style_unscannable", "scannability": report})
+		return nil, false
+	}
+	return &report, true
+}
+
+// cleanSymbology normalizes s in place and reports whether it's one of
+// model.Symbologies. Empty means QR.
+func cleanSymbology(s *string) bool {
+	*s = strings.ToLower(strings.TrimSpace(*s))
+	if *s == "" {
+		*s = model.SymbologyQR
+	}
+	return slices.Contains(model.Symbologies, *s)
+}
+
+// checkSymbology writes an error when the code id can't be drawn as
+// symbology: a logo on anything but a QR code, or a tracking URL too long for
+// the symbology to hold.
+func (srv *Server) checkSymbology(w http.ResponseWriter, id, symbology string, style *model.QrStyle) bool {
+	if symbology != model.SymbologyQR && style != nil && style.LogoScale > 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "style_invalid"})
+		return false
+	}
+	if _, err := render.EncodeSymbol(srv.trackingURL(id), symbology, model.ErrorCorrectionM); errors.Is(err, render.ErrPayloadTooLarge) {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"error": "symbology_capacity_exceeded"})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,186 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func TestStyle_Validation(t *testing.T) {
+	cases := []struct {
+		name  string
+		style map[string]any
+	}{
+		{"short color", map[string]any{"foreground": "#000"}},
+		{"named color", map[string]any{"background": "white"}},
+		{"unknown level", map[string]any{"errorCorrection": "X"}},
+		{"logo too large", map[string]any{"logoScale": 0.5}},
+		{"negative logo", map[string]any{"logoScale": -0.1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRouter(Server{Store: store.NewMemoryStore()})
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "style": tc.style}))
+			var resp map[string]string
+			_ = json.Unmarshal(w.Body.Bytes(), &resp)
+			if w.Code != http.StatusBadRequest || resp["error"] != "style_invalid" {
+				t.Fatalf("expected 400 style_invalid, got %d %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestStyle_SavedWithScannability(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{
+		"url":   "https://example.com",
+		"style": map[string]any{"foreground": " #1A237E ", "errorCorrection": "h", "logoScale": 0.2},
+	}))
+	var created model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &created)
+	if w.Code != http.StatusCreated || created.Style == nil || created.Style.Foreground != "#1a237e" || created.Style.ErrorCorrection != "H" {
+		t.Fatalf("unexpected create %d %s", w.Code, w.Body.String())
+	}
+	if created.Scannability == nil || !created.Scannability.Readable || created.Scannability.Score == 0 || created.Scannability.ContrastRatio < 7 {
+		t.Fatalf("expected a passing report, got %+v", created.Scannability)
+	}
+
+	// A low-contrast restyle still reads but warns.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{
+		"style": map[string]any{"foreground": "#777777", "background": "#ffffff"},
+	}))
+	var patched model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &patched)
+	if w.Code != http.StatusOK || patched.Scannability == nil || !hasWarning(*patched.Scannability, "low_contrast") {
+		t.Fatalf("expected a low_contrast warning, got %d %s", w.Code, w.Body.String())
+	}
+
+	// An empty style clears both the style and its report.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"style": map[string]any{}}))
+	if q, _ := s.Get(context.Background(), created.ID); w.Code != http.StatusOK || q.Style != nil || q.Scannability != nil {
+		t.Fatalf("expected style cleared, got %d %+v", w.Code, q)
+	}
+}
+
+func TestStyle_UnscannableIsRefused(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	created, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com"})
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch} {
+		path := "/api/qr-codes"
+		if method == http.MethodPatch {
+			path += "/" + created.ID
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, jsonRequest(method, path, map[string]any{
+			"url":   "https://example.com",
+			"style": map[string]any{"errorCorrection": "L", "logoScale": 0.4},
+		}))
+		var resp struct {
+			Error        string           `json:"error"`
+			Scannability model.ScanReport `json:"scannability"`
+		}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		if w.Code != http.StatusUnprocessableEntity || resp.Error != "style_unscannable" || resp.Scannability.Readable || !hasWarning(resp.Scannability, "logo_too_large") {
+			t.Fatalf("%s: expected 422 style_unscannable, got %d %s", method, w.Code, w.Body.String())
+		}
+	}
+	if all, _ := s.List(context.Background()); len(all) != 1 || all[0].Style != nil {
+		t.Fatalf("expected nothing saved, got %+v", all)
+	}
+}
+
+func hasWarning(r model.ScanReport, code string) bool {
+	for _, w := range r.Warnings {
+		if w.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSymbology_CreateAndPatch(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com"}))
+	var plain model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &plain)
+	if w.Code != http.StatusCreated || plain.Symbology != model.SymbologyQR {
+		t.Fatalf("expected a QR code by default, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{
+		"url":       "https://example.com",
+		"symbology": " Data_Matrix ",
+		"style":     map[string]any{"foreground": "#1a237e"},
+	}))
+	var created model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &created)
+	if w.Code != http.StatusCreated || created.Symbology != model.SymbologyDataMatrix || created.Scannability == nil || !created.Scannability.Readable {
+		t.Fatalf("unexpected create %d %s", w.Code, w.Body.String())
+	}
+
+	// Switching symbology re-checks the saved style.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPatch, "/api/qr-codes/"+created.ID, map[string]any{"symbology": "pdf417"}))
+	var patched model.QrCode
+	_ = json.Unmarshal(w.Body.Bytes(), &patched)
+	if w.Code != http.StatusOK || patched.Symbology != model.SymbologyPDF417 || patched.Scannability == nil || !hasWarning(*patched.Scannability, "unverified") {
+		t.Fatalf("unexpected patch %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestSymbology_Refusals(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, ClickBaseURL: "https://click.example.com"})
+	logo, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com", Style: &model.QrStyle{ErrorCorrection: "H", LogoScale: 0.2}})
+
+	cases := []struct {
+		name    string
+		method  string
+		path    string
+		body    map[string]any
+		status  int
+		errCode string
+	}{
+		{"unknown", http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "symbology": "maxicode"}, http.StatusBadRequest, "symbology_invalid"},
+		{"logo on data matrix", http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "symbology": "data_matrix", "style": map[string]any{"logoScale": 0.2}}, http.StatusBadRequest, "style_invalid"},
+		{"patch away from qr keeps the logo", http.MethodPatch, "/api/qr-codes/" + logo.ID, map[string]any{"symbology": "aztec"}, http.StatusBadRequest, "style_invalid"},
+		{"unknown on patch", http.MethodPatch, "/api/qr-codes/" + logo.ID, map[string]any{"symbology": "ean13"}, http.StatusBadRequest, "symbology_invalid"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, jsonRequest(tc.method, tc.path, tc.body))
+			var resp map[string]string
+			_ = json.Unmarshal(w.Body.Bytes(), &resp)
+			if w.Code != tc.status || resp["error"] != tc.errCode {
+				t.Fatalf("expected %d %s, got %d %s", tc.status, tc.errCode, w.Code, w.Body.String())
+			}
+		})
+	}
+
+	// A tracking link longer than Code 128 holds.
+	r = NewRouter(Server{Store: s, ClickBaseURL: "https://scans.a-rather-long-tracking-domain.example.com"})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com", "symbology": "code128"}))
+	if w.Code != http.StatusUnprocessableEntity || !strings.Contains(w.Body.String(), "symbology_capacity_exceeded") {
+		t.Fatalf("expected 422 symbology_capacity_exceeded, got %d %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,431 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+// maxTemplateInstances bounds one instantiate call; larger rollouts can be
+// split across calls.
+const maxTemplateInstances = 500
+
+type cloneQrCodeRequest struct {
+	Label  *string `json:"label,omitempty"`
+	URL    *string `json:"url,omitempty"`
+	Active *bool   `json:"active,omitempty"`
+}
+
+// cloneHandler serves POST /api/qr-codes/{id}/clone. The copy belongs to the
+// caller and counts against their quota like any new code.
+func (srv *Server) cloneHandler(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// The body is optional; an empty one clones as-is.
+	var req cloneQrCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+
+	source, ok := srv.getAuthorized(w, r, id, workspace.RoleViewer)
+	if !ok {
+		return
+	}
+	// The copy lands in the caller's current workspace, not the source's.
+	scope := workspaceFromRequest(r)
+	if !srv.accessFor(r).allow(w, scope, workspace.RoleEditor) {
+		return
+	}
+	if source.IsModerated() {
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "disabled_by_admin"})
+		return
+	}
+
+	input := store.CreateInput{
+		OwnerID:         userIDFromRequest(r),
+		WorkspaceID:     scope,
+		Label:           source.Label + " (copy)",
+		URL:             source.URL,
+		Active:          &source.Active,
+		Campaign:        source.Campaign,
+		Tags:            source.Tags,
+		Utm:             source.Utm,
+		DestinationType: source.DestinationType,
+		AppLink:         source.AppLink,
+		FallbackURL:     source.FallbackURL,
+		LandingPage:     source.LandingPage,
+		Symbology:       source.Symbology,
+		Style:           source.Style,
+		Scannability:    source.Scannability,
+		Frame:           source.Frame,
+	}
+	if req.Label != nil {
+		input.Label = strings.TrimSpace(*req.Label)
+	}
+	if req.URL != nil {
+		input.URL = strings.TrimSpace(*req.URL)
+		if !isValidHTTPURL(input.URL) {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "url_invalid"})
+			return
+		}
+	}
+	if req.Active != nil {
+		input.Active = req.Active
+	}
+
+	newActive := 0
+	if *input.Active {
+		newActive = 1
+	}
+	if !srv.checkQuota(w, r, quotaForUserType(userTypeFromRequest(r)), 1, newActive) {
+		return
+	}
+	created, err := srv.Store.Create(r.Context(), input)
+	if err != nil {
+		writeStoreError(w, err, "create_failed")
+		return
+	}
+	writeJSON(w, http.StatusCreated, created.NormalizeForResponse())
+}
+
+type templateRequest struct {
+	Name         string             `json:"name"`
+	LabelPattern string             `json:"labelPattern"`
+	URLPattern   string             `json:"urlPattern"`
+	Active       *bool              `json:"active,omitempty"`
+	Campaign     string             `json:"campaign,omitempty"`
+	Tags         []string           `json:"tags,omitempty"`
+	Style        *model.QrStyle     `json:"style,omitempty"`
+	Utm          *model.UtmTemplate `json:"utm,omitempty"`
+
+	DestinationType string         `json:"destinationType,omitempty"`
+	AppLink         *model.AppLink `json:"appLink,omitempty"`
+}
+
+// toTemplate validates req and returns the template it describes, or an
+// error code. Patterns are checked with every variable filled in, so a
+// template that saves can always be instantiated given its variables.
+func (req templateRequest) toTemplate() (model.QrTemplate, string) {
+	t := model.QrTemplate{
+		Name:            strings.TrimSpace(req.Name),
+		LabelPattern:    strings.TrimSpace(req.LabelPattern),
+		URLPattern:      strings.TrimSpace(req.URLPattern),
+		Active:          true,
+		Campaign:        strings.TrimSpace(req.Campaign),
+		Style:           req.Style,
+		Utm:             req.Utm,
+		DestinationType: strings.TrimSpace(req.DestinationType),
+		AppLink:         req.AppLink,
+	}
+	if req.Active != nil {
+		t.Active = *req.Active
+	}
+	if t.Name == "" {
+		return model.QrTemplate{}, "name_required"
+	}
+	if t.URLPattern == "" {
+		return model.QrTemplate{}, "url_required"
+	}
+
+	sample := func(string) (string, bool) { return "x", true }
+	u, _ := model.ExpandTemplate(t.URLPattern, sample)
+	if !isValidHTTPURL(u) {
+		return model.QrTemplate{}, "url_invalid"
+	}
+	tags, ok := cleanTags(req.Tags)
+	if !ok {
+		return model.QrTemplate{}, "tags_invalid"
+	}
+	t.Tags = tags
+	if t.Style != nil && !cleanStyle(t.Style) {
+		return model.QrTemplate{}, "style_invalid"
+	}
+	if t.Utm != nil && !isValidUtmTemplate(*t.Utm) {
+		return model.QrTemplate{}, "utm_invalid"
+	}
+	link, _ := expandAppLink(t.AppLink, sample)
+	if code := validateDestination(t.DestinationType, link); code != "" {
+		return model.QrTemplate{}, code
+	}
+	return t, ""
+}
+
+// expandAppLink expands placeholders in each app route, returning the first
+// variable lookup couldn't resolve.
+func expandAppLink(a *model.AppLink, lookup func(string) (string, bool)) (*model.AppLink, string) {
+	if a == nil {
+		return nil, ""
+	}
+	out := *a
+	var missing string
+	for _, f := range []*string{&out.IOSURL, &out.AndroidURL, &out.AppStoreURL, &out.PlayStoreURL} {
+		var m string
+		*f, m = model.ExpandTemplate(*f, lookup)
+		if missing == "" {
+			missing = m
+		}
+	}
+	return &out, missing
+}
+
+func (srv *Server) templateCollectionHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		scope := workspaceFromRequest(r)
+		acc := srv.accessFor(r)
+		if !acc.allow(w, scope, workspace.RoleViewer) {
+			return
+		}
+		all, err := srv.Store.ListTemplates(r.Context())
+		if err != nil {
+			writeStoreError(w, err, "list_failed")
+			return
+		}
+		items := make([]model.QrTemplate, 0, len(all))
+		for _, t := range all {
+			if acc.listed(t.WorkspaceID, t.OwnerID, scope) {
+				items = append(items, t.NormalizeForResponse())
+			}
+		}
+		writeJSON(w, http.StatusOK, items)
+	case http.MethodPost:
+		scope := workspaceFromRequest(r)
+		if !srv.accessFor(r).allow(w, scope, workspace.RoleEditor) {
+			return
+		}
+		var req templateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		t, code := req.toTemplate()
+		if code != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+			return
+		}
+		if _, ok := srv.checkStyle(w, placeholderID, model.SymbologyQR, t.Style); !ok {
+			return
+		}
+		t.OwnerID, t.WorkspaceID = userIDFromRequest(r), scope
+		created, err := srv.Store.CreateTemplate(r.Context(), t)
+		if err != nil {
+			writeStoreError(w, err, "create_failed")
+			return
+		}
+		writeJSON(w, http.StatusCreated, created.NormalizeForResponse())
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (srv *Server) templateItemHandler(w http.ResponseWriter, r *http.Request) {
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/qr-templates/"), "/")
+	if templateID, ok := strings.CutSuffix(id, "/instantiate"); ok {
+		srv.instantiateHandler(w, r, templateID)
+		return
+	}
+	if id == "" || strings.Contains(id, "/") {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		t, ok := srv.getTemplateAuthorized(w, r, id, workspace.RoleViewer)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, t.NormalizeForResponse())
+	case http.MethodPut:
+		if _, ok := srv.getTemplateAuthorized(w, r, id, workspace.RoleEditor); !ok {
+			return
+		}
+		var req templateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		t, code := req.toTemplate()
+		if code != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+			return
+		}
+		if _, ok := srv.checkStyle(w, placeholderID, model.SymbologyQR, t.Style); !ok {
+			return
+		}
+		updated, err := srv.Store.UpdateTemplate(r.Context(), id, t)
+		if err != nil {
+			writeTemplateStoreError(w, err, "update_failed")
+			return
+		}
+		writeJSON(w, http.StatusOK, updated.NormalizeForResponse())
+	case http.MethodDelete:
+		if _, ok := srv.getTemplateAuthorized(w, r, id, workspace.RoleEditor); !ok {
+			return
+		}
+		if err := srv.Store.DeleteTemplate(r.Context(), id); err != nil {
+			writeTemplateStoreError(w, err, "delete_failed")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// getTemplateAuthorized is getAuthorized for templates.
+func (srv *Server) getTemplateAuthorized(w http.ResponseWriter, r *http.Request, id string, min workspace.Role) (model.QrTemplate, bool) {
+	t, err := srv.Store.GetTemplate(r.Context(), id)
+	if err != nil {
+		writeTemplateStoreError(w, err, "get_failed")
+		return model.QrTemplate{}, false
+	}
+	if !srv.accessFor(r).allowOwned(w, t.WorkspaceID, t.OwnerID, min) {
+		return model.QrTemplate{}, false
+	}
+	return t, true
+}
+
+func writeTemplateStoreError(w http.ResponseWriter, err error, code string) {
+	if errors.Is(err, store.ErrNotFound) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return
+	}
+	writeStoreError(w, err, code)
+}
+
+type templateInstance struct {
+	Variables map[string]string `json:"variables,omitempty"`
+	// Label overrides the template's label pattern for this instance.
+	Label string `json:"label,omitempty"`
+}
+
+type instantiateRequest struct {
+	Instances []templateInstance `json:"instances"`
+}
+
+// instantiateHandler creates one code per instance. Every instance is
+// expanded and the whole batch is checked against the caller's quota, then
+// created in one transaction: either every code exists afterwards or none
+// does.
+func (srv *Server) instantiateHandler(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req instantiateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	if len(req.Instances) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "instances_required"})
+		return
+	}
+	if len(req.Instances) > maxTemplateInstances {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "too_many_instances"})
+		return
+	}
+
+	// Codes are created in the template's workspace.
+	t, ok := srv.getTemplateAuthorized(w, r, id, workspace.RoleEditor)
+	if !ok {
+		return
+	}
+
+	// Every instance is drawn the same way, so one report covers them all.
+	report, ok := srv.checkStyle(w, placeholderID, model.SymbologyQR, t.Style)
+	if !ok {
+		return
+	}
+
+	ownerID := userIDFromRequest(r)
+	inputs := make([]store.CreateInput, 0, len(req.Instances))
+	for i, inst := range req.Instances {
+		n := strconv.Itoa(i + 1)
+		raw := func(name string) (string, bool) {
+			if v, ok := inst.Variables[name]; ok {
+				return v, true
+			}
+			if name == "n" {
+				return n, true
+			}
+			return "", false
+		}
+		escaped := func(name string) (string, bool) {
+			v, ok := raw(name)
+			return url.PathEscape(v), ok
+		}
+
+		label, missing := model.ExpandTemplate(t.LabelPattern, raw)
+		if inst.Label != "" {
+			label, missing = strings.TrimSpace(inst.Label), ""
+		}
+		dest, missingURL := model.ExpandTemplate(t.URLPattern, escaped)
+		link, missingLink := expandAppLink(t.AppLink, escaped)
+		for _, m := range []string{missingURL, missingLink} {
+			if missing == "" {
+				missing = m
+			}
+		}
+		if missing != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "variable_missing", "variable": missing, "index": i})
+			return
+		}
+		if !isValidHTTPURL(dest) {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "url_invalid", "index": i})
+			return
+		}
+		if code := validateDestination(t.DestinationType, link); code != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": code, "index": i})
+			return
+		}
+
+		active := t.Active
+		inputs = append(inputs, store.CreateInput{
+			OwnerID:         ownerID,
+			WorkspaceID:     t.WorkspaceID,
+			Label:           label,
+			URL:             dest,
+			Active:          &active,
+			Campaign:        t.Campaign,
+			Tags:            t.Tags,
+			Utm:             t.Utm,
+			DestinationType: t.DestinationType,
+			AppLink:         link,
+			Style:           t.Style,
+			Scannability:    report,
+		})
+	}
+
+	newActive := 0
+	if t.Active {
+		newActive = len(inputs)
+	}
+	if !srv.checkQuota(w, r, quotaForUserType(userTypeFromRequest(r)), len(inputs), newActive) {
+		return
+	}
+
+	created, err := srv.Store.CreateBatch(r.Context(), inputs)
+	if err != nil {
+		writeStoreError(w, err, "create_failed")
+		return
+	}
+	for i := range created {
+		created[i] = created[i].NormalizeForResponse()
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
@@ -0,0 +1,179 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func TestClone_CopiesDestinationAndCountsQuota(t *testing.T) {
+	s := store.NewMemoryStore()
+	src, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "alice", Label: "Menu", URL: "https://example.com/menu", Campaign: "spring"})
+	r := NewRouter(Server{Store: s})
+
+	req := jsonRequest(http.MethodPost, "/api/qr-codes/"+src.ID+"/clone", map[string]any{"label": "Menu 2"})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(req, "alice", ""))
+	var clone model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&clone)
+	if w.Code != http.StatusCreated || clone.ID == src.ID || clone.Label != "Menu 2" || clone.URL != src.URL || clone.Campaign != "spring" || clone.OwnerID != "alice" {
+		t.Fatalf("unexpected clone %d %+v", w.Code, clone)
+	}
+
+	// Fill the free plan's active quota (5), then cloning an active code fails.
+	for i := 0; i < 3; i++ {
+		_, _ = s.Create(context.Background(), store.CreateInput{OwnerID: "alice", URL: "https://example.com"})
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes/"+src.ID+"/clone", map[string]any{}), "alice", ""))
+	var resp errResp
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if w.Code != http.StatusForbidden || resp.Error != "quota_active_exceeded" {
+		t.Fatalf("expected quota_active_exceeded, got %d %q", w.Code, resp.Error)
+	}
+}
+
+func createTemplate(t *testing.T, r http.Handler, body map[string]any) model.QrTemplate {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-templates", body))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create template: expected %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var tmpl model.QrTemplate
+	_ = json.NewDecoder(w.Body).Decode(&tmpl)
+	return tmpl
+}
+
+func TestTemplate_Instantiate(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	tmpl := createTemplate(t, r, map[string]any{
+		"name":         "Store door",
+		"labelPattern": "Door #{n} – {city}",
+		"urlPattern":   "https://example.com/stores/{city}?ref=door",
+		"campaign":     "doors",
+		"tags":         []string{"doors", " doors"},
+		"style":        map[string]any{"foreground": "#1a237e", "errorCorrection": "H"},
+		"utm":          map[string]string{"source": "qr", "content": "{qr_id}"},
+	})
+	if len(tmpl.Tags) != 1 || tmpl.Style == nil {
+		t.Fatalf("expected tags and style saved, got %+v", tmpl)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{
+		"instances": []map[string]any{
+			{"variables": map[string]string{"city": "San José"}},
+			{"variables": map[string]string{"city": "oslo"}, "label": "Oslo flagship"},
+		},
+	}))
+	var created []model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&created)
+	if w.Code != http.StatusCreated || len(created) != 2 {
+		t.Fatalf("expected 2 codes, got %d: %s", w.Code, w.Body.String())
+	}
+	if created[0].Label != "Door #1 – San José" || created[0].URL != "https://example.com/stores/San%20Jos%C3%A9?ref=door" {
+		t.Fatalf("unexpected first code %+v", created[0])
+	}
+	if created[1].Label != "Oslo flagship" || created[1].Campaign != "doors" || created[1].Utm == nil || created[1].Utm.Content != "{qr_id}" {
+		t.Fatalf("unexpected second code %+v", created[1])
+	}
+	for _, q := range created {
+		if len(q.Tags) != 1 || q.Tags[0] != "doors" || q.Style == nil || q.Style.Foreground != "#1a237e" || q.Scannability == nil {
+			t.Fatalf("expected tags, style and report copied, got %+v", q)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{
+		"instances": []map[string]any{{"variables": map[string]string{}}},
+	}))
+	var missing struct {
+		Error    string `json:"error"`
+		Variable string `json:"variable"`
+	}
+	_ = json.NewDecoder(w.Body).Decode(&missing)
+	if w.Code != http.StatusBadRequest || missing.Error != "variable_missing" || missing.Variable != "city" {
+		t.Fatalf("expected variable_missing city, got %d %+v", w.Code, missing)
+	}
+}
+
+func TestTemplate_InstantiateChecksWholeBatchAgainstQuota(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	tmpl := createTemplate(t, r, map[string]any{"name": "Table", "labelPattern": "Table {n}", "urlPattern": "https://example.com/t/{n}"})
+
+	// Free plan allows 5 active codes; asking for 6 creates none.
+	instances := make([]map[string]any, 6)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{"instances": instances}))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected %d, got %d", http.StatusForbidden, w.Code)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 0 {
+		t.Fatalf("expected no codes created, got %d", n)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{"instances": instances[:5]}))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestTemplate_RejectsInvalidPattern(t *testing.T) {
+	r := NewRouter(Server{Store: store.NewMemoryStore()})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodPost, "/api/qr-templates", map[string]any{"name": "x", "urlPattern": "http://{host}/"}))
+	var resp errResp
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if w.Code != http.StatusBadRequest || resp.Error != "url_invalid" {
+		t.Fatalf("expected url_invalid, got %d %q", w.Code, resp.Error)
+	}
+}
+
+func TestTemplate_PersonalTemplatesAreTheOwners(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-templates", map[string]any{"name": "Mine", "urlPattern": "https://example.com/{n}"}), "alice", ""))
+	var tmpl model.QrTemplate
+	_ = json.NewDecoder(w.Body).Decode(&tmpl)
+	if w.Code != http.StatusCreated || tmpl.OwnerID != "alice" {
+		t.Fatalf("create: %d %+v", w.Code, tmpl)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodGet, "/api/qr-templates", nil), "bob", ""))
+	var items []model.QrTemplate
+	_ = json.NewDecoder(w.Body).Decode(&items)
+	if w.Code != http.StatusOK || len(items) != 0 {
+		t.Fatalf("bob's list: %d %+v", w.Code, items)
+	}
+
+	path := "/api/qr-templates/" + tmpl.ID
+	for _, req := range []*http.Request{
+		jsonRequest(http.MethodGet, path, nil),
+		jsonRequest(http.MethodPut, path, map[string]any{"name": "Mine now", "urlPattern": "https://phish.test/{n}"}),
+		jsonRequest(http.MethodPost, path+"/instantiate", map[string]any{"instances": []map[string]any{{}}}),
+		jsonRequest(http.MethodDelete, path, nil),
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(req, "bob", ""))
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("%s %s as bob: expected %d, got %d", req.Method, req.URL.Path, http.StatusNotFound, w.Code)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodGet, path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("admin key: expected %d, got %d", http.StatusOK, w.Code)
+	}
+}
@@ -0,0 +1,71 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+// expiredStore fails the way a database store does once an operation's
+// deadline has passed.
+type expiredStore struct {
+	store.Store
+}
+
+func (expiredStore) Get(context.Context, string) (model.QrCode, error) {
+	return model.QrCode{}, context.DeadlineExceeded
+}
+
+func (expiredStore) List(context.Context) ([]model.QrCode, error) {
+	return nil, context.DeadlineExceeded
+}
+
+func (expiredStore) CountTotal(context.Context, string) (int, error) {
+	return 0, context.DeadlineExceeded
+}
+
+func TestStoreDeadline_Answers504(t *testing.T) {
+	r := NewRouter(Server{Store: expiredStore{store.NewMemoryStore()}})
+
+	for _, tc := range []struct {
+		req  *http.Request
+		code string
+	}{
+		{jsonRequest(http.MethodGet, "/api/qr-codes/abc", nil), "get_failed"},
+		{jsonRequest(http.MethodGet, "/api/qr-codes", nil), "list_failed"},
+		{jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com"}), "quota_check_failed"},
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, tc.req)
+		var resp errResp
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		if w.Code != http.StatusGatewayTimeout || resp.Error != tc.code {
+			t.Fatalf("%s %s: expected %d %s, got %d %s", tc.req.Method, tc.req.URL.Path, http.StatusGatewayTimeout, tc.code, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestStoreCanceled_Answers503(t *testing.T) {
+	s := store.NewMemoryStore()
+	created, err := s.Create(context.Background(), store.CreateInput{URL: "https://example.com"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	r := NewRouter(Server{Store: s})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodDelete, "/api/qr-codes/"+created.ID, nil).WithContext(ctx))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected %d, got %d: %s", http.StatusServiceUnavailable, w.Code, w.Body.String())
+	}
+	if _, err := s.Get(context.Background(), created.ID); err != nil {
+		t.Fatalf("code deleted by a cancelled request: %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+package httpapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/apitoken"
+)
+
+// RequiredTokenScope is the scope an API token needs for r: qr:read to read,
+// qr:write for everything else under /api/. PDF exports and decoding photos
+// only read.
+func RequiredTokenScope(r *http.Request) string {
+	if !strings.HasPrefix(r.URL.Path, "/api/") {
+		return ""
+	}
+	if r.Method == http.MethodGet || r.Method == http.MethodHead || strings.HasPrefix(r.URL.Path, "/api/qr-codes/export/") || r.URL.Path == "/api/qr-codes/decode" {
+		return apitoken.ScopeQrRead
+	}
+	return apitoken.ScopeQrWrite
+}
@@ -0,0 +1,28 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/apitoken"
+)
+
+func TestRequiredTokenScope(t *testing.T) {
+	cases := []struct {
+		method, path, want string
+	}{
+		{http.MethodGet, "/api/qr-codes", apitoken.ScopeQrRead},
+		{http.MethodPost, "/api/qr-codes", apitoken.ScopeQrWrite},
+		{http.MethodPatch, "/api/qr-codes/abc", apitoken.ScopeQrWrite},
+		{http.MethodPost, "/api/qr-codes/export/pdf", apitoken.ScopeQrRead},
+		{http.MethodPost, "/api/qr-codes/decode", apitoken.ScopeQrRead},
+		{http.MethodPut, "/api/settings", apitoken.ScopeQrWrite},
+		{http.MethodGet, "/healthz", ""},
+	}
+	for _, tc := range cases {
+		if got := RequiredTokenScope(httptest.NewRequest(tc.method, tc.path, nil)); got != tc.want {
+			t.Errorf("%s %s: got %q, want %q", tc.method, tc.path, got, tc.want)
+		}
+	}
+}
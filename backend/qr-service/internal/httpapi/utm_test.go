@@ -0,0 +1,126 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+func TestUtm_Create_RoundTripsTemplate(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+
+	body, _ := json.Marshal(map[string]any{
+		"label":    "x",
+		"url":      "https://example.com",
+		"campaign": "summer2026",
+		"utm":      map[string]string{"source": "qr", "content": "{qr_id}-{country}"},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/qr-codes", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var created model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&created)
+	if created.Campaign != "summer2026" {
+		t.Fatalf("expected campaign summer2026, got %q", created.Campaign)
+	}
+	if created.Utm == nil || created.Utm.Content != "{qr_id}-{country}" {
+		t.Fatalf("expected utm template to round-trip, got %+v", created.Utm)
+	}
+}
+
+func TestUtm_RejectsUnknownPlaceholder(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+
+	body, _ := json.Marshal(map[string]any{
+		"label": "x",
+		"url":   "https://example.com",
+		"utm":   map[string]string{"source": "{qrid}"},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/qr-codes", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	var resp errResp
+	_ = json.NewDecoder(w.Body).Decode(&resp)
+	if resp.Error != "utm_invalid" {
+		t.Fatalf("expected utm_invalid, got %q", resp.Error)
+	}
+
+	settingsBody, _ := json.Marshal(map[string]any{
+		"campaignUtm": map[string]any{"spring": map[string]string{"campaign": "{campaign"}},
+	})
+	settingsReq := httptest.NewRequest(http.MethodPut, "/api/settings", bytes.NewReader(settingsBody))
+	settingsReq.Header.Set("Content-Type", "application/json")
+	settingsW := httptest.NewRecorder()
+	r.ServeHTTP(settingsW, settingsReq)
+	if settingsW.Code != http.StatusBadRequest {
+		t.Fatalf("expected %d, got %d", http.StatusBadRequest, settingsW.Code)
+	}
+}
+
+func TestUtm_SettingsKeepCampaignsWhenOmitted(t *testing.T) {
+	s := store.NewMemoryStore()
+	r := NewRouter(Server{Store: s})
+	put := func(body map[string]any) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, jsonRequest(http.MethodPut, "/api/settings", body))
+		if w.Code != http.StatusOK {
+			t.Fatalf("put %v: expected %d, got %d", body, http.StatusOK, w.Code)
+		}
+	}
+
+	put(map[string]any{"campaignUtm": map[string]any{"spring": map[string]string{"medium": "print"}}})
+	put(map[string]any{"defaultRedirectUrl": "https://example.com"})
+	got, _ := s.GetSettings(context.Background(), "")
+	if got.DefaultRedirectURL != "https://example.com" || got.CampaignUtm["spring"].Medium != "print" {
+		t.Fatalf("expected campaigns kept, got %+v", got)
+	}
+
+	put(map[string]any{"defaultRedirectUrl": "https://example.com", "campaignUtm": map[string]any{}})
+	if got, _ := s.GetSettings(context.Background(), ""); len(got.CampaignUtm) != 0 {
+		t.Fatalf("expected campaigns cleared, got %+v", got.CampaignUtm)
+	}
+}
+
+func TestSettings_BelongToTheCaller(t *testing.T) {
+	s := store.NewMemoryStore()
+	_ = s.UpdateSettings(context.Background(), "alice", model.UserSettings{DefaultRedirectURL: "https://example.com/alice"})
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+	get := func(req *http.Request) (int, model.UserSettings) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		var got model.UserSettings
+		_ = json.NewDecoder(w.Body).Decode(&got)
+		return w.Code, got
+	}
+
+	if code, got := get(asMember(jsonRequest(http.MethodGet, "/api/settings", nil), "alice", "")); code != http.StatusOK || got.DefaultRedirectURL != "https://example.com/alice" {
+		t.Fatalf("alice: %d %+v", code, got)
+	}
+	if code, got := get(asMember(jsonRequest(http.MethodGet, "/api/settings", nil), "bob", "")); code != http.StatusOK || got.DefaultRedirectURL != "" {
+		t.Fatalf("bob: %d %+v", code, got)
+	}
+	if code, _ := get(asMember(jsonRequest(http.MethodGet, "/api/settings?ownerId=alice", nil), "bob", "")); code != http.StatusForbidden {
+		t.Fatalf("bob reading alice's: expected %d, got %d", http.StatusForbidden, code)
+	}
+	if code, got := get(adminRequest(http.MethodGet, "/api/settings?ownerId=alice", nil)); code != http.StatusOK || got.DefaultRedirectURL != "https://example.com/alice" {
+		t.Fatalf("admin: %d %+v", code, got)
+	}
+}
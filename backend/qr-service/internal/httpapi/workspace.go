@@ -0,0 +1,137 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+)
+
+// WorkspaceRoles resolves a user's role in a team workspace;
+// workspace.Client implements it against user-service.
+type WorkspaceRoles interface {
+	Role(ctx context.Context, workspaceID, userID string) (workspace.Role, error)
+}
+
+// workspaceFromRequest returns the workspace the caller is working in, from
+// X-Workspace-Id, or "" for their personal codes.
+func workspaceFromRequest(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get("X-Workspace-Id"))
+}
+
+// access checks what the caller may do in each workspace, looking each role
+// up at most once per request.
+type access struct {
+	srv     *Server
+	r       *http.Request
+	isAdmin bool
+	userID  string
+	roles   map[string]workspace.Role
+	errs    map[string]error
+}
+
+func (srv *Server) accessFor(r *http.Request) *access {
+	return &access{
+		srv:     srv,
+		r:       r,
+		isAdmin: srv.AdminAPIKey != "" && r.Header.Get("X-Admin-Key") == srv.AdminAPIKey,
+		userID:  userIDFromRequest(r),
+		roles:   map[string]workspace.Role{},
+		errs:    map[string]error{},
+	}
+}
+
+// check returns 0 when the caller may act on workspaceID's codes with at
+// least min, or the status and error code to answer with. Anyone may work in
+// their personal scope (""); checkCode limits its codes to their owner.
+// Non-members get 404 so workspace contents can't be probed.
+func (a *access) check(workspaceID string, min workspace.Role) (int, string) {
+	if workspaceID == "" || a.isAdmin {
+		return 0, ""
+	}
+	if a.srv.Workspaces == nil {
+		return http.StatusServiceUnavailable, "workspaces_unavailable"
+	}
+	role, ok := a.roles[workspaceID]
+	err := a.errs[workspaceID]
+	if !ok && err == nil {
+		role, err = a.srv.Workspaces.Role(a.r.Context(), workspaceID, a.userID)
+		a.roles[workspaceID], a.errs[workspaceID] = role, err
+	}
+	switch {
+	case errors.Is(err, workspace.ErrNotMember):
+		return http.StatusNotFound, "not_found"
+	case err != nil:
+		return http.StatusBadGateway, "workspace_lookup_failed"
+	case !role.AtLeast(min):
+		return http.StatusForbidden, "forbidden"
+	}
+	return 0, ""
+}
+
+// checkOwned is check for an existing code or template. Personal ones are
+// their owner's alone; anyone else gets 404, as for a workspace they aren't
+// in. Those made without an owner stay with anonymous callers.
+func (a *access) checkOwned(workspaceID, ownerID string, min workspace.Role) (int, string) {
+	if workspaceID == "" && !a.isAdmin && ownerID != a.userID {
+		return http.StatusNotFound, "not_found"
+	}
+	return a.check(workspaceID, min)
+}
+
+// checkCode is checkOwned for a code.
+func (a *access) checkCode(q model.QrCode, min workspace.Role) (int, string) {
+	return a.checkOwned(q.WorkspaceID, q.OwnerID, min)
+}
+
+// allow is check that writes the error response.
+func (a *access) allow(w http.ResponseWriter, workspaceID string, min workspace.Role) bool {
+	if status, code := a.check(workspaceID, min); status != 0 {
+		writeJSON(w, status, map[string]string{"error": code})
+		return false
+	}
+	return true
+}
+
+// allowOwned is checkOwned that writes the error response.
+func (a *access) allowOwned(w http.ResponseWriter, workspaceID, ownerID string, min workspace.Role) bool {
+	if status, code := a.checkOwned(workspaceID, ownerID, min); status != 0 {
+		writeJSON(w, status, map[string]string{"error": code})
+		return false
+	}
+	return true
+}
+
+// allowCode is allowOwned for a code.
+func (a *access) allowCode(w http.ResponseWriter, q model.QrCode, min workspace.Role) bool {
+	return a.allowOwned(w, q.WorkspaceID, q.OwnerID, min)
+}
+
+// canView reports whether the caller may read q: their own personal code, or
+// one in a workspace they can view. Admins can view everything.
+func (a *access) canView(q model.QrCode) bool {
+	status, _ := a.checkCode(q, workspace.RoleViewer)
+	return status == 0
+}
+
+// inScope keeps the codes belonging to the request's workspace (or, with no
+// workspace selected, the caller's personal codes). Callers have already
+// checked their role in the workspace.
+func (a *access) inScope(items []model.QrCode, scope string) []model.QrCode {
+	out := items[:0]
+	for _, q := range items {
+		if a.listed(q.WorkspaceID, q.OwnerID, scope) {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+// listed reports whether a code or template belongs in scope's listing.
+func (a *access) listed(workspaceID, ownerID, scope string) bool {
+	return workspaceID == scope && (scope != "" || a.isAdmin || ownerID == a.userID)
+}
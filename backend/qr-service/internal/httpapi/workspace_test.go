@@ -0,0 +1,218 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qr-dragonfly/qr-dragonfly/backend/sdk/workspace"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+// fakeRoles maps workspace → user → role; "broken" fails every lookup.
+type fakeRoles map[string]map[string]workspace.Role
+
+func (f fakeRoles) Role(_ context.Context, workspaceID, userID string) (workspace.Role, error) {
+	if userID == "broken" {
+		return "", errors.New("user-service down")
+	}
+	role, ok := f[workspaceID][userID]
+	if !ok {
+		return "", workspace.ErrNotMember
+	}
+	return role, nil
+}
+
+var teamRoles = fakeRoles{"ws1": {"olga": workspace.RoleOwner, "ed": workspace.RoleEditor, "vic": workspace.RoleViewer}}
+
+func asMember(req *http.Request, userID, workspaceID string) *http.Request {
+	req.Header.Set("X-User-Id", userID)
+	if workspaceID != "" {
+		req.Header.Set("X-Workspace-Id", workspaceID)
+	}
+	return req
+}
+
+func TestWorkspace_CreateAndListAreScoped(t *testing.T) {
+	s := store.NewMemoryStore()
+	personal, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "ed", URL: "https://example.com/mine"})
+	r := NewRouter(Server{Store: s, Workspaces: teamRoles})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"label": "Team", "url": "https://example.com/team"}), "ed", "ws1"))
+	var created model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&created)
+	if w.Code != http.StatusCreated || created.WorkspaceID != "ws1" {
+		t.Fatalf("editor create: %d %+v", w.Code, created)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes", map[string]any{"url": "https://example.com/x"}), "vic", "ws1"))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("viewer create: expected %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	list := func(userID, workspaceID string) (int, []model.QrCode) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(jsonRequest(http.MethodGet, "/api/qr-codes", nil), userID, workspaceID))
+		var items []model.QrCode
+		_ = json.NewDecoder(w.Body).Decode(&items)
+		return w.Code, items
+	}
+	if code, items := list("vic", "ws1"); code != http.StatusOK || len(items) != 1 || items[0].ID != created.ID {
+		t.Fatalf("workspace list: %d %+v", code, items)
+	}
+	if code, items := list("ed", ""); code != http.StatusOK || len(items) != 1 || items[0].ID != personal.ID {
+		t.Fatalf("personal list: %d %+v", code, items)
+	}
+	if code, _ := list("mallory", "ws1"); code != http.StatusNotFound {
+		t.Fatalf("non-member list: expected %d, got %d", http.StatusNotFound, code)
+	}
+	if code, _ := list("broken", "ws1"); code != http.StatusBadGateway {
+		t.Fatalf("lookup failure: expected %d, got %d", http.StatusBadGateway, code)
+	}
+}
+
+func TestWorkspace_ItemRoles(t *testing.T) {
+	s := store.NewMemoryStore()
+	team, _ := s.Create(context.Background(), store.CreateInput{OwnerID: "olga", WorkspaceID: "ws1", URL: "https://example.com/team"})
+	r := NewRouter(Server{Store: s, Workspaces: teamRoles, AdminAPIKey: "k"})
+	path := "/api/qr-codes/" + team.ID
+
+	cases := []struct {
+		name, user, method string
+		body               any
+		want               int
+	}{
+		{"viewer reads", "vic", http.MethodGet, nil, http.StatusOK},
+		{"viewer can't edit", "vic", http.MethodPatch, map[string]any{"label": "x"}, http.StatusForbidden},
+		{"non-member", "mallory", http.MethodGet, nil, http.StatusNotFound},
+		{"editor edits", "ed", http.MethodPatch, map[string]any{"label": "Renamed"}, http.StatusOK},
+		{"viewer can't delete", "vic", http.MethodDelete, nil, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(jsonRequest(tc.method, path, tc.body), tc.user, ""))
+		if w.Code != tc.want {
+			t.Fatalf("%s: expected %d, got %d: %s", tc.name, tc.want, w.Code, w.Body.String())
+		}
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodGet, path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("admin key: expected %d, got %d", http.StatusOK, w.Code)
+	}
+
+	// Without user-service, workspace codes are out of reach but personal
+	// codes still work.
+	personal, _ := s.Create(context.Background(), store.CreateInput{URL: "https://example.com/p"})
+	r = NewRouter(Server{Store: s})
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodGet, path, nil), "olga", ""))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("no user-service: expected %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, jsonRequest(http.MethodGet, "/api/qr-codes/"+personal.ID, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("personal code: expected %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestWorkspace_PersonalCodesAreTheOwners(t *testing.T) {
+	s := store.NewMemoryStore()
+	alice, bob := seedOwnedCodes(t, s)
+	r := NewRouter(Server{Store: s, AdminAPIKey: "k"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodGet, "/api/qr-codes", nil), "bob", ""))
+	var items []model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&items)
+	if w.Code != http.StatusOK || len(items) != 1 || items[0].ID != bob.ID {
+		t.Fatalf("bob's list: %d %+v", w.Code, items)
+	}
+
+	path := "/api/qr-codes/" + alice.ID
+	for _, req := range []*http.Request{
+		jsonRequest(http.MethodGet, path, nil),
+		jsonRequest(http.MethodPatch, path, map[string]any{"label": "Mine now"}),
+		jsonRequest(http.MethodDelete, path, nil),
+		jsonRequest(http.MethodPost, path+"/clone", map[string]any{}),
+	} {
+		for _, user := range []string{"bob", ""} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, asMember(req.Clone(req.Context()), user, ""))
+			if w.Code != http.StatusNotFound {
+				t.Fatalf("%s %s as %q: expected %d, got %d", req.Method, req.URL.Path, user, http.StatusNotFound, w.Code)
+			}
+		}
+	}
+
+	// A filter in the personal scope only reaches the caller's own codes.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes/bulk", map[string]any{"action": "deactivate", "filter": map[string]any{"search": "e"}}), "bob", ""))
+	var bulk bulkResponse
+	_ = json.NewDecoder(w.Body).Decode(&bulk)
+	if w.Code != http.StatusOK || len(bulk.Results) != 1 || bulk.Results[0].ID != bob.ID {
+		t.Fatalf("bob's bulk: %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, adminRequest(http.MethodGet, path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("admin key: expected %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestWorkspace_CloneAndTemplates(t *testing.T) {
+	s := store.NewMemoryStore()
+	team, _ := s.Create(context.Background(), store.CreateInput{WorkspaceID: "ws1", Label: "Menu", URL: "https://example.com/menu"})
+	r := NewRouter(Server{Store: s, Workspaces: teamRoles})
+
+	// A viewer may copy a team code into their personal space, not into the team.
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes/"+team.ID+"/clone", map[string]any{}), "vic", ""))
+	var clone model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&clone)
+	if w.Code != http.StatusCreated || clone.WorkspaceID != "" {
+		t.Fatalf("personal clone: %d %+v", w.Code, clone)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-codes/"+team.ID+"/clone", map[string]any{}), "vic", "ws1"))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("viewer clone into team: expected %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-templates", map[string]any{
+		"name": "Doors", "labelPattern": "Door {n}", "urlPattern": "https://example.com/doors/{n}",
+	}), "ed", "ws1"))
+	var tmpl model.QrTemplate
+	_ = json.NewDecoder(w.Body).Decode(&tmpl)
+	if w.Code != http.StatusCreated || tmpl.WorkspaceID != "ws1" {
+		t.Fatalf("team template: %d %+v", w.Code, tmpl)
+	}
+
+	instantiate := func(userID string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, asMember(jsonRequest(http.MethodPost, "/api/qr-templates/"+tmpl.ID+"/instantiate", map[string]any{
+			"instances": []map[string]any{{}},
+		}), userID, ""))
+		return w
+	}
+	if w := instantiate("vic"); w.Code != http.StatusForbidden {
+		t.Fatalf("viewer instantiate: expected %d, got %d", http.StatusForbidden, w.Code)
+	}
+	w = instantiate("ed")
+	var created []model.QrCode
+	_ = json.NewDecoder(w.Body).Decode(&created)
+	if w.Code != http.StatusCreated || len(created) != 1 || created[0].WorkspaceID != "ws1" {
+		t.Fatalf("editor instantiate: %d %s", w.Code, w.Body.String())
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"log"
+	"mime"
 	"net/http"
+	"slices"
 	"strings"
 )
 
@@ -28,6 +30,26 @@ func EnforceJSONHandler(next http.Handler) http.Handler {
 	})
 }
 
+// EnforceUploadHandler is EnforceJSONHandler for endpoints that take a file
+// as the request body: responses are still JSON, but the request Content-Type
+// must be one of types.
+func EnforceUploadHandler(next http.Handler, types ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+			ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if !slices.Contains(types, ct) {
+				http.Error(w, `{"error":"content_type_unsupported"}`, http.StatusUnsupportedMediaType)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 // ExposeResponseHeaders configures CORS Access-Control-Expose-Headers for clients.
 func ExposeResponseHeaders(next http.Handler, headers ...string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,24 @@
+package model
+
+// Destination types for a QR code.
+const (
+	DestinationURL     = "url"
+	DestinationAppLink = "app_link"
+)
+
+// AppLink routes a scan to a native app by platform. The code's URL remains the
+// web destination used on desktop and as the last resort on mobile.
+type AppLink struct {
+	// IOSURL and AndroidURL open the app: a custom scheme (myapp://...) or a
+	// universal/app link (https://...).
+	IOSURL     string `json:"iosUrl,omitempty"`
+	AndroidURL string `json:"androidUrl,omitempty"`
+
+	// Store URLs are used when the app link is missing or can't be opened.
+	AppStoreURL  string `json:"appStoreUrl,omitempty"`
+	PlayStoreURL string `json:"playStoreUrl,omitempty"`
+}
+
+func (a AppLink) IsZero() bool {
+	return a == AppLink{}
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// Change event types.
+const (
+	EventQrCodeCreated   = "qr_code.created"
+	EventQrCodeUpdated   = "qr_code.updated"
+	EventQrCodeDeleted   = "qr_code.deleted"
+	EventSettingsUpdated = "settings.updated"
+)
+
+// ChangeEvent records one committed change, so other services can follow
+// them. It names what changed; subscribers fetch the current state.
+type ChangeEvent struct {
+	// ID increases in commit order; subscribers resume after the last one
+	// they handled.
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+	// QrCodeID, OwnerID and WorkspaceID are empty for settings events.
+	QrCodeID    string    `json:"qrCodeId,omitempty"`
+	OwnerID     string    `json:"ownerId,omitempty"`
+	WorkspaceID string    `json:"workspaceId,omitempty"`
+	At          time.Time `json:"-"`
+	AtIso       string    `json:"atIso"`
+}
+
+func (e ChangeEvent) NormalizeForResponse() ChangeEvent {
+	e.AtIso = e.At.UTC().Format(time.RFC3339Nano)
+	return e
+}
+
+// CodeEvent returns an event of type typ about q.
+func CodeEvent(typ string, q QrCode) ChangeEvent {
+	return ChangeEvent{Type: typ, QrCodeID: q.ID, OwnerID: q.OwnerID, WorkspaceID: q.WorkspaceID}
+}
@@ -0,0 +1,54 @@
+package model
+
+// Frame borders, drawn around the code's quiet zone.
+const (
+	FrameBorderNone    = "none"
+	FrameBorderLine    = "line"
+	FrameBorderRounded = "rounded"
+	// FrameBorderBanner is a thick border with the caption set in a solid
+	// band, in the background color.
+	FrameBorderBanner = "banner"
+)
+
+// FrameBorders lists the border styles, the default first.
+var FrameBorders = []string{FrameBorderNone, FrameBorderLine, FrameBorderRounded, FrameBorderBanner}
+
+// FrameFonts are the typefaces captions can be set in, the default first.
+// They're embedded in the service, so every export looks the same.
+var FrameFonts = []string{"bold", "regular", "italic", "mono"}
+
+// Caption positions relative to the code.
+const (
+	CaptionBelow = "below"
+	CaptionAbove = "above"
+)
+
+// QrFrame is a call-to-action frame around a rendered code. The zero value
+// draws no frame.
+type QrFrame struct {
+	// Border is one of FrameBorders; empty means none.
+	Border string `json:"border,omitempty"`
+	// Caption is a short line such as "Scan me"; empty hides it.
+	Caption string `json:"caption,omitempty"`
+	// CaptionPosition is CaptionBelow (default) or CaptionAbove.
+	CaptionPosition string `json:"captionPosition,omitempty"`
+	// Font is one of FrameFonts; empty means the first.
+	Font string `json:"font,omitempty"`
+	// Color is the #rrggbb border and caption color; empty means the style's
+	// foreground.
+	Color string `json:"color,omitempty"`
+	// ShowURL prints the tracking link beneath the code, for phones that
+	// can't scan it.
+	ShowURL bool `json:"showUrl,omitempty"`
+}
+
+func (f QrFrame) IsZero() bool {
+	return f == QrFrame{}
+}
+
+func (f QrFrame) FontOrDefault() string {
+	if f.Font == "" {
+		return FrameFonts[0]
+	}
+	return f.Font
+}
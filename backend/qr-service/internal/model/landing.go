@@ -0,0 +1,18 @@
+package model
+
+// LandingPage is a hosted page click-service shows instead of redirecting when
+// a code can't be followed (it's inactive) and has no fallback URL. Every field
+// is optional; click-service fills in a title and message for the reason.
+type LandingPage struct {
+	Title   string `json:"title,omitempty"`
+	Message string `json:"message,omitempty"`
+	// LogoURL is shown above the title. It must be https.
+	LogoURL string `json:"logoUrl,omitempty"`
+	// ButtonText and ButtonURL add a call to action; ButtonURL must be https.
+	ButtonText string `json:"buttonText,omitempty"`
+	ButtonURL  string `json:"buttonUrl,omitempty"`
+}
+
+func (p LandingPage) IsZero() bool {
+	return p == LandingPage{}
+}
@@ -3,15 +3,60 @@ package model
 import "time"
 
 type QrCode struct {
-	ID           string    `json:"id"`
-	Label        string    `json:"label"`
-	URL          string    `json:"url"`
-	Active       bool      `json:"active"`
+	ID      string `json:"id"`
+	OwnerID string `json:"ownerId,omitempty"`
+	// WorkspaceID, when set, shares the code with that workspace's members
+	// according to their role; otherwise only the owner context sees it.
+	WorkspaceID string `json:"workspaceId,omitempty"`
+	Label       string `json:"label"`
+	URL         string `json:"url"`
+	Active      bool   `json:"active"`
+	Campaign    string `json:"campaign,omitempty"`
+	// Tags are free-form labels for grouping and bulk operations.
+	Tags []string     `json:"tags,omitempty"`
+	Utm  *UtmTemplate `json:"utm,omitempty"`
+	// DestinationType is DestinationURL or DestinationAppLink.
+	DestinationType string   `json:"destinationType"`
+	AppLink         *AppLink `json:"appLink,omitempty"`
+	// Symbology is the barcode the tracking URL is printed as; see
+	// Symbologies.
+	Symbology string `json:"symbology"`
+	// FallbackURL is where scans of the code go while it's inactive, ahead of
+	// the owner's default redirect URL.
+	FallbackURL string `json:"fallbackUrl,omitempty"`
+	// LandingPage is shown while the code is inactive and has no fallback URL.
+	LandingPage *LandingPage `json:"landingPage,omitempty"`
+	// Style is how the code is drawn; nil is plain black on white.
+	Style *QrStyle `json:"style,omitempty"`
+	// Scannability is measured whenever Style is saved.
+	Scannability *ScanReport `json:"scannability,omitempty"`
+	// Frame is the call-to-action border and captions drawn around the code
+	// in exports; nil draws none.
+	Frame        *QrFrame  `json:"frame,omitempty"`
 	CreatedAt    time.Time `json:"-"`
 	CreatedAtIso string    `json:"createdAtIso"`
+
+	// DisabledReason is set when an admin force-disables the code; the owner
+	// sees it and can't reactivate the code until an admin lifts it.
+	DisabledReason string    `json:"disabledReason,omitempty"`
+	DisabledAt     time.Time `json:"-"`
+	DisabledAtIso  string    `json:"disabledAtIso,omitempty"`
+}
+
+func (q QrCode) IsModerated() bool {
+	return q.DisabledReason != ""
 }
 
 func (q QrCode) NormalizeForResponse() QrCode {
 	q.CreatedAtIso = q.CreatedAt.UTC().Format(time.RFC3339)
+	if !q.DisabledAt.IsZero() {
+		q.DisabledAtIso = q.DisabledAt.UTC().Format(time.RFC3339)
+	}
+	if q.DestinationType == "" {
+		q.DestinationType = DestinationURL
+	}
+	if q.Symbology == "" {
+		q.Symbology = SymbologyQR
+	}
 	return q
 }
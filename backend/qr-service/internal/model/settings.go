@@ -2,4 +2,8 @@ package model
 
 type UserSettings struct {
 	DefaultRedirectURL string `json:"defaultRedirectUrl"`
+
+	// CampaignUtm holds per-campaign UTM templates keyed by campaign name.
+	// A code's own template takes precedence field by field.
+	CampaignUtm map[string]UtmTemplate `json:"campaignUtm,omitempty"`
 }
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// Error correction levels, from the least to the most damage a code survives.
+const (
+	ErrorCorrectionL = "L"
+	ErrorCorrectionM = "M"
+	ErrorCorrectionQ = "Q"
+	ErrorCorrectionH = "H"
+)
+
+// QrStyle is how a code is drawn. The zero value is the plain black-on-white
+// code at level M.
+type QrStyle struct {
+	// Foreground and Background are #rrggbb colors; empty means black and
+	// white.
+	Foreground string `json:"foreground,omitempty"`
+	Background string `json:"background,omitempty"`
+	// ErrorCorrection is L, M, Q or H; empty means M.
+	ErrorCorrection string `json:"errorCorrection,omitempty"`
+	// LogoScale is the width of a logo centered on the code as a fraction of
+	// the code's width, 0 for none. The logo hides the modules beneath it.
+	LogoScale float64 `json:"logoScale,omitempty"`
+	// ForegroundCmyk and BackgroundCmyk are the process colors EPS and PDF
+	// exports print with. Unset, they're converted from the hex colors; an
+	// empty hex color is in turn converted from them for screen formats.
+	ForegroundCmyk *CmykColor `json:"foregroundCmyk,omitempty"`
+	BackgroundCmyk *CmykColor `json:"backgroundCmyk,omitempty"`
+}
+
+// CmykColor is a process color with each ink from 0 to 100 percent.
+type CmykColor struct {
+	C float64 `json:"c"`
+	M float64 `json:"m"`
+	Y float64 `json:"y"`
+	K float64 `json:"k"`
+}
+
+// Hex is the naive #rrggbb equivalent, good enough for a preview.
+func (c CmykColor) Hex() string {
+	ch := func(ink float64) int {
+		return int(math.Round(255 * (1 - ink/100) * (1 - c.K/100)))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", ch(c.C), ch(c.M), ch(c.Y))
+}
+
+func (s QrStyle) IsZero() bool {
+	return s == QrStyle{}
+}
+
+// Clone copies s, including its CMYK colors.
+func (s QrStyle) Clone() QrStyle {
+	if s.ForegroundCmyk != nil {
+		c := *s.ForegroundCmyk
+		s.ForegroundCmyk = &c
+	}
+	if s.BackgroundCmyk != nil {
+		c := *s.BackgroundCmyk
+		s.BackgroundCmyk = &c
+	}
+	return s
+}
+
+// ForegroundOrDefault and BackgroundOrDefault resolve the empty defaults,
+// falling back on the CMYK colors before black and white.
+func (s QrStyle) ForegroundOrDefault() string {
+	switch {
+	case s.Foreground != "":
+		return s.Foreground
+	case s.ForegroundCmyk != nil:
+		return s.ForegroundCmyk.Hex()
+	}
+	return "#000000"
+}
+
+func (s QrStyle) BackgroundOrDefault() string {
+	switch {
+	case s.Background != "":
+		return s.Background
+	case s.BackgroundCmyk != nil:
+		return s.BackgroundCmyk.Hex()
+	}
+	return "#ffffff"
+}
+
+func (s QrStyle) ErrorCorrectionOrDefault() string {
+	if s.ErrorCorrection == "" {
+		return ErrorCorrectionM
+	}
+	return s.ErrorCorrection
+}
+
+// ScanReport is how well a styled code survives being printed and
+// photographed, measured when its style was saved.
+type ScanReport struct {
+	// Score runs from 0 (never read) to 100 (read at every simulated print
+	// size and blur level, with ample contrast).
+	Score int `json:"score"`
+	// Readable is false when even a large, sharp rendering couldn't be read.
+	Readable bool `json:"readable"`
+	// ContrastRatio is the WCAG contrast ratio between the colors, 1 to 21.
+	ContrastRatio float64 `json:"contrastRatio"`
+	// MinPrintSizeMm is the smallest simulated print size that read at every
+	// blur level, 0 when none did.
+	MinPrintSizeMm int           `json:"minPrintSizeMm,omitempty"`
+	Warnings       []ScanWarning `json:"warnings,omitempty"`
+}
+
+type ScanWarning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
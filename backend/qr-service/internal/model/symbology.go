@@ -0,0 +1,14 @@
+package model
+
+// Barcode symbologies a code's tracking URL can be printed as. Every one
+// carries the same /r/{id} link, so scans are tracked the same way.
+const (
+	SymbologyQR         = "qr"
+	SymbologyDataMatrix = "data_matrix"
+	SymbologyAztec      = "aztec"
+	SymbologyPDF417     = "pdf417"
+	SymbologyCode128    = "code128"
+)
+
+// Symbologies lists the supported symbologies, the default first.
+var Symbologies = []string{SymbologyQR, SymbologyDataMatrix, SymbologyAztec, SymbologyPDF417, SymbologyCode128}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"regexp"
+	"time"
+)
+
+// QrTemplate is a reusable blueprint for near-identical codes, e.g. one per
+// store location. LabelPattern, URLPattern and the AppLink URLs may contain
+// {variable} placeholders filled in per instance; {n} is the 1-based
+// instance number. UTM values are copied verbatim and expanded by
+// click-service at redirect time as usual.
+type QrTemplate struct {
+	ID           string `json:"id"`
+	OwnerID      string `json:"ownerId,omitempty"`
+	WorkspaceID  string `json:"workspaceId,omitempty"`
+	Name         string `json:"name"`
+	LabelPattern string `json:"labelPattern"`
+	URLPattern   string `json:"urlPattern"`
+	Active       bool   `json:"active"`
+	Campaign     string `json:"campaign,omitempty"`
+	// Tags and Style are copied to every instance as-is.
+	Tags  []string `json:"tags,omitempty"`
+	Style *QrStyle `json:"style,omitempty"`
+
+	Utm             *UtmTemplate `json:"utm,omitempty"`
+	DestinationType string       `json:"destinationType"`
+	AppLink         *AppLink     `json:"appLink,omitempty"`
+
+	CreatedAt    time.Time `json:"-"`
+	CreatedAtIso string    `json:"createdAtIso"`
+}
+
+func (t QrTemplate) NormalizeForResponse() QrTemplate {
+	t.CreatedAtIso = t.CreatedAt.UTC().Format(time.RFC3339)
+	if t.DestinationType == "" {
+		t.DestinationType = DestinationURL
+	}
+	return t
+}
+
+var templateVariable = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+// TemplateVariables lists the distinct placeholders in s, in order of appearance.
+func TemplateVariables(s string) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, m := range templateVariable.FindAllStringSubmatch(s, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			out = append(out, m[1])
+		}
+	}
+	return out
+}
+
+// ExpandTemplate replaces each {variable} in s using lookup. The first
+// variable lookup can't resolve is returned as missing.
+func ExpandTemplate(s string, lookup func(name string) (string, bool)) (expanded, missing string) {
+	expanded = templateVariable.ReplaceAllStringFunc(s, func(m string) string {
+		name := m[1 : len(m)-1]
+		v, ok := lookup(name)
+		if !ok {
+			if missing == "" {
+				missing = name
+			}
+			return m
+		}
+		return v
+	})
+	return expanded, missing
+}
@@ -0,0 +1,30 @@
+package model
+
+// UtmTemplate describes UTM parameters that click-service merges into a
+// destination URL at redirect time. Values may contain placeholders such as
+// {qr_id} or {country}; empty fields are skipped.
+type UtmTemplate struct {
+	Source   string `json:"source,omitempty"`
+	Medium   string `json:"medium,omitempty"`
+	Campaign string `json:"campaign,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Term     string `json:"term,omitempty"`
+}
+
+// UtmPlaceholders lists the placeholders click-service knows how to expand.
+var UtmPlaceholders = []string{"{qr_id}", "{label}", "{campaign}", "{country}", "{date}"}
+
+func (t UtmTemplate) IsZero() bool {
+	return t == UtmTemplate{}
+}
+
+// Fields returns the template values keyed by their query parameter name.
+func (t UtmTemplate) Fields() map[string]string {
+	return map[string]string{
+		"utm_source":   t.Source,
+		"utm_medium":   t.Medium,
+		"utm_campaign": t.Campaign,
+		"utm_content":  t.Content,
+		"utm_term":     t.Term,
+	}
+}
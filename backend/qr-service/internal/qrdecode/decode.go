@@ -0,0 +1,102 @@
+// Package qrdecode finds and reads QR codes in photos and scans, and the
+// other symbologies codes can be printed as.
+package qrdecode
+
+import (
+	"errors"
+	"image"
+
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/aztec"
+	"github.com/makiuchi-d/gozxing/datamatrix"
+	multiqr "github.com/makiuchi-d/gozxing/multi/qrcode"
+	"github.com/makiuchi-d/gozxing/oned"
+	"github.com/makiuchi-d/gozxing/qrcode"
+
+	"qr-service/internal/model"
+)
+
+// ErrUnsupported is returned for symbologies there's no reader for, which is
+// PDF417.
+var ErrUnsupported = errors.New("no reader for this symbology")
+
+// Decode returns the payloads of the QR codes in img, each once, in the
+// order they were found. An image without a readable code gives none and no
+// error.
+func Decode(img image.Image) ([]string, error) {
+	bmp, err := gozxing.NewBinaryBitmap(gozxing.NewHybridBinarizer(gozxing.NewLuminanceSourceFromImage(img)))
+	if err != nil {
+		return nil, err
+	}
+	hints := map[gozxing.DecodeHintType]interface{}{gozxing.DecodeHintType_TRY_HARDER: true}
+
+	results, err := multiqr.NewQRCodeMultiReader().DecodeMultiple(bmp, hints)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	// The multi-code detector needs three clean finder patterns per code and
+	// misses some single codes the plain reader still gets.
+	if len(results) == 0 {
+		result, err := qrcode.NewQRCodeReader().Decode(bmp, hints)
+		switch {
+		case err == nil:
+			results = append(results, result)
+		case !isNotFound(err):
+			return nil, err
+		}
+	}
+
+	payloads := make([]string, 0, len(results))
+	seen := make(map[string]bool, len(results))
+	for _, r := range results {
+		if text := r.GetText(); !seen[text] {
+			seen[text] = true
+			payloads = append(payloads, text)
+		}
+	}
+	return payloads, nil
+}
+
+// DecodeSymbol reads one symbol of the given symbology (one of
+// model.Symbologies) from img. ok is false when there was nothing readable.
+func DecodeSymbol(img image.Image, symbology string) (text string, ok bool, err error) {
+	var reader gozxing.Reader
+	switch symbology {
+	case "", model.SymbologyQR:
+		payloads, err := Decode(img)
+		if err != nil || len(payloads) == 0 {
+			return "", false, err
+		}
+		return payloads[0], true, nil
+	case model.SymbologyDataMatrix:
+		reader = datamatrix.NewDataMatrixReader()
+	case model.SymbologyAztec:
+		reader = aztec.NewAztecReader()
+	case model.SymbologyCode128:
+		reader = oned.NewCode128Reader()
+	default:
+		return "", false, ErrUnsupported
+	}
+
+	bmp, err := gozxing.NewBinaryBitmap(gozxing.NewHybridBinarizer(gozxing.NewLuminanceSourceFromImage(img)))
+	if err != nil {
+		return "", false, err
+	}
+	result, err := reader.Decode(bmp, map[gozxing.DecodeHintType]interface{}{gozxing.DecodeHintType_TRY_HARDER: true})
+	switch {
+	case err == nil:
+		return result.GetText(), true, nil
+	case isNotFound(err):
+		return "", false, nil
+	}
+	return "", false, err
+}
+
+// isNotFound reports errors that only mean there was nothing readable:
+// no code, or one too damaged or blurred to decode.
+func isNotFound(err error) bool {
+	var notFound gozxing.NotFoundException
+	var checksum gozxing.ChecksumException
+	var format gozxing.FormatException
+	return errors.As(err, &notFound) || errors.As(err, &checksum) || errors.As(err, &format)
+}
@@ -0,0 +1,57 @@
+package qrdecode
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"slices"
+	"testing"
+
+	"qr-service/internal/render"
+)
+
+func codeImage(t *testing.T, content string) image.Image {
+	t.Helper()
+	m, err := render.Encode(content)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := render.WritePNG(&buf, m, 6); err != nil {
+		t.Fatalf("png: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode png: %v", err)
+	}
+	return img
+}
+
+func TestDecode_SingleAndMultipleCodes(t *testing.T) {
+	got, err := Decode(codeImage(t, "https://qr.example.com/r/abc"))
+	if err != nil || !slices.Equal(got, []string{"https://qr.example.com/r/abc"}) {
+		t.Fatalf("single: %v %v", got, err)
+	}
+
+	// Two codes side by side on a photo-sized grey background.
+	a, b := codeImage(t, "https://qr.example.com/r/one"), codeImage(t, "https://qr.example.com/r/two")
+	sheet := image.NewRGBA(image.Rect(0, 0, a.Bounds().Dx()+b.Bounds().Dx()+120, max(a.Bounds().Dy(), b.Bounds().Dy())+80))
+	draw.Draw(sheet, sheet.Bounds(), image.NewUniform(color.Gray{Y: 0xee}), image.Point{}, draw.Src)
+	draw.Draw(sheet, a.Bounds().Add(image.Pt(40, 40)), a, image.Point{}, draw.Src)
+	draw.Draw(sheet, b.Bounds().Add(image.Pt(80+a.Bounds().Dx(), 40)), b, image.Point{}, draw.Src)
+	got, err = Decode(sheet)
+	slices.Sort(got)
+	if err != nil || !slices.Equal(got, []string{"https://qr.example.com/r/one", "https://qr.example.com/r/two"}) {
+		t.Fatalf("multiple: %v %v", got, err)
+	}
+}
+
+func TestDecode_NoCode(t *testing.T) {
+	blank := image.NewGray(image.Rect(0, 0, 200, 200))
+	draw.Draw(blank, blank.Bounds(), image.White, image.Point{}, draw.Src)
+	if got, err := Decode(blank); err != nil || len(got) != 0 {
+		t.Fatalf("expected nothing, got %v %v", got, err)
+	}
+}
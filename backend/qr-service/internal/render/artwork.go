@@ -0,0 +1,388 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/vector"
+)
+
+const (
+	mmPerInch = 25.4
+	ptPerMm   = 72 / mmPerInch
+)
+
+// MaxRasterSide bounds the pixels on a side of a PNG so a large print size at
+// a high DPI can't exhaust memory.
+const MaxRasterSide = 10000
+
+var ErrArtworkInvalid = errors.New("artwork size, quiet zone or dpi invalid")
+
+// CMYK is a process color with each ink from 0 to 1.
+type CMYK struct{ C, M, Y, K float64 }
+
+// CMYKFromRGB is the naive conversion print shops apply when they're only
+// given screen colors.
+func CMYKFromRGB(c color.RGBA) CMYK {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	k := 1 - max(r, g, b)
+	if k >= 1 {
+		return CMYK{K: 1}
+	}
+	return CMYK{C: (1 - r - k) / (1 - k), M: (1 - g - k) / (1 - k), Y: (1 - b - k) / (1 - k), K: k}
+}
+
+// Artwork is a code drawn at an exact physical size, for print.
+type Artwork struct {
+	Matrix *Matrix
+	Style  Style
+	// Ink holds the foreground and background process colors for EPS and
+	// PDF; screen formats use Style's colors.
+	Ink struct{ Foreground, Background CMYK }
+	// QuietZone is the light border in modules; print vendors often add
+	// their own, so 0 is allowed.
+	QuietZone int
+	// SizeMm is the outer width, quiet zone and frame included. The height
+	// follows from the symbol's shape and the frame.
+	SizeMm float64
+	// Frame, when set, surrounds the symbol with a border and captions.
+	Frame *Frame
+}
+
+func (a Artwork) validate() error {
+	if a.Matrix == nil || a.QuietZone < 0 || !(a.SizeMm > 0) || math.IsInf(a.SizeMm, 0) {
+		return ErrArtworkInvalid
+	}
+	return nil
+}
+
+// modules is the outer size in modules, quiet zone included.
+func (a Artwork) modules() (width, height int) {
+	return a.Matrix.Width + 2*a.QuietZone, a.Matrix.Height + 2*a.QuietZone
+}
+
+// HeightMm is the outer height.
+func (a Artwork) HeightMm() float64 {
+	l := a.layout()
+	return a.SizeMm * l.height / l.width
+}
+
+// RasterSize is the PNG width and height in pixels at dpi.
+func (a Artwork) RasterSize(dpi float64) (width, height int) {
+	return int(math.Round(a.SizeMm / mmPerInch * dpi)), int(math.Round(a.HeightMm() / mmPerInch * dpi))
+}
+
+// WritePNG draws the artwork at dpi, exactly RasterSize pixels, and records
+// the resolution in the file so layout software prints it at SizeMm. Modules
+// land on whole pixels, so at low resolutions some are a pixel wider than
+// others. A rounded frame leaves its corners transparent.
+func (a Artwork) WritePNG(w io.Writer, dpi float64) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	width, height := a.RasterSize(dpi)
+	if max(width, height) > MaxRasterSide {
+		return ErrArtworkInvalid
+	}
+	l := a.layout()
+	scale := float64(width) / l.width
+	cols, rows := a.modules()
+	// The symbol's pixels, quiet zone included.
+	x0, y0 := int(math.Round(l.symbolX*scale)), int(math.Round(l.symbolY*scale))
+	x1, y1 := int(math.Round((l.symbolX+float64(cols))*scale)), int(math.Round((l.symbolY+float64(rows))*scale))
+	x1, y1 = min(x1, width), min(y1, height)
+	if x1-x0 < cols || y1-y0 < rows {
+		return ErrArtworkInvalid
+	}
+
+	fg, bg := color.RGBAModel.Convert(a.Style.Foreground).(color.RGBA), color.RGBAModel.Convert(a.Style.Background).(color.RGBA)
+	bounds := image.Rect(0, 0, width, height)
+	var img draw.Image
+	if a.Frame == nil {
+		// Two colors keep plain codes small.
+		img = image.NewPaletted(bounds, color.Palette{bg, fg})
+	} else {
+		// Text and curves are antialiased.
+		img = image.NewRGBA(bounds)
+		fill := func(p path, c color.RGBA) {
+			z := vector.NewRasterizer(width, height)
+			for _, s := range p {
+				pt := func(i int) (float32, float32) { return float32(s.pts[i].X * scale), float32(s.pts[i].Y * scale) }
+				switch s.op {
+				case 'M':
+					z.MoveTo(pt(0))
+				case 'L':
+					z.LineTo(pt(0))
+				case 'C':
+					c1x, c1y := pt(0)
+					c2x, c2y := pt(1)
+					x, y := pt(2)
+					z.CubeTo(c1x, c1y, c2x, c2y, x, y)
+				case 'Z':
+					z.ClosePath()
+				}
+			}
+			z.Draw(img, bounds, image.NewUniform(c), image.Point{})
+		}
+		fill(l.outline, bg)
+		for _, sh := range l.shapes {
+			c := a.Frame.Color
+			if sh.light {
+				c = bg
+			}
+			fill(sh.path, c)
+		}
+	}
+	logo := LogoArea(a.Matrix, a.Style.LogoScale)
+	for y := y0; y < y1; y++ {
+		my := (y-y0)*rows/(y1-y0) - a.QuietZone
+		for x := x0; x < x1; x++ {
+			mx := (x-x0)*cols/(x1-x0) - a.QuietZone
+			if a.Matrix.Dark(mx, my) && !image.Pt(mx, my).In(logo) {
+				img.Set(x, y, fg)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	_, err := w.Write(withPhys(buf.Bytes(), dpi))
+	return err
+}
+
+// withPhys inserts a pHYs chunk after the IHDR chunk, which image/png always
+// writes first.
+func withPhys(pngData []byte, dpi float64) []byte {
+	const ihdrEnd = 8 + 4 + 4 + 13 + 4
+	perMetre := uint32(math.Round(dpi / mmPerInch * 1000))
+	chunk := make([]byte, 4+4+9+4)
+	binary.BigEndian.PutUint32(chunk, 9)
+	copy(chunk[4:], "pHYs")
+	binary.BigEndian.PutUint32(chunk[8:], perMetre)
+	binary.BigEndian.PutUint32(chunk[12:], perMetre)
+	chunk[16] = 1 // unit: metre
+	binary.BigEndian.PutUint32(chunk[17:], crc32.ChecksumIEEE(chunk[4:17]))
+
+	out := make([]byte, 0, len(pngData)+len(chunk))
+	out = append(out, pngData[:ihdrEnd]...)
+	out = append(out, chunk...)
+	return append(out, pngData[ihdrEnd:]...)
+}
+
+// WriteSVG draws the artwork with its width and height in millimetres.
+func (a Artwork) WriteSVG(w io.Writer) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	l := a.layout()
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%smm" height="%smm" viewBox="0 0 %s %s" shape-rendering="crispEdges">`, formatNum(a.SizeMm), formatNum(a.HeightMm()), formatNum(l.width), formatNum(l.height))
+	if a.Frame == nil {
+		fmt.Fprintf(bw, `<rect width="%s" height="%s" fill="%s"/>`, formatNum(l.width), formatNum(l.height), hexOf(a.Style.Background))
+	} else {
+		// Curves and text look better antialiased than the modules do.
+		fmt.Fprintf(bw, `<g shape-rendering="geometricPrecision"><path fill="%s" d="%s"/>`, hexOf(a.Style.Background), svgPath(l.outline))
+		for _, sh := range l.shapes {
+			c := color.Color(a.Frame.Color)
+			if sh.light {
+				c = a.Style.Background
+			}
+			fmt.Fprintf(bw, `<path fill="%s" d="%s"/>`, hexOf(c), svgPath(sh.path))
+		}
+		fmt.Fprint(bw, `</g>`)
+	}
+	fmt.Fprintf(bw, `<path fill="%s" d="`, hexOf(a.Style.Foreground))
+	a.eachRun(func(x, y, run int) {
+		fmt.Fprintf(bw, "M%s %sh%dv1h-%dz", formatNum(l.symbolX+float64(x+a.QuietZone)), formatNum(l.symbolY+float64(y+a.QuietZone)), run, run)
+	})
+	fmt.Fprint(bw, `"/></svg>`)
+	return bw.Flush()
+}
+
+func svgPath(p path) string {
+	var b strings.Builder
+	for _, s := range p {
+		switch s.op {
+		case 'M', 'L':
+			fmt.Fprintf(&b, "%c%s %s", s.op, formatNum(s.pts[0].X), formatNum(s.pts[0].Y))
+		case 'C':
+			fmt.Fprintf(&b, "C%s %s %s %s %s %s", formatNum(s.pts[0].X), formatNum(s.pts[0].Y), formatNum(s.pts[1].X), formatNum(s.pts[1].Y), formatNum(s.pts[2].X), formatNum(s.pts[2].Y))
+		case 'Z':
+			b.WriteByte('Z')
+		}
+	}
+	return b.String()
+}
+
+// WriteEPS draws the artwork as Encapsulated PostScript in CMYK, sized in
+// points from SizeMm.
+func (a Artwork) WriteEPS(w io.Writer) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	l := a.layout()
+	widthPt, heightPt := a.SizeMm*ptPerMm, a.HeightMm()*ptPerMm
+	module := widthPt / l.width
+	fmt.Fprintf(bw, "%%!PS-Adobe-3.0 EPSF-3.0\n")
+	fmt.Fprintf(bw, "%%%%BoundingBox: 0 0 %d %d\n", int(math.Ceil(widthPt)), int(math.Ceil(heightPt)))
+	fmt.Fprintf(bw, "%%%%HiResBoundingBox: 0 0 %s %s\n", formatNum(widthPt), formatNum(heightPt))
+	fmt.Fprintf(bw, "%%%%Creator: qr-service\n%%%%LanguageLevel: 2\n%%%%Pages: 1\n%%%%EndComments\n")
+	fmt.Fprintf(bw, "%%%%Page: 1 1\ngsave\n")
+	// PostScript's origin is the bottom left; rows count down from the top.
+	psPoint := func(p point) string {
+		return formatNum(p.X*module) + " " + formatNum(heightPt-p.Y*module)
+	}
+	fill := func(p path) {
+		for _, s := range p {
+			switch s.op {
+			case 'M':
+				fmt.Fprintf(bw, "%s moveto\n", psPoint(s.pts[0]))
+			case 'L':
+				fmt.Fprintf(bw, "%s lineto\n", psPoint(s.pts[0]))
+			case 'C':
+				fmt.Fprintf(bw, "%s %s %s curveto\n", psPoint(s.pts[0]), psPoint(s.pts[1]), psPoint(s.pts[2]))
+			case 'Z':
+				fmt.Fprint(bw, "closepath\n")
+			}
+		}
+		fmt.Fprint(bw, "fill\n")
+	}
+	if a.Frame == nil {
+		fmt.Fprintf(bw, "%s setcmykcolor\n0 0 %s %s rectfill\n", inks(a.Ink.Background), formatNum(widthPt), formatNum(heightPt))
+	} else {
+		fmt.Fprintf(bw, "%s setcmykcolor\nnewpath\n", inks(a.Ink.Background))
+		fill(l.outline)
+		for _, sh := range l.shapes {
+			ink := a.Frame.Ink
+			if sh.light {
+				ink = a.Ink.Background
+			}
+			fmt.Fprintf(bw, "%s setcmykcolor\nnewpath\n", inks(ink))
+			fill(sh.path)
+		}
+	}
+	fmt.Fprintf(bw, "%s setcmykcolor\n", inks(a.Ink.Foreground))
+	a.eachRun(func(x, y, run int) {
+		fmt.Fprintf(bw, "%s %s %s %s rectfill\n",
+			formatNum((l.symbolX+float64(x+a.QuietZone))*module), formatNum(heightPt-(l.symbolY+float64(y+a.QuietZone+1))*module),
+			formatNum(float64(run)*module), formatNum(module))
+	})
+	fmt.Fprintf(bw, "grestore\nshowpage\n%%%%EOF\n")
+	return bw.Flush()
+}
+
+// WritePDF draws the artwork on a page exactly its size, in CMYK.
+func (a Artwork) WritePDF(w io.Writer) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: a.SizeMm, Ht: a.HeightMm()},
+	})
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetCreator("qr-service", true)
+	pdf.AddPage()
+	a.drawPDF(pdf, 0, 0)
+	return pdf.Output(w)
+}
+
+// drawPDF draws the artwork SizeMm wide with its top left at (x, y) on the
+// current page, in CMYK.
+func (a Artwork) drawPDF(pdf *fpdf.Fpdf, x, y float64) {
+	// fpdf only sets RGB fills, so the CMYK operator goes straight into the
+	// content stream; Rect and DrawPath leave the current fill alone.
+	l := a.layout()
+	module := a.SizeMm / l.width
+	fill := func(p path) {
+		for _, s := range p {
+			switch s.op {
+			case 'M':
+				pdf.MoveTo(x+s.pts[0].X*module, y+s.pts[0].Y*module)
+			case 'L':
+				pdf.LineTo(x+s.pts[0].X*module, y+s.pts[0].Y*module)
+			case 'C':
+				pdf.CurveBezierCubicTo(x+s.pts[0].X*module, y+s.pts[0].Y*module, x+s.pts[1].X*module, y+s.pts[1].Y*module, x+s.pts[2].X*module, y+s.pts[2].Y*module)
+			case 'Z':
+				pdf.ClosePath()
+			}
+		}
+		pdf.DrawPath("F")
+	}
+	pdf.RawWriteStr(inks(a.Ink.Background) + " k")
+	if a.Frame == nil {
+		pdf.Rect(x, y, a.SizeMm, a.HeightMm(), "F")
+	} else {
+		fill(l.outline)
+		for _, sh := range l.shapes {
+			ink := a.Frame.Ink
+			if sh.light {
+				ink = a.Ink.Background
+			}
+			pdf.RawWriteStr(inks(ink) + " k")
+			fill(sh.path)
+		}
+	}
+	pdf.RawWriteStr(inks(a.Ink.Foreground) + " k")
+	a.eachRun(func(mx, my, run int) {
+		pdf.Rect(x+(l.symbolX+float64(mx+a.QuietZone))*module, y+(l.symbolY+float64(my+a.QuietZone))*module, float64(run)*module, module, "F")
+	})
+}
+
+// eachRun calls fn for every horizontal run of dark modules outside the logo
+// area, in matrix coordinates.
+func (a Artwork) eachRun(fn func(x, y, run int)) {
+	m := a.Matrix
+	logo := LogoArea(m, a.Style.LogoScale)
+	dark := func(x, y int) bool { return m.Dark(x, y) && !image.Pt(x, y).In(logo) }
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; {
+			if !dark(x, y) {
+				x++
+				continue
+			}
+			run := 1
+			for dark(x+run, y) {
+				run++
+			}
+			fn(x, y, run)
+			x += run
+		}
+	}
+}
+
+func inks(c CMYK) string {
+	return fmt.Sprintf("%s %s %s %s", formatNum(c.C), formatNum(c.M), formatNum(c.Y), formatNum(c.K))
+}
+
+func hexOf(c color.Color) string {
+	v := color.RGBAModel.Convert(c).(color.RGBA)
+	return fmt.Sprintf("#%02x%02x%02x", v.R, v.G, v.B)
+}
+
+// formatNum writes v with at most four decimals and no trailing zeros.
+func formatNum(v float64) string {
+	s := fmt.Sprintf("%.4f", v)
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
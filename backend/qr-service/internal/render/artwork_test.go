@@ -0,0 +1,160 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+)
+
+func testArtwork(t *testing.T) Artwork {
+	t.Helper()
+	m, err := Encode("https://click.example.com/r/abc")
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	a := Artwork{Matrix: m, Style: PlainStyle, QuietZone: 2, SizeMm: 25.4}
+	a.Ink.Foreground = CMYK{C: 1, M: 0.5, K: 0.2}
+	return a
+}
+
+func TestArtwork_PNGIsExactlySizedForDPI(t *testing.T) {
+	a := testArtwork(t)
+	var buf bytes.Buffer
+	if err := a.WritePNG(&buf, 300); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("pHYs")) {
+		t.Fatal("expected a pHYs chunk")
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	// One inch at 300 dpi.
+	if b := img.Bounds(); b.Dx() != 300 || b.Dy() != 300 {
+		t.Fatalf("expected 300x300, got %v", b)
+	}
+	if r, _, _, _ := img.At(0, 0).RGBA(); r < 0x8000 {
+		t.Fatal("expected a light quiet zone")
+	}
+
+	if err := a.WritePNG(&bytes.Buffer{}, 5); err != ErrArtworkInvalid {
+		t.Fatalf("expected fewer pixels than modules to fail, got %v", err)
+	}
+}
+
+func TestArtwork_VectorFormats(t *testing.T) {
+	a := testArtwork(t)
+	a.Style.Foreground = color.RGBA{R: 0x1a, G: 0x23, B: 0x7e, A: 0xff}
+
+	var eps bytes.Buffer
+	if err := a.WriteEPS(&eps); err != nil {
+		t.Fatalf("eps: %v", err)
+	}
+	for _, want := range []string{"%!PS-Adobe-3.0 EPSF-3.0", "%%BoundingBox: 0 0 72 72", "%%HiResBoundingBox: 0 0 72 72", "1 0.5 0 0.2 setcmykcolor", "0 0 0 0 setcmykcolor", "%%EOF"} {
+		if !strings.Contains(eps.String(), want) {
+			t.Fatalf("eps missing %q:\n%.400s", want, eps.String())
+		}
+	}
+
+	var svg bytes.Buffer
+	if err := a.WriteSVG(&svg); err != nil {
+		t.Fatalf("svg: %v", err)
+	}
+	if s := svg.String(); !strings.Contains(s, `width="25.4mm"`) || !strings.Contains(s, `fill="#1a237e"`) || !strings.Contains(s, "M2 2h7v1h-7z") {
+		t.Fatalf("unexpected svg: %.300s", s)
+	}
+
+	var pdf bytes.Buffer
+	if err := a.WritePDF(&pdf); err != nil {
+		t.Fatalf("pdf: %v", err)
+	}
+	if !bytes.HasPrefix(pdf.Bytes(), []byte("%PDF-")) || !bytes.Contains(pdf.Bytes(), []byte("/MediaBox [0 0 72.00 72.00]")) {
+		t.Fatalf("unexpected pdf: %.300s", pdf.String())
+	}
+}
+
+func TestCMYKFromRGB(t *testing.T) {
+	if got := CMYKFromRGB(color.RGBA{A: 0xff}); got != (CMYK{K: 1}) {
+		t.Fatalf("black: %+v", got)
+	}
+	if got := CMYKFromRGB(color.RGBA{R: 0xff, A: 0xff}); got != (CMYK{M: 1, Y: 1}) {
+		t.Fatalf("red: %+v", got)
+	}
+}
+
+func TestArtwork_WideSymbolKeepsItsAspect(t *testing.T) {
+	m, err := EncodeSymbol("https://click.example.com/r/abc", model.SymbologyCode128, "M")
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	a := Artwork{Matrix: m, Style: PlainStyle, QuietZone: m.QuietZone, SizeMm: 50.8}
+	width, height := a.RasterSize(300)
+	if width != 600 || height >= width || height < 1 {
+		t.Fatalf("expected 600 px wide and shorter, got %dx%d", width, height)
+	}
+	var buf bytes.Buffer
+	if err := a.WritePNG(&buf, 300); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil || img.Bounds().Dx() != width || img.Bounds().Dy() != height {
+		t.Fatalf("expected %dx%d, got %v %v", width, height, img.Bounds(), err)
+	}
+}
+
+func TestArtwork_Frame(t *testing.T) {
+	a := testArtwork(t)
+	plainHeight := a.HeightMm()
+	a.Frame = &Frame{Border: model.FrameBorderRounded, Caption: "Scan me", Font: "bold", Color: color.RGBA{R: 0xc6, G: 0x28, B: 0x28, A: 0xff}, Ink: CMYK{M: 0.9, Y: 0.8}, URL: "click.example.com/r/abc"}
+	if a.HeightMm() <= plainHeight {
+		t.Fatalf("expected captions to make the artwork taller, got %v mm", a.HeightMm())
+	}
+
+	var buf bytes.Buffer
+	if err := a.WritePNG(&buf, 300); err != nil {
+		t.Fatalf("png: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	width, height := a.RasterSize(300)
+	if b := img.Bounds(); b.Dx() != width || b.Dy() != height || width != 300 {
+		t.Fatalf("expected %dx%d, got %v", width, height, b)
+	}
+	if _, _, _, alpha := img.At(0, 0).RGBA(); alpha != 0 {
+		t.Fatal("expected a transparent rounded corner")
+	}
+	if r, g, _, _ := img.At(width/2, 1).RGBA(); r>>8 != 0xc6 || g>>8 != 0x28 {
+		t.Fatalf("expected the border in the frame color, got %v", img.At(width/2, 1))
+	}
+
+	var svg bytes.Buffer
+	if err := a.WriteSVG(&svg); err != nil {
+		t.Fatalf("svg: %v", err)
+	}
+	if s := svg.String(); !strings.Contains(s, `fill="#c62828"`) || !strings.Contains(s, "C") {
+		t.Fatalf("expected the frame drawn as paths:\n%.400s", s)
+	}
+
+	var eps bytes.Buffer
+	if err := a.WriteEPS(&eps); err != nil {
+		t.Fatalf("eps: %v", err)
+	}
+	if s := eps.String(); !strings.Contains(s, "0 0.9 0.8 0 setcmykcolor") || !strings.Contains(s, "curveto") {
+		t.Fatalf("expected the frame in its ink:\n%.400s", s)
+	}
+
+	var pdf bytes.Buffer
+	if err := a.WritePDF(&pdf); err != nil {
+		t.Fatalf("pdf: %v", err)
+	}
+	if !bytes.Contains(pdf.Bytes(), []byte("/MediaBox [0 0 72.00 ")) {
+		t.Fatalf("expected a page one inch wide, got %.300s", pdf.String())
+	}
+}
@@ -0,0 +1,308 @@
+package render
+
+import (
+	"image/color"
+	"slices"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+
+	"qr-service/internal/model"
+)
+
+// Frame is a call-to-action border and captions drawn around an Artwork's
+// symbol. Text is drawn as glyph outlines, so no format needs the font.
+type Frame struct {
+	// Border is one of model.FrameBorders.
+	Border       string
+	Caption      string
+	CaptionAbove bool
+	// Font is one of model.FrameFonts.
+	Font string
+	// Color and Ink draw the border and text, except a banner's caption,
+	// which is in the artwork's background.
+	Color color.RGBA
+	Ink   CMYK
+	// URL is printed beneath the symbol; empty hides it.
+	URL string
+}
+
+// Frame proportions, relative to the symbol's base size: its width, or twice
+// its height for symbols much wider than they're tall.
+const (
+	lineBorder     = 0.03
+	bannerBorder   = 0.05
+	cornerRadius   = 0.1
+	captionEm      = 0.12
+	urlEm          = 0.05
+	textPadding    = 0.05
+	captionLeading = 1.5
+	urlLeading     = 1.8
+)
+
+var fontData = map[string][]byte{
+	"bold":    gobold.TTF,
+	"regular": goregular.TTF,
+	"italic":  goitalic.TTF,
+	"mono":    gomono.TTF,
+}
+
+var (
+	fontsOnce sync.Once
+	fonts     map[string]*sfnt.Font
+)
+
+// frameFont returns one of the embedded fonts, the default for an unknown
+// name. The Go fonts always parse.
+func frameFont(name string) *sfnt.Font {
+	fontsOnce.Do(func() {
+		fonts = make(map[string]*sfnt.Font, len(fontData))
+		for n, data := range fontData {
+			f, err := sfnt.Parse(data)
+			if err != nil {
+				panic(err)
+			}
+			fonts[n] = f
+		}
+	})
+	if f, ok := fonts[name]; ok {
+		return f
+	}
+	return fonts[model.FrameFonts[0]]
+}
+
+type point struct{ X, Y float64 }
+
+// segment is one step of an outline: 'M'ove, 'L'ine, 'C'ubic Bézier or 'Z'
+// to close. Quadratic glyph curves are raised to cubics so every format draws
+// the same operators.
+type segment struct {
+	op  byte
+	pts [3]point
+}
+
+// path is a filled outline in module units, y pointing down. Holes wind the
+// other way, so the nonzero rule leaves them empty.
+type path []segment
+
+// kappa places cubic control points to approximate a quarter circle.
+const kappa = 0.5522847498
+
+// rectPath is a rectangle wound clockwise, or counter-clockwise for a hole.
+// A positive radius rounds its corners.
+func rectPath(x, y, w, h, r float64, hole bool) path {
+	r = min(r, w/2, h/2)
+	k := r * kappa
+	p := path{
+		{op: 'M', pts: [3]point{{x + r, y}}},
+		{op: 'L', pts: [3]point{{x + w - r, y}}},
+		{op: 'C', pts: [3]point{{x + w - r + k, y}, {x + w, y + r - k}, {x + w, y + r}}},
+		{op: 'L', pts: [3]point{{x + w, y + h - r}}},
+		{op: 'C', pts: [3]point{{x + w, y + h - r + k}, {x + w - r + k, y + h}, {x + w - r, y + h}}},
+		{op: 'L', pts: [3]point{{x + r, y + h}}},
+		{op: 'C', pts: [3]point{{x + r - k, y + h}, {x, y + h - r + k}, {x, y + h - r}}},
+		{op: 'L', pts: [3]point{{x, y + r}}},
+		{op: 'C', pts: [3]point{{x, y + r - k}, {x + r - k, y}, {x + r, y}}},
+		{op: 'Z'},
+	}
+	if r == 0 {
+		// Square corners need no curves.
+		p = slices.DeleteFunc(p, func(s segment) bool { return s.op == 'C' })
+	}
+	if hole {
+		return p.reversed()
+	}
+	return p
+}
+
+// reversed winds a single closed contour the other way.
+func (p path) reversed() path {
+	// ends[i] is where segment i finishes.
+	ends := make([]point, len(p))
+	var cur point
+	for i, s := range p {
+		switch s.op {
+		case 'M', 'L':
+			cur = s.pts[0]
+		case 'C':
+			cur = s.pts[2]
+		}
+		ends[i] = cur
+	}
+	out := path{{op: 'M', pts: [3]point{ends[len(p)-1]}}}
+	for i := len(p) - 1; i > 0; i-- {
+		switch s := p[i]; s.op {
+		case 'L':
+			out = append(out, segment{op: 'L', pts: [3]point{ends[i-1]}})
+		case 'C':
+			out = append(out, segment{op: 'C', pts: [3]point{s.pts[1], s.pts[0], ends[i-1]}})
+		}
+	}
+	return append(out, segment{op: 'Z'})
+}
+
+// shape is part of a frame, drawn in the frame's color or, when light, the
+// artwork's background.
+type shape struct {
+	path  path
+	light bool
+}
+
+// layout places the symbol and its frame, in module units.
+type layout struct {
+	width, height float64
+	// symbolX and symbolY are the top left of the symbol's quiet zone.
+	symbolX, symbolY float64
+	// outline is the artwork's edge, filled with the background.
+	outline path
+	shapes  []shape
+}
+
+func (a Artwork) layout() layout {
+	cols, rows := a.modules()
+	w, h := float64(cols), float64(rows)
+	f := a.Frame
+	if f == nil {
+		return layout{width: w, height: h, outline: rectPath(0, 0, w, h, 0, false)}
+	}
+
+	base := min(w, 2*h)
+	var border, radius float64
+	switch f.Border {
+	case model.FrameBorderLine:
+		border = lineBorder * base
+	case model.FrameBorderRounded:
+		border, radius = lineBorder*base, cornerRadius*base
+	case model.FrameBorderBanner:
+		border = bannerBorder * base
+	}
+	var captionBand, urlBand float64
+	if f.Caption != "" {
+		captionBand = captionEm * base * captionLeading
+	}
+	if f.URL != "" {
+		urlBand = urlEm * base * urlLeading
+	}
+
+	l := layout{width: w + 2*border, height: h + 2*border + captionBand + urlBand, symbolX: border, symbolY: border}
+	captionY := border + h
+	if f.CaptionAbove {
+		captionY = border
+		l.symbolY += captionBand
+	}
+	l.outline = rectPath(0, 0, l.width, l.height, radius, false)
+	if border > 0 {
+		ring := rectPath(0, 0, l.width, l.height, radius, false)
+		ring = append(ring, rectPath(border, border, l.width-2*border, l.height-2*border, max(radius-border, 0), true)...)
+		l.shapes = append(l.shapes, shape{path: ring})
+	}
+
+	fnt := frameFont(f.Font)
+	maxText := w - 2*textPadding*base
+	if f.Caption != "" {
+		banner := f.Border == model.FrameBorderBanner
+		if banner {
+			// Overlap the border so no seam shows between them.
+			top := captionY
+			if f.CaptionAbove {
+				top = 0
+			}
+			l.shapes = append(l.shapes, shape{path: rectPath(0, top, l.width, captionY+captionBand-top, 0, false)})
+		}
+		l.shapes = append(l.shapes, shape{path: textPath(fnt, f.Caption, captionEm*base, maxText, l.width/2, captionY+captionBand/2), light: banner})
+	}
+	if f.URL != "" {
+		l.shapes = append(l.shapes, shape{path: textPath(fnt, f.URL, urlEm*base, maxText, l.width/2, l.height-border-urlBand/2)})
+	}
+	return l
+}
+
+// textPath lays s out on one line centered on (cx, cy), its capitals
+// vertically centered, shrinking em until the line fits within maxWidth.
+func textPath(f *sfnt.Font, s string, em, maxWidth, cx, cy float64) path {
+	var buf sfnt.Buffer
+	// Load glyphs at one pixel per font unit, then scale.
+	upem := float64(f.UnitsPerEm())
+	ppem := fixed.I(int(f.UnitsPerEm()))
+	unit := func(v fixed.Int26_6) float64 { return float64(v) / 64 }
+
+	var (
+		glyphs  []sfnt.GlyphIndex
+		offsets []float64
+		advance float64
+		prev    sfnt.GlyphIndex
+	)
+	for i, r := range []rune(s) {
+		g, err := f.GlyphIndex(&buf, r)
+		if err != nil {
+			continue
+		}
+		if i > 0 {
+			if k, err := f.Kern(&buf, prev, g, ppem, font.HintingNone); err == nil {
+				advance += unit(k)
+			}
+		}
+		glyphs, offsets = append(glyphs, g), append(offsets, advance)
+		if adv, err := f.GlyphAdvance(&buf, g, ppem, font.HintingNone); err == nil {
+			advance += unit(adv)
+		}
+		prev = g
+	}
+	if advance == 0 {
+		return nil
+	}
+	scale := em / upem
+	if advance*scale > maxWidth {
+		scale = maxWidth / advance
+	}
+	capHeight := 0.7 * upem
+	if m, err := f.Metrics(&buf, ppem, font.HintingNone); err == nil && m.CapHeight > 0 {
+		capHeight = unit(m.CapHeight)
+	}
+	x0, baseline := cx-advance*scale/2, cy+capHeight*scale/2
+
+	var p path
+	for i, g := range glyphs {
+		segs, err := f.LoadGlyph(&buf, g, ppem, nil)
+		if err != nil {
+			continue
+		}
+		at := func(v fixed.Point26_6) point {
+			return point{x0 + (offsets[i]+unit(v.X))*scale, baseline + unit(v.Y)*scale}
+		}
+		var cur point
+		open := false
+		for _, seg := range segs {
+			switch seg.Op {
+			case sfnt.SegmentOpMoveTo:
+				if open {
+					p = append(p, segment{op: 'Z'})
+				}
+				cur, open = at(seg.Args[0]), true
+				p = append(p, segment{op: 'M', pts: [3]point{cur}})
+			case sfnt.SegmentOpLineTo:
+				cur = at(seg.Args[0])
+				p = append(p, segment{op: 'L', pts: [3]point{cur}})
+			case sfnt.SegmentOpQuadTo:
+				q, end := at(seg.Args[0]), at(seg.Args[1])
+				c1 := point{cur.X + 2*(q.X-cur.X)/3, cur.Y + 2*(q.Y-cur.Y)/3}
+				c2 := point{end.X + 2*(q.X-end.X)/3, end.Y + 2*(q.Y-end.Y)/3}
+				cur = end
+				p = append(p, segment{op: 'C', pts: [3]point{c1, c2, cur}})
+			case sfnt.SegmentOpCubeTo:
+				cur = at(seg.Args[2])
+				p = append(p, segment{op: 'C', pts: [3]point{at(seg.Args[0]), at(seg.Args[1]), cur}})
+			}
+		}
+		if open {
+			p = append(p, segment{op: 'Z'})
+		}
+	}
+	return p
+}
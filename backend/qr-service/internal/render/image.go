@@ -0,0 +1,58 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// QuietZone is the light border, in modules, that scanners need around a QR
+// code.
+const QuietZone = 4
+
+// WritePNG draws m as a black-on-white PNG with scale pixels per module and
+// the symbology's quiet zone.
+func WritePNG(w io.Writer, m *Matrix, scale int) error {
+	if scale < 1 {
+		scale = 1
+	}
+	width, height := (m.Width+2*m.QuietZone)*scale, (m.Height+2*m.QuietZone)*scale
+	img := image.NewPaletted(image.Rect(0, 0, width, height), color.Palette{color.White, color.Black})
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if m.Dark(x/scale-m.QuietZone, y/scale-m.QuietZone) {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+// WriteSVG draws m as a scalable SVG, one unit per module, with the
+// symbology's quiet zone. Horizontal runs of dark modules share a single path
+// segment.
+func WriteSVG(w io.Writer, m *Matrix) error {
+	bw := bufio.NewWriter(w)
+	width, height := m.Width+2*m.QuietZone, m.Height+2*m.QuietZone
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, width, height)
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, width, height)
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; {
+			if !m.Dark(x, y) {
+				x++
+				continue
+			}
+			run := 1
+			for m.Dark(x+run, y) {
+				run++
+			}
+			fmt.Fprintf(bw, "M%d %dh%dv1h-%dz", x+m.QuietZone, y+m.QuietZone, run, run)
+			x += run
+		}
+	}
+	fmt.Fprint(bw, `"/></svg>`)
+	return bw.Flush()
+}
@@ -0,0 +1,51 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestWritePNG_DrawsModulesWithQuietZone(t *testing.T) {
+	m, err := Encode("https://click.example.com/r/abc")
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, m, 3); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	side := (m.Width + 2*QuietZone) * 3
+	if b := img.Bounds(); b.Dx() != side || b.Dy() != side {
+		t.Fatalf("expected %dx%d, got %v", side, side, b)
+	}
+	// The quiet zone is light; the finder pattern's corner is dark.
+	if r, _, _, _ := img.At(0, 0).RGBA(); r < 0x8000 {
+		t.Fatal("expected a light quiet zone")
+	}
+	if r, _, _, _ := img.At(QuietZone*3, QuietZone*3).RGBA(); r >= 0x8000 {
+		t.Fatal("expected the finder pattern to be dark")
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	m, err := Encode("https://click.example.com/r/abc")
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, m); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	side := m.Width + 2*QuietZone
+	svg := buf.String()
+	if !strings.Contains(svg, fmt.Sprintf(`viewBox="0 0 %d %d"`, side, side)) || !strings.Contains(svg, fmt.Sprintf("M%d %dh7v1h-7z", QuietZone, QuietZone)) {
+		t.Fatalf("unexpected svg: %.200s", svg)
+	}
+}
@@ -0,0 +1,137 @@
+// Package render encodes QR payloads and draws them server-side.
+package render
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/aztec"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/datamatrix"
+	"github.com/boombuler/barcode/pdf417"
+	"github.com/boombuler/barcode/qr"
+
+	"qr-service/internal/model"
+)
+
+var (
+	ErrPayloadTooLarge  = errors.New("payload too large for the symbology")
+	ErrSymbologyUnknown = errors.New("unknown symbology")
+)
+
+const (
+	// maxCode128Chars keeps Code 128 symbols short enough to print and scan.
+	maxCode128Chars = 80
+	// code128HeightFraction is the bar height relative to the symbol's length.
+	code128HeightFraction = 0.15
+)
+
+// Matrix is a grid of modules without a quiet zone. QR, Data Matrix and Aztec
+// symbols are square; PDF417 and Code 128 are wider than they're tall.
+type Matrix struct {
+	Width, Height int
+	// QuietZone is the light border, in modules, the symbology calls for.
+	QuietZone int
+	modules   []bool
+}
+
+// Dark reports whether the module at (x, y) is dark. Out-of-range coordinates
+// are light, which lets callers treat the quiet zone uniformly.
+func (m *Matrix) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= m.Width || y >= m.Height {
+		return false
+	}
+	return m.modules[y*m.Width+x]
+}
+
+// Encode builds the module matrix for content at error correction level M,
+// matching what the frontend renders.
+func Encode(content string) (*Matrix, error) {
+	return EncodeLevel(content, "M")
+}
+
+// EncodeLevel is Encode at error correction level L, M, Q or H; anything else
+// means M.
+func EncodeLevel(content, level string) (*Matrix, error) {
+	ecl := qr.M
+	switch level {
+	case "L":
+		ecl = qr.L
+	case "Q":
+		ecl = qr.Q
+	case "H":
+		ecl = qr.H
+	}
+	code, err := qr.Encode(content, ecl, qr.Auto)
+	if err != nil {
+		return nil, ErrPayloadTooLarge
+	}
+	return fromBarcode(code, QuietZone, 1, 1), nil
+}
+
+// EncodeSymbol builds the matrix for content in one of model.Symbologies.
+// level only applies to QR codes. Code 128 is limited to maxCode128Chars,
+// past which it's too long to print or scan comfortably.
+func EncodeSymbol(content, symbology, level string) (*Matrix, error) {
+	var (
+		code barcode.Barcode
+		err  error
+	)
+	switch symbology {
+	case "", model.SymbologyQR:
+		return EncodeLevel(content, level)
+	case model.SymbologyDataMatrix:
+		if code, err = datamatrix.Encode(content); err != nil {
+			return nil, ErrPayloadTooLarge
+		}
+		return fromBarcode(code, 1, 1, 1), nil
+	case model.SymbologyAztec:
+		// 23% error correction is the recommended minimum.
+		if code, err = aztec.Encode([]byte(content), 23, 0); err != nil {
+			return nil, ErrPayloadTooLarge
+		}
+		return fromBarcode(code, 2, 1, 1), nil
+	case model.SymbologyPDF417:
+		if code, err = pdf417.Encode(content, 2); err != nil {
+			return nil, ErrPayloadTooLarge
+		}
+		// The encoder draws rows two modules high; the standard asks for at
+		// least three.
+		return fromBarcode(code, 2, 2, 3), nil
+	case model.SymbologyCode128:
+		if len(content) > maxCode128Chars {
+			return nil, ErrPayloadTooLarge
+		}
+		if code, err = code128.Encode(content); err != nil {
+			return nil, ErrPayloadTooLarge
+		}
+		// A single row of bars, drawn as tall as the standard's 15% of the
+		// symbol's length.
+		height := int(math.Ceil(code128HeightFraction * float64(code.Bounds().Dx()+20)))
+		return fromBarcode(code, 10, code.Bounds().Dy(), height), nil
+	}
+	return nil, ErrSymbologyUnknown
+}
+
+// fromBarcode reads code's modules, sampling every rowStep-th pixel row and
+// drawing each one rowRepeat modules high.
+func fromBarcode(code image.Image, quietZone, rowStep, rowRepeat int) *Matrix {
+	b := code.Bounds()
+	rows := b.Dy() / rowStep
+	m := &Matrix{Width: b.Dx(), Height: rows * rowRepeat, QuietZone: quietZone}
+	m.modules = make([]bool, m.Width*m.Height)
+	for row := 0; row < rows; row++ {
+		for x := 0; x < m.Width; x++ {
+			r, _, _, _ := code.At(b.Min.X+x, b.Min.Y+row*rowStep).RGBA()
+			if r >= 0x8000 {
+				continue
+			}
+			for i := 0; i < rowRepeat; i++ {
+				m.modules[(row*rowRepeat+i)*m.Width+x] = true
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,50 @@
+package render
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+)
+
+func TestEncodeSymbol_Shapes(t *testing.T) {
+	const url = "https://click.example.com/r/00000000-0000-0000-0000-000000000000"
+	cases := []struct {
+		symbology string
+		square    bool
+		quietZone int
+	}{
+		{model.SymbologyQR, true, QuietZone},
+		{model.SymbologyDataMatrix, true, 1},
+		{model.SymbologyAztec, true, 2},
+		{model.SymbologyPDF417, false, 2},
+		{model.SymbologyCode128, false, 10},
+	}
+	for _, tc := range cases {
+		t.Run(tc.symbology, func(t *testing.T) {
+			m, err := EncodeSymbol(url, tc.symbology, "M")
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if (m.Width == m.Height) != tc.square || m.QuietZone != tc.quietZone {
+				t.Fatalf("unexpected %dx%d symbol with quiet zone %d", m.Width, m.Height, m.QuietZone)
+			}
+			if !tc.square && m.Width <= m.Height {
+				t.Fatalf("expected a wide symbol, got %dx%d", m.Width, m.Height)
+			}
+		})
+	}
+}
+
+func TestEncodeSymbol_Limits(t *testing.T) {
+	if _, err := EncodeSymbol(strings.Repeat("a", maxCode128Chars+1), model.SymbologyCode128, "M"); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("expected a long Code 128 payload to be refused, got %v", err)
+	}
+	if _, err := EncodeSymbol(strings.Repeat("a", 4000), model.SymbologyDataMatrix, "M"); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("expected an oversized Data Matrix payload to be refused, got %v", err)
+	}
+	if _, err := EncodeSymbol("x", "maxicode", "M"); !errors.Is(err, ErrSymbologyUnknown) {
+		t.Fatalf("expected an unknown symbology, got %v", err)
+	}
+}
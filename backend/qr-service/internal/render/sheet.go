@@ -0,0 +1,240 @@
+package render
+
+import (
+	"errors"
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/go-pdf/fpdf"
+)
+
+var ErrLayoutInvalid = errors.New("sheet layout invalid")
+
+// quietZoneModules is the light border drawn around each symbol. Two modules
+// matches the frontend's margin and keeps codes compact on small labels.
+const quietZoneModules = 2
+
+// SheetLayout describes label stock in millimetres. Labels are placed in
+// row-major order starting at (MarginLeftMm, MarginTopMm); the pitch is the
+// distance between the origins of neighbouring labels.
+type SheetLayout struct {
+	PageWidthMm  float64 `json:"pageWidthMm"`
+	PageHeightMm float64 `json:"pageHeightMm"`
+	Columns      int     `json:"columns"`
+	Rows         int     `json:"rows"`
+
+	LabelWidthMm  float64 `json:"labelWidthMm"`
+	LabelHeightMm float64 `json:"labelHeightMm"`
+	MarginTopMm   float64 `json:"marginTopMm"`
+	MarginLeftMm  float64 `json:"marginLeftMm"`
+	PitchXMm      float64 `json:"pitchXMm"`
+	PitchYMm      float64 `json:"pitchYMm"`
+
+	// BleedMm extends each label's background, its code's background ink,
+	// past its die-cut edge, so a trim that's slightly off leaves no paper
+	// showing.
+	BleedMm float64 `json:"bleedMm"`
+	// CodeSizeMm is the outer size of each symbol including its quiet zone;
+	// zero fits the largest code the label allows.
+	CodeSizeMm float64 `json:"codeSizeMm"`
+	// FontSizePt sizes the caption under each code; zero hides captions.
+	FontSizePt float64 `json:"fontSizePt"`
+}
+
+// Page sizes in millimetres.
+const (
+	letterWidthMm  = 215.9
+	letterHeightMm = 279.4
+	a4WidthMm      = 210
+	a4HeightMm     = 297
+)
+
+// SheetTemplates are common Avery label stocks.
+var SheetTemplates = map[string]SheetLayout{
+	// 1" x 2-5/8" address labels, 30 per US Letter sheet.
+	"avery-5160": {
+		PageWidthMm: letterWidthMm, PageHeightMm: letterHeightMm, Columns: 3, Rows: 10,
+		LabelWidthMm: 66.675, LabelHeightMm: 25.4, MarginTopMm: 12.7, MarginLeftMm: 4.7625,
+		PitchXMm: 69.85, PitchYMm: 25.4, FontSizePt: 7,
+	},
+	// 2" x 4" shipping labels, 10 per US Letter sheet.
+	"avery-5163": {
+		PageWidthMm: letterWidthMm, PageHeightMm: letterHeightMm, Columns: 2, Rows: 5,
+		LabelWidthMm: 101.6, LabelHeightMm: 50.8, MarginTopMm: 12.7, MarginLeftMm: 3.96875,
+		PitchXMm: 104.775, PitchYMm: 50.8, FontSizePt: 9,
+	},
+	// 1-1/2" square labels, 24 per US Letter sheet.
+	"avery-22805": {
+		PageWidthMm: letterWidthMm, PageHeightMm: letterHeightMm, Columns: 4, Rows: 6,
+		LabelWidthMm: 38.1, LabelHeightMm: 38.1, MarginTopMm: 15.875, MarginLeftMm: 15.875,
+		PitchXMm: 50.8, PitchYMm: 44.45, FontSizePt: 6,
+	},
+	// 63.5 x 38.1 mm address labels, 21 per A4 sheet.
+	"avery-l7160": {
+		PageWidthMm: a4WidthMm, PageHeightMm: a4HeightMm, Columns: 3, Rows: 7,
+		LabelWidthMm: 63.5, LabelHeightMm: 38.1, MarginTopMm: 15.15, MarginLeftMm: 7.25,
+		PitchXMm: 66.04, PitchYMm: 38.1, FontSizePt: 7,
+	},
+}
+
+// PageSize returns the dimensions of a named page size.
+func PageSize(name string) (widthMm, heightMm float64, ok bool) {
+	switch name {
+	case "letter":
+		return letterWidthMm, letterHeightMm, true
+	case "a4":
+		return a4WidthMm, a4HeightMm, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// SheetLabel is one label on the sheet.
+type SheetLabel struct {
+	// Artwork is the code in its style and frame. Its SizeMm is ignored:
+	// the sheet fits it to the layout's code size, and its quiet zone is
+	// trimmed to quietZoneModules.
+	Artwork Artwork
+	Caption string
+}
+
+// labelPaddingMm keeps codes and captions off the die-cut edge.
+const labelPaddingMm = 1.5
+
+func (l SheetLayout) captionHeightMm() float64 {
+	if l.FontSizePt <= 0 {
+		return 0
+	}
+	// 1pt = 0.3528mm; leave a little leading above the baseline.
+	return l.FontSizePt*0.3528*1.3 + 0.5
+}
+
+func (l SheetLayout) maxCodeSizeMm() float64 {
+	return math.Min(l.LabelWidthMm, l.LabelHeightMm-l.captionHeightMm()) - 2*labelPaddingMm
+}
+
+// Validate checks that the grid fits on the page and a code fits on each label.
+func (l SheetLayout) Validate() error {
+	if l.PageWidthMm <= 0 || l.PageHeightMm <= 0 || l.Columns <= 0 || l.Rows <= 0 {
+		return ErrLayoutInvalid
+	}
+	if l.LabelWidthMm <= 0 || l.LabelHeightMm <= 0 || l.MarginTopMm < 0 || l.MarginLeftMm < 0 || l.BleedMm < 0 {
+		return ErrLayoutInvalid
+	}
+	if l.PitchXMm < l.LabelWidthMm || l.PitchYMm < l.LabelHeightMm {
+		return ErrLayoutInvalid
+	}
+	const tolerance = 0.01
+	if l.MarginLeftMm+float64(l.Columns-1)*l.PitchXMm+l.LabelWidthMm > l.PageWidthMm+tolerance {
+		return ErrLayoutInvalid
+	}
+	if l.MarginTopMm+float64(l.Rows-1)*l.PitchYMm+l.LabelHeightMm > l.PageHeightMm+tolerance {
+		return ErrLayoutInvalid
+	}
+	if l.CodeSizeMm < 0 || l.FontSizePt < 0 {
+		return ErrLayoutInvalid
+	}
+	maxCode := l.maxCodeSizeMm()
+	if maxCode <= 0 || l.CodeSizeMm > maxCode+tolerance {
+		return ErrLayoutInvalid
+	}
+	return nil
+}
+
+// WriteSheetPDF lays labels out on as many pages as needed and draws each
+// code's artwork as vectors, in its CMYK inks, so it stays sharp at any print
+// resolution.
+func WriteSheetPDF(w io.Writer, layout SheetLayout, labels []SheetLabel) error {
+	if err := layout.Validate(); err != nil {
+		return err
+	}
+
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: layout.PageWidthMm, Ht: layout.PageHeightMm},
+	})
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetCreator("qr-service", true)
+	if layout.FontSizePt > 0 {
+		pdf.SetFont("Helvetica", "", layout.FontSizePt)
+	}
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	codeSize := layout.CodeSizeMm
+	if codeSize == 0 {
+		codeSize = layout.maxCodeSizeMm()
+	}
+	perPage := layout.Columns * layout.Rows
+
+	slotOrigin := func(slot int) (x, y float64) {
+		return layout.MarginLeftMm + float64(slot%layout.Columns)*layout.PitchXMm, layout.MarginTopMm + float64(slot/layout.Columns)*layout.PitchYMm
+	}
+	for _, label := range labels {
+		if label.Artwork.Matrix == nil {
+			return ErrArtworkInvalid
+		}
+	}
+
+	for first := 0; first < len(labels); first += perPage {
+		page := labels[first:min(first+perPage, len(labels))]
+		pdf.AddPage()
+
+		// Backgrounds go down first, so one label's bleed never covers a
+		// neighbour's code.
+		for slot, label := range page {
+			x, y := slotOrigin(slot)
+			pdf.RawWriteStr(inks(label.Artwork.Ink.Background) + " k")
+			pdf.Rect(x-layout.BleedMm, y-layout.BleedMm, layout.LabelWidthMm+2*layout.BleedMm, layout.LabelHeightMm+2*layout.BleedMm, "F")
+		}
+
+		for slot, label := range page {
+			x, y := slotOrigin(slot)
+			a := label.Artwork
+			a.QuietZone = min(a.QuietZone, quietZoneModules)
+			// Fit the artwork, frame included, in a codeSize square.
+			a.SizeMm = codeSize
+			if h := a.HeightMm(); h > codeSize {
+				a.SizeMm = codeSize * codeSize / h
+			}
+			// Center the code in the area above the caption.
+			codeX := x + (layout.LabelWidthMm-codeSize)/2
+			codeY := y + (layout.LabelHeightMm-layout.captionHeightMm()-codeSize)/2
+			a.drawPDF(pdf, codeX+(codeSize-a.SizeMm)/2, codeY+(codeSize-a.HeightMm())/2)
+
+			if layout.FontSizePt > 0 && label.Caption != "" {
+				caption := fitText(pdf, translate(label.Caption), layout.LabelWidthMm-2*labelPaddingMm)
+				// drawPDF left a raw CMYK fill that fpdf doesn't know
+				// about; reset it, then write the caption in the code's
+				// foreground so it shows on the label's background.
+				fg := color.RGBA{A: 255}
+				if a.Style.Foreground != nil {
+					fg = color.RGBAModel.Convert(a.Style.Foreground).(color.RGBA)
+				}
+				pdf.SetFillColor(0, 0, 0)
+				pdf.SetTextColor(int(fg.R), int(fg.G), int(fg.B))
+				pdf.SetXY(x, codeY+codeSize)
+				pdf.CellFormat(layout.LabelWidthMm, layout.captionHeightMm(), caption, "", 0, "CT", false, 0, "")
+			}
+		}
+	}
+	if len(labels) == 0 {
+		pdf.AddPage()
+	}
+
+	return pdf.Output(w)
+}
+
+// fitText truncates s with an ellipsis so it fits within width.
+func fitText(pdf *fpdf.Fpdf, s string, width float64) string {
+	if pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	const ellipsis = "..."
+	runes := []rune(s)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+ellipsis) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + ellipsis
+}
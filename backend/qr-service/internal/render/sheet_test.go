@@ -0,0 +1,87 @@
+package render
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"qr-service/internal/model"
+)
+
+func TestSheetTemplates_Validate(t *testing.T) {
+	for name, layout := range SheetTemplates {
+		if err := layout.Validate(); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestWriteSheetPDF_PaginatesLabels(t *testing.T) {
+	layout := SheetTemplates["avery-5163"]
+	framed := testArtwork(t)
+	framed.Frame = &Frame{Border: model.FrameBorderBanner, Caption: "SCAN ME", Font: "bold", Ink: CMYK{M: 0.9, Y: 0.8}}
+	labels := make([]SheetLabel, 11)
+	for i := range labels {
+		labels[i] = SheetLabel{Artwork: testArtwork(t), Caption: "Ünïcode label"}
+	}
+	labels[3].Artwork = framed
+
+	var buf bytes.Buffer
+	if err := WriteSheetPDF(&buf, layout, labels); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	// 10 labels per sheet, so 11 labels need a second page.
+	if n := bytes.Count(buf.Bytes(), []byte("/Type /Page\n")); n != 2 {
+		t.Fatalf("expected 2 pages, got %d", n)
+	}
+
+	if err := WriteSheetPDF(&buf, layout, []SheetLabel{{Caption: "no code"}}); !errors.Is(err, ErrArtworkInvalid) {
+		t.Fatalf("expected ErrArtworkInvalid, got %v", err)
+	}
+}
+
+// pageContent inflates every stream in pdf, which is where fpdf puts page
+// content.
+func pageContent(t *testing.T, pdf []byte) string {
+	t.Helper()
+	var out strings.Builder
+	for _, m := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(pdf, -1) {
+		zr, err := zlib.NewReader(bytes.NewReader(m[1]))
+		if err != nil {
+			continue
+		}
+		b, _ := io.ReadAll(zr)
+		out.Write(b)
+	}
+	return out.String()
+}
+
+func TestWriteSheetPDF_BleedsTheLabelBackground(t *testing.T) {
+	layout := SheetTemplates["avery-5163"]
+	layout.BleedMm = 2
+	a := testArtwork(t)
+	a.Ink.Background = CMYK{C: 0.1, Y: 0.6}
+
+	var buf bytes.Buffer
+	if err := WriteSheetPDF(&buf, layout, []SheetLabel{{Artwork: a, Caption: "Menu"}}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	content := pageContent(t, buf.Bytes())
+	// The label and its bleed are filled in the code's background ink, not
+	// white, before anything else is drawn.
+	bg := strings.Index(content, "0.1 0 0.6 0 k")
+	code := strings.Index(content, inks(a.Ink.Foreground)+" k")
+	if bg < 0 || code < bg {
+		t.Fatalf("expected the background ink before the code:\n%.600s", content)
+	}
+	k := 72 / 25.4
+	wantRect := fmt.Sprintf("%.2f %.2f %.2f %.2f re", (layout.MarginLeftMm-2)*k, (layout.PageHeightMm-layout.MarginTopMm+2)*k, (layout.LabelWidthMm+4)*k, -(layout.LabelHeightMm+4)*k)
+	if !strings.Contains(content[bg:], wantRect) {
+		t.Fatalf("expected the label filled %s, got:\n%.600s", wantRect, content[bg:])
+	}
+}
@@ -0,0 +1,66 @@
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"strconv"
+)
+
+// Style colors a code and leaves room for a logo.
+type Style struct {
+	Foreground color.Color
+	Background color.Color
+	// LogoScale is the width of a centered logo as a fraction of the code's;
+	// the modules beneath it are left in the background color.
+	LogoScale float64
+}
+
+// PlainStyle is black on white without a logo.
+var PlainStyle = Style{Foreground: color.Black, Background: color.White}
+
+// Image draws m in style with scale pixels per module and the symbology's
+// quiet zone.
+func Image(m *Matrix, style Style, scale int) *image.RGBA {
+	if scale < 1 {
+		scale = 1
+	}
+	width, height := (m.Width+2*m.QuietZone)*scale, (m.Height+2*m.QuietZone)*scale
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	fg, bg := color.RGBAModel.Convert(style.Foreground).(color.RGBA), color.RGBAModel.Convert(style.Background).(color.RGBA)
+	logo := LogoArea(m, style.LogoScale)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mx, my := x/scale-m.QuietZone, y/scale-m.QuietZone
+			if m.Dark(mx, my) && !image.Pt(mx, my).In(logo) {
+				img.SetRGBA(x, y, fg)
+			} else {
+				img.SetRGBA(x, y, bg)
+			}
+		}
+	}
+	return img
+}
+
+// LogoArea is the square of modules a centered logo of the given scale
+// covers, empty for none. The scale is relative to the symbol's shorter side.
+func LogoArea(m *Matrix, scale float64) image.Rectangle {
+	n := int(scale*float64(min(m.Width, m.Height)) + 0.5)
+	if n <= 0 {
+		return image.Rectangle{}
+	}
+	x, y := (m.Width-n)/2, (m.Height-n)/2
+	return image.Rect(x, y, x+n, y+n)
+}
+
+// ParseHexColor reads a #rrggbb color.
+func ParseHexColor(s string) (color.RGBA, error) {
+	if len(s) != 7 || s[0] != '#' {
+		return color.RGBA{}, errors.New("color must look like #rrggbb")
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return color.RGBA{}, errors.New("color must look like #rrggbb")
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, nil
+}
@@ -0,0 +1,190 @@
+// Package rendercache keeps rendered images on disk, keyed by a digest of
+// everything that went into them, and evicts the least recently used ones
+// once they outgrow a size limit. Entries never go stale: a changed code has
+// a different digest.
+package rendercache
+
+import (
+	"container/list"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tempPrefix marks files still being written; Open removes any a crash left.
+const tempPrefix = ".tmp-"
+
+var ErrKeyInvalid = errors.New("cache key must be lowercase hex")
+
+type Cache struct {
+	dir      string
+	maxBytes int64
+
+	mu   sync.Mutex
+	size int64
+	// order holds *entry values, most recently used first.
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type entry struct {
+	key  string
+	size int64
+}
+
+// Open indexes the files already in dir, creating it if needed, so a restart
+// keeps the cache warm. Files are ranked by modification time, which Get
+// refreshes.
+func Open(dir string, maxBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type found struct {
+		entry
+		modTime time.Time
+	}
+	var files []found
+	for _, de := range des {
+		name := de.Name()
+		if strings.HasPrefix(name, tempPrefix) {
+			_ = os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		if !de.Type().IsRegular() || !validKey(name) {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, found{entry{name, info.Size()}, info.ModTime()})
+	}
+	slices.SortFunc(files, func(a, b found) int { return b.modTime.Compare(a.modTime) })
+
+	c := &Cache{dir: dir, maxBytes: maxBytes, order: list.New(), entries: map[string]*list.Element{}}
+	for _, f := range files {
+		c.entries[f.key] = c.order.PushBack(&entry{f.key, f.size})
+		c.size += f.size
+	}
+	c.mu.Lock()
+	c.evictLocked()
+	c.mu.Unlock()
+	return c, nil
+}
+
+// Get returns the bytes stored under key.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	el, ok := c.entries[key]
+	if ok {
+		c.order.MoveToFront(el)
+	}
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	path := filepath.Join(c.dir, key)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		// Evicted meanwhile, or removed behind our back.
+		c.mu.Lock()
+		if cur, ok := c.entries[key]; ok && cur == el {
+			c.removeLocked(el)
+		}
+		c.mu.Unlock()
+		return nil, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return data, true
+}
+
+// Put stores data under key, evicting older entries to stay within the size
+// limit. Data larger than the whole limit isn't kept.
+func (c *Cache) Put(key string, data []byte) error {
+	if !validKey(key) {
+		return ErrKeyInvalid
+	}
+	size := int64(len(data))
+	if size > c.maxBytes {
+		return nil
+	}
+	// Write beside the final name and rename, so readers never see half a
+	// file.
+	f, err := os.CreateTemp(c.dir, tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := os.Rename(f.Name(), filepath.Join(c.dir, key)); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if el, ok := c.entries[key]; ok {
+		c.size -= el.Value.(*entry).size
+		el.Value.(*entry).size = size
+		c.order.MoveToFront(el)
+	} else {
+		c.entries[key] = c.order.PushFront(&entry{key, size})
+	}
+	c.size += size
+	c.evictLocked()
+	return nil
+}
+
+// Size is the total bytes cached.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *Cache) evictLocked() {
+	for c.size > c.maxBytes {
+		el := c.order.Back()
+		if el == nil {
+			return
+		}
+		_ = os.Remove(filepath.Join(c.dir, el.Value.(*entry).key))
+		c.removeLocked(el)
+	}
+}
+
+func (c *Cache) removeLocked(el *list.Element) {
+	e := el.Value.(*entry)
+	c.order.Remove(el)
+	delete(c.entries, e.key)
+	c.size -= e.size
+}
+
+// validKey keeps keys to plain file names.
+func validKey(key string) bool {
+	if key == "" || len(key) > 128 {
+		return false
+	}
+	for _, r := range key {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package rendercache
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+	c, err := Open(dir, 10)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	for _, key := range []string{"aa", "bb"} {
+		if err := c.Put(key, []byte("1234")); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+	// Reading aa makes bb the oldest, so it goes when cc pushes past 10 bytes.
+	if data, ok := c.Get("aa"); !ok || string(data) != "1234" {
+		t.Fatalf("expected aa, got %q %v", data, ok)
+	}
+	if err := c.Put("cc", []byte("1234")); err != nil {
+		t.Fatalf("put cc: %v", err)
+	}
+	if _, ok := c.Get("bb"); ok {
+		t.Fatal("expected bb evicted")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "bb")); !os.IsNotExist(err) {
+		t.Fatalf("expected bb's file removed, got %v", err)
+	}
+	if _, ok := c.Get("aa"); !ok || c.Size() != 8 {
+		t.Fatalf("expected aa kept and 8 bytes cached, got %v %d", ok, c.Size())
+	}
+
+	// Too large to ever fit; not kept, not an error.
+	if err := c.Put("dd", bytes.Repeat([]byte("x"), 11)); err != nil {
+		t.Fatalf("put dd: %v", err)
+	}
+	if _, ok := c.Get("dd"); ok {
+		t.Fatal("expected an oversized entry to be skipped")
+	}
+	if err := c.Put("../escape", []byte("x")); err != ErrKeyInvalid {
+		t.Fatalf("expected ErrKeyInvalid, got %v", err)
+	}
+}
+
+func TestCache_OpenIndexesExistingFiles(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-time.Hour)
+	for i, key := range []string{"0a", "0b"} {
+		path := filepath.Join(dir, key)
+		if err := os.WriteFile(path, []byte("123456"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		at := old.Add(time.Duration(i) * time.Minute)
+		_ = os.Chtimes(path, at, at)
+	}
+	_ = os.WriteFile(filepath.Join(dir, tempPrefix+"1"), []byte("partial"), 0o644)
+
+	// Only one fits; the newer file survives and the leftover temp file goes.
+	c, err := Open(dir, 10)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if _, ok := c.Get("0a"); ok {
+		t.Fatal("expected the older file evicted")
+	}
+	if data, ok := c.Get("0b"); !ok || string(data) != "123456" {
+		t.Fatalf("expected the newer file, got %q %v", data, ok)
+	}
+	if _, err := os.Stat(filepath.Join(dir, tempPrefix+"1")); !os.IsNotExist(err) {
+		t.Fatalf("expected the temp file removed, got %v", err)
+	}
+}
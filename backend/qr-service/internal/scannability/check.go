@@ -0,0 +1,254 @@
+// Package scannability estimates whether a styled code will still scan once
+// it's printed and photographed, by rendering it, degrading the rendering the
+// way a small print and a shaky phone camera would, and decoding the result.
+package scannability
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"qr-service/internal/model"
+	"qr-service/internal/qrdecode"
+	"qr-service/internal/render"
+)
+
+var (
+	// modulePitchesMm are the simulated printed module sizes, smallest first;
+	// for a QR code carrying a tracking link they make prints roughly 15, 25
+	// and 40 mm wide. The largest one without blur decides Readable.
+	modulePitchesMm = []float64{0.35, 0.6, 1.0}
+	// blurSigmas are Gaussian blurs, in camera pixels, standing in for focus
+	// and motion blur.
+	blurSigmas = []float64{0, 0.75, 1.5}
+)
+
+const (
+	// cameraPxPerMm is roughly what a phone resolves from a code held 15 to
+	// 20 cm away.
+	cameraPxPerMm = 8
+	// sourceScale is the pixels per module of the rendering every simulated
+	// capture is resampled from.
+	sourceScale = 10
+)
+
+// recoverable is roughly the share of a code each error correction level can
+// rebuild.
+var recoverable = map[string]float64{
+	model.ErrorCorrectionL: 0.07,
+	model.ErrorCorrectionM: 0.15,
+	model.ErrorCorrectionQ: 0.25,
+	model.ErrorCorrectionH: 0.30,
+}
+
+// Check renders payload as symbology in style and reports how well it scans.
+// style must already be valid: parseable colors and a known error correction
+// level. PDF417 has no decoder here, so its report rests on contrast alone
+// and says so.
+func Check(payload, symbology string, style model.QrStyle) (model.ScanReport, error) {
+	fg, err := render.ParseHexColor(style.ForegroundOrDefault())
+	if err != nil {
+		return model.ScanReport{}, err
+	}
+	bg, err := render.ParseHexColor(style.BackgroundOrDefault())
+	if err != nil {
+		return model.ScanReport{}, err
+	}
+	level := style.ErrorCorrectionOrDefault()
+	m, err := render.EncodeSymbol(payload, symbology, level)
+	if err != nil {
+		return model.ScanReport{}, err
+	}
+	source := toGray(render.Image(m, render.Style{Foreground: fg, Background: bg, LogoScale: style.LogoScale}, sourceScale))
+	report := model.ScanReport{ContrastRatio: math.Round(contrastRatio(fg, bg)*100) / 100}
+	// widthMm is the printed width, quiet zone included, at a module pitch.
+	cols := m.Width + 2*m.QuietZone
+	widthMm := func(pitch float64) int { return int(math.Ceil(pitch * float64(cols))) }
+
+	verified := true
+	passed := 0
+	// readsAtEveryBlur[i] is whether modulePitchesMm[i] read at every blur
+	// level.
+	readsAtEveryBlur := make([]bool, len(modulePitchesMm))
+	for i, pitch := range modulePitchesMm {
+		captured := resample(source, widthMm(pitch)*cameraPxPerMm)
+		readsAtEveryBlur[i] = true
+		for _, sigma := range blurSigmas {
+			ok, err := reads(blur(captured, sigma), payload, symbology)
+			if errors.Is(err, qrdecode.ErrUnsupported) {
+				verified = false
+				break
+			}
+			if ok {
+				passed++
+			} else {
+				readsAtEveryBlur[i] = false
+			}
+			if i == len(modulePitchesMm)-1 && sigma == 0 {
+				report.Readable = ok
+			}
+		}
+		if !verified {
+			break
+		}
+	}
+
+	largest := widthMm(modulePitchesMm[len(modulePitchesMm)-1])
+	if !verified {
+		// Without a decoder, only the colors can be judged.
+		report.Readable = report.ContrastRatio >= 3
+		report.Score = int(math.Round(100 * contrastFactor(report.ContrastRatio)))
+		if !report.Readable {
+			report.Score = 0
+		}
+		report.Warnings = append(report.Warnings, model.ScanWarning{Code: "unverified", Message: "This symbology can't be test-scanned here; only its colors were checked. Test a print before a large run."})
+	} else {
+		// The minimum size is the smallest one from which every larger size
+		// read too.
+		for i := len(modulePitchesMm) - 1; i >= 0 && readsAtEveryBlur[i]; i-- {
+			report.MinPrintSizeMm = widthMm(modulePitchesMm[i])
+		}
+		trials := len(modulePitchesMm) * len(blurSigmas)
+		report.Score = int(math.Round(100 * float64(passed) / float64(trials) * contrastFactor(report.ContrastRatio)))
+		if !report.Readable {
+			report.Score = 0
+		}
+	}
+
+	if !report.Readable {
+		report.Warnings = append(report.Warnings, model.ScanWarning{Code: "unreadable", Message: fmt.Sprintf("The code couldn't be read even printed %d mm wide and in focus.", largest)})
+	}
+	if report.ContrastRatio < 4.5 {
+		report.Warnings = append(report.Warnings, model.ScanWarning{Code: "low_contrast", Message: fmt.Sprintf("Contrast is %.1f:1; aim for at least 4.5:1 by darkening the foreground or lightening the background.", report.ContrastRatio)})
+	}
+	if luminance(fg) > luminance(bg) {
+		report.Warnings = append(report.Warnings, model.ScanWarning{Code: "inverted_colors", Message: "Light modules on a dark background aren't read by some scanner apps."})
+	}
+	if logo := render.LogoArea(m, style.LogoScale); !logo.Empty() {
+		covered := float64(logo.Dx()*logo.Dy()) / float64(m.Width*m.Height)
+		if covered > recoverable[level] {
+			report.Warnings = append(report.Warnings, model.ScanWarning{Code: "logo_too_large", Message: fmt.Sprintf("The logo hides %.0f%% of the code but level %s only recovers about %.0f%%; use a smaller logo or a higher error correction level.", covered*100, level, recoverable[level]*100)})
+		}
+	}
+	if !verified {
+		return report, nil
+	}
+	if report.Readable && report.MinPrintSizeMm == 0 {
+		report.Warnings = append(report.Warnings, model.ScanWarning{Code: "blur_sensitive", Message: "The code failed to read when slightly out of focus at every simulated size."})
+	} else if report.Readable && report.MinPrintSizeMm > widthMm(modulePitchesMm[0]) {
+		report.Warnings = append(report.Warnings, model.ScanWarning{Code: "small_print", Message: fmt.Sprintf("Print the code at least %d mm wide; smaller prints didn't read reliably.", report.MinPrintSizeMm)})
+	}
+	return report, nil
+}
+
+func reads(img image.Image, payload, symbology string) (bool, error) {
+	text, ok, err := qrdecode.DecodeSymbol(img, symbology)
+	return ok && text == payload, err
+}
+
+// contrastFactor scales the score down for color pairs that decode on screen
+// but fade on paper and under poor light.
+func contrastFactor(ratio float64) float64 {
+	switch {
+	case ratio >= 7:
+		return 1
+	case ratio >= 4.5:
+		return 0.9
+	case ratio >= 3:
+		return 0.7
+	default:
+		return 0.4
+	}
+}
+
+// contrastRatio is the WCAG 2 contrast ratio between a and b.
+func contrastRatio(a, b color.RGBA) float64 {
+	la, lb := luminance(a), luminance(b)
+	return (max(la, lb) + 0.05) / (min(la, lb) + 0.05)
+}
+
+// luminance is the WCAG relative luminance of c.
+func luminance(c color.RGBA) float64 {
+	lin := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*lin(c.R) + 0.7152*lin(c.G) + 0.0722*lin(c.B)
+}
+
+func toGray(img image.Image) *image.Gray {
+	b := img.Bounds()
+	g := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			g.Set(x, y, img.At(x, y))
+		}
+	}
+	return g
+}
+
+// resample scales src to width pixels wide, keeping its aspect ratio and
+// averaging the source pixels each target pixel covers, so modules narrower
+// than a pixel smear together the way they do on a camera sensor.
+func resample(src *image.Gray, width int) *image.Gray {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	ratio := float64(sw) / float64(width)
+	height := max(int(math.Round(float64(sh)/ratio)), 1)
+	dst := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := int(float64(y)*ratio), max(int(float64(y+1)*ratio), int(float64(y)*ratio)+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := int(float64(x)*ratio), max(int(float64(x+1)*ratio), int(float64(x)*ratio)+1)
+			sum, count := 0, 0
+			for sy := y0; sy < min(y1, sh); sy++ {
+				for sx := x0; sx < min(x1, sw); sx++ {
+					sum += int(src.Pix[sy*src.Stride+sx])
+					count++
+				}
+			}
+			dst.Pix[y*dst.Stride+x] = uint8(sum / max(count, 1))
+		}
+	}
+	return dst
+}
+
+// blur applies a separable Gaussian blur; sigma 0 returns src unchanged.
+func blur(src *image.Gray, sigma float64) *image.Gray {
+	if sigma <= 0 {
+		return src
+	}
+	radius := int(math.Ceil(3 * sigma))
+	kernel := make([]float64, 2*radius+1)
+	total := 0.0
+	for i := range kernel {
+		d := float64(i - radius)
+		kernel[i] = math.Exp(-d * d / (2 * sigma * sigma))
+		total += kernel[i]
+	}
+	for i := range kernel {
+		kernel[i] /= total
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	pass := func(in *image.Gray, dx, dy int) *image.Gray {
+		out := image.NewGray(in.Bounds())
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				v := 0.0
+				for i, k := range kernel {
+					sx := min(max(x+(i-radius)*dx, 0), w-1)
+					sy := min(max(y+(i-radius)*dy, 0), h-1)
+					v += k * float64(in.Pix[sy*in.Stride+sx])
+				}
+				out.Pix[y*out.Stride+x] = uint8(math.Round(v))
+			}
+		}
+		return out
+	}
+	return pass(pass(src, 1, 0), 0, 1)
+}
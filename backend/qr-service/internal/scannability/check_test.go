@@ -0,0 +1,92 @@
+package scannability
+
+import (
+	"testing"
+
+	"qr-service/internal/model"
+)
+
+const payload = "https://qr-dragonfly.com/r/0b8f6a52-3c1e-4b7e-9d55-2f3a1c9e7d41"
+
+func hasWarning(r model.ScanReport, code string) bool {
+	for _, w := range r.Warnings {
+		if w.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCheck_PlainCodeScoresWell(t *testing.T) {
+	r, err := Check(payload, model.SymbologyQR, model.QrStyle{})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !r.Readable || r.Score < 60 || r.ContrastRatio != 21 || r.MinPrintSizeMm == 0 {
+		t.Fatalf("unexpected report %+v", r)
+	}
+	if hasWarning(r, "low_contrast") || hasWarning(r, "unreadable") {
+		t.Fatalf("unexpected warnings %+v", r.Warnings)
+	}
+}
+
+func TestCheck_LowContrastAndInverted(t *testing.T) {
+	r, err := Check(payload, model.SymbologyQR, model.QrStyle{Foreground: "#f2f2f2", Background: "#333333"})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !hasWarning(r, "inverted_colors") {
+		t.Fatalf("expected inverted_colors, got %+v", r.Warnings)
+	}
+
+	pale, err := Check(payload, model.SymbologyQR, model.QrStyle{Foreground: "#bbbbbb"})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	plain, _ := Check(payload, model.SymbologyQR, model.QrStyle{})
+	if !hasWarning(pale, "low_contrast") || pale.Score >= plain.Score {
+		t.Fatalf("expected a lower score with a contrast warning, got %+v (plain %d)", pale, plain.Score)
+	}
+}
+
+func TestCheck_OversizedLogo(t *testing.T) {
+	r, err := Check(payload, model.SymbologyQR, model.QrStyle{ErrorCorrection: model.ErrorCorrectionL, LogoScale: 0.4})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if r.Readable || r.Score != 0 || !hasWarning(r, "unreadable") || !hasWarning(r, "logo_too_large") {
+		t.Fatalf("expected an unreadable code, got %+v", r)
+	}
+
+	// The same logo at level H is within what error correction recovers.
+	r, err = Check(payload, model.SymbologyQR, model.QrStyle{ErrorCorrection: model.ErrorCorrectionH, LogoScale: 0.2})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !r.Readable || hasWarning(r, "logo_too_large") {
+		t.Fatalf("expected a readable code, got %+v", r)
+	}
+}
+
+func TestCheck_OtherSymbologies(t *testing.T) {
+	r, err := Check(payload, model.SymbologyDataMatrix, model.QrStyle{})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !r.Readable || r.MinPrintSizeMm == 0 || hasWarning(r, "unverified") {
+		t.Fatalf("expected a decoded Data Matrix, got %+v", r)
+	}
+
+	// PDF417 can't be decoded here; the report says so and rests on contrast.
+	r, err = Check(payload, model.SymbologyPDF417, model.QrStyle{})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !r.Readable || r.MinPrintSizeMm != 0 || !hasWarning(r, "unverified") {
+		t.Fatalf("expected an unverified PDF417 report, got %+v", r)
+	}
+	r, _ = Check(payload, model.SymbologyPDF417, model.QrStyle{Foreground: "#cccccc"})
+	if r.Readable || !hasWarning(r, "unreadable") {
+		t.Fatalf("expected a faint PDF417 to be refused, got %+v", r)
+	}
+}
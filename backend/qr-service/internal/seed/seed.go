@@ -0,0 +1,185 @@
+// Package seed loads fixture files of QR codes and settings into a store.
+//
+// Seeding is deterministic: the same fixture, owner and seed always produce
+// the same IDs, so re-running a seed skips codes that already exist instead
+// of duplicating them. Creation times count back from Options.Now, so they
+// repeat too when Now is pinned.
+package seed
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"qr-service/internal/model"
+	"qr-service/internal/store"
+)
+
+//go:embed fixtures/default.json
+var defaultFixture []byte
+
+type Fixture struct {
+	Settings *model.UserSettings `json:"settings,omitempty"`
+	QrCodes  []QrCodeFixture     `json:"qrCodes"`
+}
+
+type QrCodeFixture struct {
+	Label           string             `json:"label"`
+	URL             string             `json:"url"`
+	Active          *bool              `json:"active,omitempty"`
+	Campaign        string             `json:"campaign,omitempty"`
+	Utm             *model.UtmTemplate `json:"utm,omitempty"`
+	DestinationType string             `json:"destinationType,omitempty"`
+	AppLink         *model.AppLink     `json:"appLink,omitempty"`
+	// AgeDays pins how long ago the code was created; zero picks a random
+	// age within Options.MaxAgeDays.
+	AgeDays int `json:"ageDays,omitempty"`
+}
+
+// WithoutSettings returns a copy of the fixture that only creates codes.
+func (f Fixture) WithoutSettings() Fixture {
+	f.Settings = nil
+	return f
+}
+
+// Default returns the fixture bundled with the service.
+func Default() Fixture {
+	f, err := Load(bytes.NewReader(defaultFixture))
+	if err != nil {
+		panic(fmt.Sprintf("seed: bundled fixture is invalid: %v", err))
+	}
+	return f
+}
+
+func Load(r io.Reader) (Fixture, error) {
+	var f Fixture
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return Fixture{}, err
+	}
+	for i, q := range f.QrCodes {
+		if q.URL == "" {
+			return Fixture{}, fmt.Errorf("qrCodes[%d]: url required", i)
+		}
+	}
+	return f, nil
+}
+
+// LoadFile reads a fixture from path; "default" selects the bundled fixture.
+func LoadFile(path string) (Fixture, error) {
+	if path == "default" {
+		return Default(), nil
+	}
+	fh, err := os.Open(path)
+	if err != nil {
+		return Fixture{}, err
+	}
+	defer fh.Close()
+	return Load(fh)
+}
+
+type Options struct {
+	OwnerID string
+	Seed    int64
+	// Now anchors generated creation times; defaults to the current time,
+	// so pin it (see ParseNow) for creation times that repeat across runs.
+	Now time.Time
+	// MaxAgeDays bounds randomly chosen creation dates; defaults to 180.
+	MaxAgeDays int
+}
+
+type Result struct {
+	Created []model.QrCode
+	// Skipped holds codes that already existed from an earlier run, as
+	// stored.
+	Skipped []model.QrCode
+}
+
+// ParseNow reads an Options.Now given as a date (2006-01-02, midnight UTC)
+// or an RFC 3339 time; "" means the current time.
+func ParseNow(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("now: want YYYY-MM-DD or RFC 3339, got %q", s)
+	}
+	return t.UTC(), nil
+}
+
+// Apply writes the fixture's settings (if any) and codes to st, both
+// belonging to opts.OwnerID.
+func Apply(ctx context.Context, st store.Store, f Fixture, opts Options) (Result, error) {
+	if opts.Now.IsZero() {
+		opts.Now = time.Now().UTC()
+	}
+	if opts.MaxAgeDays <= 0 {
+		opts.MaxAgeDays = 180
+	}
+
+	if f.Settings != nil {
+		if err := st.UpdateSettings(ctx, opts.OwnerID, *f.Settings); err != nil {
+			return Result{}, fmt.Errorf("settings: %w", err)
+		}
+	}
+
+	// Mix the owner into the seed so seeding two users with the same seed
+	// doesn't produce colliding IDs.
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(opts.OwnerID))
+	rng := rand.New(rand.NewSource(opts.Seed ^ int64(h.Sum64())))
+
+	var res Result
+	for i, q := range f.QrCodes {
+		id, err := uuid.NewRandomFromReader(rng)
+		if err != nil {
+			return res, err
+		}
+		age := q.AgeDays
+		if age == 0 {
+			age = rng.Intn(opts.MaxAgeDays) + 1
+		}
+		createdAt := opts.Now.Add(-time.Duration(age)*24*time.Hour + time.Duration(rng.Intn(86400))*time.Second)
+
+		created, err := st.Create(ctx, store.CreateInput{
+			ID:              id.String(),
+			CreatedAt:       createdAt,
+			OwnerID:         opts.OwnerID,
+			Label:           q.Label,
+			URL:             q.URL,
+			Active:          q.Active,
+			Campaign:        q.Campaign,
+			Utm:             q.Utm,
+			DestinationType: q.DestinationType,
+			AppLink:         q.AppLink,
+		})
+		if errors.Is(err, store.ErrConflict) {
+			existing, err := st.Get(ctx, id.String())
+			if err != nil {
+				return res, fmt.Errorf("qrCodes[%d]: %w", i, err)
+			}
+			res.Skipped = append(res.Skipped, existing)
+			continue
+		}
+		if err != nil {
+			return res, fmt.Errorf("qrCodes[%d]: %w", i, err)
+		}
+		res.Created = append(res.Created, created)
+	}
+	return res, nil
+}
@@ -0,0 +1,96 @@
+package seed
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"qr-service/internal/store"
+)
+
+func TestApply_DeterministicAndIdempotent(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	opts := Options{OwnerID: "alice", Seed: 42, Now: now}
+
+	a := store.NewMemoryStore()
+	first, err := Apply(context.Background(), a, Default(), opts)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if len(first.Created) != len(Default().QrCodes) {
+		t.Fatalf("expected %d codes, got %d", len(Default().QrCodes), len(first.Created))
+	}
+
+	b := store.NewMemoryStore()
+	second, err := Apply(context.Background(), b, Default(), opts)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	for i := range first.Created {
+		if first.Created[i].ID != second.Created[i].ID || !first.Created[i].CreatedAt.Equal(second.Created[i].CreatedAt) {
+			t.Fatalf("code %d differs between runs", i)
+		}
+		if first.Created[i].OwnerID != "alice" {
+			t.Fatalf("expected owner alice, got %q", first.Created[i].OwnerID)
+		}
+		if first.Created[i].CreatedAt.After(now) {
+			t.Fatalf("createdAt in the future: %v", first.Created[i].CreatedAt)
+		}
+	}
+
+	again, err := Apply(context.Background(), a, Default(), opts)
+	if err != nil {
+		t.Fatalf("re-apply: %v", err)
+	}
+	if len(again.Created) != 0 || len(again.Skipped) != len(first.Created) {
+		t.Fatalf("expected re-run to skip all codes, got created=%d skipped=%d", len(again.Created), len(again.Skipped))
+	}
+	if again.Skipped[0].ID != first.Created[0].ID || again.Skipped[0].Label != first.Created[0].Label {
+		t.Fatalf("expected skipped codes as stored, got %+v", again.Skipped[0])
+	}
+
+	other, err := Apply(context.Background(), a, Default(), Options{OwnerID: "bob", Seed: 42, Now: now})
+	if err != nil {
+		t.Fatalf("apply bob: %v", err)
+	}
+	if len(other.Created) != len(first.Created) {
+		t.Fatalf("expected a different owner to get fresh IDs")
+	}
+}
+
+func TestApply_WritesSettings(t *testing.T) {
+	st := store.NewMemoryStore()
+	if _, err := Apply(context.Background(), st, Default(), Options{Seed: 1, OwnerID: "demo"}); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	settings, _ := st.GetSettings(context.Background(), "demo")
+	if _, ok := settings.CampaignUtm["summer2026"]; !ok {
+		t.Fatalf("expected campaign UTM settings to be seeded, got %+v", settings)
+	}
+}
+
+func TestLoad_RejectsUnknownFieldsAndMissingURL(t *testing.T) {
+	if _, err := Load(strings.NewReader(`{"qrCodes":[{"label":"x","link":"https://example.com"}]}`)); err == nil {
+		t.Fatalf("expected unknown field to be rejected")
+	}
+	if _, err := Load(strings.NewReader(`{"qrCodes":[{"label":"x"}]}`)); err == nil {
+		t.Fatalf("expected missing url to be rejected")
+	}
+}
+
+func TestParseNow(t *testing.T) {
+	for in, want := range map[string]time.Time{
+		"":                          {},
+		"2026-06-01":                time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+		"2026-06-01T14:00:00+02:00": time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC),
+	} {
+		got, err := ParseNow(in)
+		if err != nil || !got.Equal(want) {
+			t.Fatalf("%q: expected %v, got %v %v", in, want, got, err)
+		}
+	}
+	if _, err := ParseNow("June 1st"); err == nil {
+		t.Fatal("expected an error for an unreadable date")
+	}
+}
@@ -0,0 +1,748 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"qr-service/internal/model"
+)
+
+// gormStore holds the queries PostgresStore and SQLiteStore share; each
+// of them only opens the connection and sets up the schema.
+type gormStore struct {
+	db *gorm.DB
+}
+
+func (s *gormStore) Close() error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+type qrCodeRow struct {
+	ID      uuid.UUID `gorm:"primaryKey;type:uuid"`
+	OwnerID string    `gorm:"not null;default:'';index:qr_codes_owner_id_idx"`
+	// WorkspaceID is '' for codes outside any workspace.
+	WorkspaceID string `gorm:"not null;default:'';index:qr_codes_workspace_id_idx"`
+	Label       string `gorm:"not null"`
+	URL         string `gorm:"not null"`
+	Active      bool   `gorm:"not null;default:true;index:qr_codes_active_idx"`
+	Campaign    string `gorm:"not null;default:''"`
+	Tags        []byte `gorm:"column:tags;type:jsonb"`
+	Utm         []byte `gorm:"column:utm;type:jsonb"`
+
+	DestinationType string    `gorm:"not null;default:'url'"`
+	AppLink         []byte    `gorm:"column:app_link;type:jsonb"`
+	FallbackURL     string    `gorm:"column:fallback_url;not null;default:''"`
+	LandingPage     []byte    `gorm:"column:landing_page;type:jsonb"`
+	Symbology       string    `gorm:"not null;default:'qr'"`
+	Style           []byte    `gorm:"column:style;type:jsonb"`
+	Scannability    []byte    `gorm:"column:scannability;type:jsonb"`
+	Frame           []byte    `gorm:"column:frame;type:jsonb"`
+	CreatedAt       time.Time `gorm:"not null;index:qr_codes_created_at_idx,sort:desc"`
+
+	DisabledReason string `gorm:"not null;default:''"`
+	DisabledAt     *time.Time
+}
+
+func (qrCodeRow) TableName() string { return "qr_codes" }
+
+func (r qrCodeRow) toModel() model.QrCode {
+	q := model.QrCode{ID: r.ID.String(), OwnerID: r.OwnerID, WorkspaceID: r.WorkspaceID, Label: r.Label, URL: r.URL, Active: r.Active, Campaign: r.Campaign, DestinationType: normalizeDestinationType(r.DestinationType), FallbackURL: r.FallbackURL, Symbology: normalizeSymbology(r.Symbology), CreatedAt: r.CreatedAt}
+	q.DisabledReason = r.DisabledReason
+	if r.DisabledAt != nil {
+		q.DisabledAt = *r.DisabledAt
+	}
+	if len(r.Tags) > 0 {
+		var tags []string
+		if err := json.Unmarshal(r.Tags, &tags); err == nil {
+			q.Tags = normalizeTags(tags)
+		}
+	}
+	if len(r.Utm) > 0 {
+		var t model.UtmTemplate
+		if err := json.Unmarshal(r.Utm, &t); err == nil {
+			q.Utm = normalizeUtm(&t)
+		}
+	}
+	if len(r.AppLink) > 0 {
+		var a model.AppLink
+		if err := json.Unmarshal(r.AppLink, &a); err == nil {
+			q.AppLink = normalizeAppLink(&a)
+		}
+	}
+	if len(r.LandingPage) > 0 {
+		var p model.LandingPage
+		if err := json.Unmarshal(r.LandingPage, &p); err == nil {
+			q.LandingPage = normalizeLandingPage(&p)
+		}
+	}
+	if len(r.Style) > 0 {
+		var s model.QrStyle
+		if err := json.Unmarshal(r.Style, &s); err == nil {
+			q.Style = normalizeStyle(&s)
+		}
+	}
+	if len(r.Scannability) > 0 && q.Style != nil {
+		var rep model.ScanReport
+		if err := json.Unmarshal(r.Scannability, &rep); err == nil {
+			q.Scannability = &rep
+		}
+	}
+	if len(r.Frame) > 0 {
+		var f model.QrFrame
+		if err := json.Unmarshal(r.Frame, &f); err == nil {
+			q.Frame = normalizeFrame(&f)
+		}
+	}
+	return q
+}
+
+type settingsRow struct {
+	ID int `gorm:"primaryKey;autoIncrement"`
+	// OwnerID is '' for the settings of codes made without an owner, which
+	// is also where the single pre-per-owner row ended up.
+	OwnerID            string `gorm:"not null;default:'';uniqueIndex:user_settings_owner_id_idx"`
+	DefaultRedirectURL string `gorm:"default:''"`
+	CampaignUtm        []byte `gorm:"column:campaign_utm;type:jsonb"`
+}
+
+func (settingsRow) TableName() string { return "user_settings" }
+
+type templateRow struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid"`
+	OwnerID      string    `gorm:"not null;default:'';index:qr_templates_owner_id_idx"`
+	WorkspaceID  string    `gorm:"not null;default:'';index:qr_templates_workspace_id_idx"`
+	Name         string    `gorm:"not null"`
+	LabelPattern string    `gorm:"not null;default:''"`
+	URLPattern   string    `gorm:"column:url_pattern;not null"`
+	Active       bool      `gorm:"not null;default:true"`
+	Campaign     string    `gorm:"not null;default:''"`
+	Tags         []byte    `gorm:"column:tags;type:jsonb"`
+	Style        []byte    `gorm:"column:style;type:jsonb"`
+	Utm          []byte    `gorm:"column:utm;type:jsonb"`
+
+	DestinationType string    `gorm:"not null;default:'url'"`
+	AppLink         []byte    `gorm:"column:app_link;type:jsonb"`
+	CreatedAt       time.Time `gorm:"not null"`
+}
+
+func (templateRow) TableName() string { return "qr_templates" }
+
+// eventRow is the change feed's outbox: rows are written in the same
+// transaction as the change they describe.
+type eventRow struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement"`
+	Type        string    `gorm:"not null"`
+	QrCodeID    string    `gorm:"column:qr_code_id;not null;default:''"`
+	OwnerID     string    `gorm:"not null;default:''"`
+	WorkspaceID string    `gorm:"not null;default:''"`
+	CreatedAt   time.Time `gorm:"not null;index:qr_events_created_at_idx"`
+}
+
+func (eventRow) TableName() string { return "qr_events" }
+
+func (r eventRow) toModel() model.ChangeEvent {
+	return model.ChangeEvent{ID: r.ID, Type: r.Type, QrCodeID: r.QrCodeID, OwnerID: r.OwnerID, WorkspaceID: r.WorkspaceID, At: r.CreatedAt}
+}
+
+// eventsLockKey names the Postgres advisory lock recordEvents takes.
+const eventsLockKey = 0x71726576
+
+// recordEvents adds events to the outbox and should be the last write of its
+// transaction. Postgres hands out serial IDs when rows are inserted, not when
+// they commit, so a reader could see ID 8 before a slower transaction commits
+// ID 7 and skip it for good. Holding a transaction-scoped lock from here to
+// commit makes IDs commit in order. SQLite transactions already run one at a
+// time.
+func recordEvents(tx *gorm.DB, events ...model.ChangeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if tx.Dialector.Name() == "postgres" {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", eventsLockKey).Error; err != nil {
+			return err
+		}
+	}
+	now := time.Now().UTC()
+	rows := make([]eventRow, len(events))
+	for i, e := range events {
+		rows[i] = eventRow{Type: e.Type, QrCodeID: e.QrCodeID, OwnerID: e.OwnerID, WorkspaceID: e.WorkspaceID, CreatedAt: now}
+	}
+	return tx.Create(&rows).Error
+}
+
+func (r templateRow) toModel() model.QrTemplate {
+	t := model.QrTemplate{ID: r.ID.String(), OwnerID: r.OwnerID, WorkspaceID: r.WorkspaceID, Name: r.Name, LabelPattern: r.LabelPattern, URLPattern: r.URLPattern, Active: r.Active, Campaign: r.Campaign, DestinationType: normalizeDestinationType(r.DestinationType), CreatedAt: r.CreatedAt}
+	if len(r.Tags) > 0 {
+		var tags []string
+		if err := json.Unmarshal(r.Tags, &tags); err == nil {
+			t.Tags = normalizeTags(tags)
+		}
+	}
+	if len(r.Style) > 0 {
+		var s model.QrStyle
+		if err := json.Unmarshal(r.Style, &s); err == nil {
+			t.Style = normalizeStyle(&s)
+		}
+	}
+	if len(r.Utm) > 0 {
+		var u model.UtmTemplate
+		if err := json.Unmarshal(r.Utm, &u); err == nil {
+			t.Utm = normalizeUtm(&u)
+		}
+	}
+	if len(r.AppLink) > 0 {
+		var a model.AppLink
+		if err := json.Unmarshal(r.AppLink, &a); err == nil {
+			t.AppLink = normalizeAppLink(&a)
+		}
+	}
+	return t
+}
+
+func templateRowFromModel(t model.QrTemplate) templateRow {
+	return templateRow{
+		OwnerID:         t.OwnerID,
+		WorkspaceID:     t.WorkspaceID,
+		Name:            t.Name,
+		LabelPattern:    t.LabelPattern,
+		URLPattern:      t.URLPattern,
+		Active:          t.Active,
+		Campaign:        t.Campaign,
+		Tags:            marshalTags(t.Tags),
+		Style:           marshalJSONB(t.Style),
+		Utm:             marshalJSONB(t.Utm),
+		DestinationType: t.DestinationType,
+		AppLink:         marshalJSONB(t.AppLink),
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+// Per-operation deadlines, applied on top of the caller's context. A single
+// statement gets queryTimeout; transactions and batch writes, which may touch
+// many rows, get batchTimeout.
+const (
+	queryTimeout = 5 * time.Second
+	batchTimeout = 30 * time.Second
+)
+
+// op runs fn against a session bound to ctx with timeout applied. Drivers
+// don't all wrap context errors, so when the context has ended the error
+// reported is ctx.Err() and callers can match it with errors.Is.
+func (s *gormStore) op(ctx context.Context, timeout time.Duration, fn func(db *gorm.DB) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := fn(s.db.WithContext(ctx))
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (s *gormStore) List(ctx context.Context) ([]model.QrCode, error) {
+	rows := make([]qrCodeRow, 0, 32)
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Order("created_at desc").Find(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]model.QrCode, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, r.toModel())
+	}
+	return items, nil
+}
+
+// forEachBatchSize is how many rows ForEach reads per query; the connection
+// is released between batches, so a slow consumer doesn't pin it.
+const forEachBatchSize = 500
+
+// ForEach pages by ID rather than holding one query open, so each batch gets
+// its own deadline and fn's time doesn't count against it.
+func (s *gormStore) ForEach(ctx context.Context, workspaceID string, fn func(model.QrCode) error) error {
+	var after uuid.UUID
+	for {
+		var batch []qrCodeRow
+		err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+			return db.Where("workspace_id = ? AND id > ?", workspaceID, after).Order("id").Limit(forEachBatchSize).Find(&batch).Error
+		})
+		if err != nil {
+			return err
+		}
+		for _, r := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(r.toModel()); err != nil {
+				return err
+			}
+		}
+		if len(batch) < forEachBatchSize {
+			return nil
+		}
+		after = batch[len(batch)-1].ID
+	}
+}
+
+func (s *gormStore) Get(ctx context.Context, id string) (model.QrCode, error) {
+	var q model.QrCode
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		var err error
+		q, err = getQrCode(db, id)
+		return err
+	})
+	return q, err
+}
+
+func getQrCode(db *gorm.DB, id string) (model.QrCode, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return model.QrCode{}, ErrNotFound
+	}
+
+	var r qrCodeRow
+	err = db.First(&r, "id = ?", uid).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.QrCode{}, ErrNotFound
+		}
+		return model.QrCode{}, err
+	}
+	return r.toModel(), nil
+}
+
+func (s *gormStore) Create(ctx context.Context, input CreateInput) (model.QrCode, error) {
+	var q model.QrCode
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			if q, err = createQrCode(tx, input); err != nil {
+				return err
+			}
+			return recordEvents(tx, model.CodeEvent(model.EventQrCodeCreated, q))
+		})
+	})
+	return q, err
+}
+
+func (s *gormStore) CreateBatch(ctx context.Context, inputs []CreateInput) ([]model.QrCode, error) {
+	created := make([]model.QrCode, 0, len(inputs))
+	err := s.op(ctx, batchTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			events := make([]model.ChangeEvent, 0, len(inputs))
+			for _, input := range inputs {
+				q, err := createQrCode(tx, input)
+				if err != nil {
+					return err
+				}
+				created = append(created, q)
+				events = append(events, model.CodeEvent(model.EventQrCodeCreated, q))
+			}
+			return recordEvents(tx, events...)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func createQrCode(db *gorm.DB, input CreateInput) (model.QrCode, error) {
+	id := uuid.New()
+	if input.ID != "" {
+		parsed, err := uuid.Parse(input.ID)
+		if err != nil {
+			return model.QrCode{}, err
+		}
+		id = parsed
+	}
+	createdAt := input.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	active := true
+	if input.Active != nil {
+		active = *input.Active
+	}
+
+	q := model.QrCode{
+		ID:          id.String(),
+		OwnerID:     input.OwnerID,
+		WorkspaceID: input.WorkspaceID,
+		Label:       input.Label,
+		URL:         input.URL,
+		Active:      active,
+		Campaign:    input.Campaign,
+		Tags:        normalizeTags(input.Tags),
+		Utm:         normalizeUtm(input.Utm),
+
+		DestinationType: normalizeDestinationType(input.DestinationType),
+		AppLink:         normalizeAppLink(input.AppLink),
+		FallbackURL:     input.FallbackURL,
+		LandingPage:     normalizeLandingPage(input.LandingPage),
+		Symbology:       normalizeSymbology(input.Symbology),
+		Style:           normalizeStyle(input.Style),
+		Frame:           normalizeFrame(input.Frame),
+		CreatedAt:       createdAt.UTC(),
+	}
+	if q.Style != nil {
+		q.Scannability = normalizeScanReport(input.Scannability)
+	}
+	if q.Label == "" {
+		q.Label = "Untitled"
+	}
+
+	r := qrCodeRow{
+		ID:              id,
+		OwnerID:         q.OwnerID,
+		WorkspaceID:     q.WorkspaceID,
+		Label:           q.Label,
+		URL:             q.URL,
+		Active:          q.Active,
+		Campaign:        q.Campaign,
+		Tags:            marshalTags(q.Tags),
+		Utm:             marshalJSONB(q.Utm),
+		DestinationType: q.DestinationType,
+		AppLink:         marshalJSONB(q.AppLink),
+		FallbackURL:     q.FallbackURL,
+		LandingPage:     marshalJSONB(q.LandingPage),
+		Symbology:       q.Symbology,
+		Style:           marshalJSONB(q.Style),
+		Scannability:    marshalJSONB(q.Scannability),
+		Frame:           marshalJSONB(q.Frame),
+		CreatedAt:       q.CreatedAt,
+	}
+	if err := db.Create(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return model.QrCode{}, ErrConflict
+		}
+		return model.QrCode{}, err
+	}
+	// gorm inserts the column default (true) in place of a false Active.
+	if !q.Active {
+		if err := db.Model(&qrCodeRow{}).Where("id = ?", id).Update("active", false).Error; err != nil {
+			return model.QrCode{}, err
+		}
+	}
+	return q, nil
+}
+
+func (s *gormStore) Update(ctx context.Context, id string, input UpdateInput) (model.QrCode, error) {
+	var q model.QrCode
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			if q, err = updateQrCode(tx, id, input); err != nil {
+				return err
+			}
+			return recordEvents(tx, model.CodeEvent(model.EventQrCodeUpdated, q))
+		})
+	})
+	return q, err
+}
+
+func updateQrCode(db *gorm.DB, id string, input UpdateInput) (model.QrCode, error) {
+	// Load first so we can map not-found cleanly.
+	current, err := getQrCode(db, id)
+	if err != nil {
+		return model.QrCode{}, err
+	}
+	applyUpdate(&current, input)
+
+	updates := map[string]any{
+		"label":            current.Label,
+		"url":              current.URL,
+		"active":           current.Active,
+		"campaign":         current.Campaign,
+		"tags":             marshalTags(current.Tags),
+		"utm":              marshalJSONB(current.Utm),
+		"destination_type": current.DestinationType,
+		"app_link":         marshalJSONB(current.AppLink),
+		"fallback_url":     current.FallbackURL,
+		"landing_page":     marshalJSONB(current.LandingPage),
+		"symbology":        current.Symbology,
+		"style":            marshalJSONB(current.Style),
+		"scannability":     marshalJSONB(current.Scannability),
+		"frame":            marshalJSONB(current.Frame),
+		"owner_id":         current.OwnerID,
+		"disabled_reason":  current.DisabledReason,
+		"disabled_at":      nil,
+	}
+	if !current.DisabledAt.IsZero() {
+		updates["disabled_at"] = current.DisabledAt
+	}
+	if err := db.Model(&qrCodeRow{}).Where("id = ?", current.ID).Updates(updates).Error; err != nil {
+		return model.QrCode{}, err
+	}
+	return current, nil
+}
+
+func (s *gormStore) Delete(ctx context.Context, id string) error {
+	return s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			q, err := deleteQrCode(tx, id)
+			if err != nil {
+				return err
+			}
+			return recordEvents(tx, model.CodeEvent(model.EventQrCodeDeleted, q))
+		})
+	})
+}
+
+// deleteQrCode returns the deleted code for its change event.
+func deleteQrCode(db *gorm.DB, id string) (model.QrCode, error) {
+	q, err := getQrCode(db, id)
+	if err != nil {
+		return model.QrCode{}, err
+	}
+
+	res := db.Delete(&qrCodeRow{}, "id = ?", q.ID)
+	if res.Error != nil {
+		return model.QrCode{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return model.QrCode{}, ErrNotFound
+	}
+	return q, nil
+}
+
+func (s *gormStore) ApplyBatch(ctx context.Context, changes []BatchChange) error {
+	return s.op(ctx, batchTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			events := make([]model.ChangeEvent, 0, len(changes))
+			for _, c := range changes {
+				if c.Delete {
+					q, err := deleteQrCode(tx, c.ID)
+					if err != nil {
+						return err
+					}
+					events = append(events, model.CodeEvent(model.EventQrCodeDeleted, q))
+					continue
+				}
+				q, err := updateQrCode(tx, c.ID, c.Update)
+				if err != nil {
+					return err
+				}
+				events = append(events, model.CodeEvent(model.EventQrCodeUpdated, q))
+			}
+			return recordEvents(tx, events...)
+		})
+	})
+}
+
+func (s *gormStore) CountTotal(ctx context.Context, ownerID string) (int, error) {
+	var n int64
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Model(&qrCodeRow{}).Where("owner_id = ?", ownerID).Count(&n).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+func (s *gormStore) CountActive(ctx context.Context, ownerID string) (int, error) {
+	var n int64
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Model(&qrCodeRow{}).Where("owner_id = ? AND active = ?", ownerID, true).Count(&n).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+func (s *gormStore) GetSettings(ctx context.Context, ownerID string) (model.UserSettings, error) {
+	var row settingsRow
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Where("owner_id = ?", ownerID).Limit(1).Find(&row).Error
+	})
+	if err != nil {
+		return model.UserSettings{}, err
+	}
+	settings := model.UserSettings{DefaultRedirectURL: row.DefaultRedirectURL}
+	if len(row.CampaignUtm) > 0 {
+		_ = json.Unmarshal(row.CampaignUtm, &settings.CampaignUtm)
+	}
+	return settings, nil
+}
+
+func (s *gormStore) UpdateSettings(ctx context.Context, ownerID string, settings model.UserSettings) error {
+	campaignUtm, err := json.Marshal(settings.CampaignUtm)
+	if err != nil {
+		return err
+	}
+	row := settingsRow{OwnerID: ownerID, DefaultRedirectURL: settings.DefaultRedirectURL, CampaignUtm: campaignUtm}
+	return s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Insert the owner's first save, update later ones.
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "owner_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"default_redirect_url", "campaign_utm"}),
+			}).Create(&row).Error
+			if err != nil {
+				return err
+			}
+			return recordEvents(tx, model.ChangeEvent{Type: model.EventSettingsUpdated, OwnerID: ownerID})
+		})
+	})
+}
+
+func (s *gormStore) ListTemplates(ctx context.Context) ([]model.QrTemplate, error) {
+	var rows []templateRow
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Order("created_at desc").Find(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.QrTemplate, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, r.toModel())
+	}
+	return items, nil
+}
+
+func (s *gormStore) GetTemplate(ctx context.Context, id string) (model.QrTemplate, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return model.QrTemplate{}, ErrNotFound
+	}
+	var r templateRow
+	err = s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.First(&r, "id = ?", uid).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.QrTemplate{}, ErrNotFound
+		}
+		return model.QrTemplate{}, err
+	}
+	return r.toModel(), nil
+}
+
+func (s *gormStore) CreateTemplate(ctx context.Context, t model.QrTemplate) (model.QrTemplate, error) {
+	t = normalizeTemplate(t)
+	t.CreatedAt = time.Now().UTC()
+	r := templateRowFromModel(t)
+	r.ID = uuid.New()
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&r).Error; err != nil {
+				return err
+			}
+			// gorm inserts the column default (true) in place of a false Active.
+			if t.Active {
+				return nil
+			}
+			return tx.Model(&templateRow{}).Where("id = ?", r.ID).Update("active", false).Error
+		})
+	})
+	if err != nil {
+		return model.QrTemplate{}, err
+	}
+	t.ID = r.ID.String()
+	return t, nil
+}
+
+func (s *gormStore) UpdateTemplate(ctx context.Context, id string, t model.QrTemplate) (model.QrTemplate, error) {
+	current, err := s.GetTemplate(ctx, id)
+	if err != nil {
+		return model.QrTemplate{}, err
+	}
+	t = normalizeTemplate(t)
+	t.ID, t.OwnerID, t.WorkspaceID, t.CreatedAt = current.ID, current.OwnerID, current.WorkspaceID, current.CreatedAt
+
+	r := templateRowFromModel(t)
+	updates := map[string]any{
+		"name":             r.Name,
+		"label_pattern":    r.LabelPattern,
+		"url_pattern":      r.URLPattern,
+		"active":           r.Active,
+		"campaign":         r.Campaign,
+		"tags":             r.Tags,
+		"style":            r.Style,
+		"utm":              r.Utm,
+		"destination_type": r.DestinationType,
+		"app_link":         r.AppLink,
+	}
+	err = s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Model(&templateRow{}).Where("id = ?", id).Updates(updates).Error
+	})
+	if err != nil {
+		return model.QrTemplate{}, err
+	}
+	return t, nil
+}
+
+func (s *gormStore) DeleteTemplate(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return ErrNotFound
+	}
+	return s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		res := db.Delete(&templateRow{}, "id = ?", uid)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func (s *gormStore) EventsAfter(ctx context.Context, after int64, limit int) ([]model.ChangeEvent, error) {
+	var rows []eventRow
+	err := s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Where("id > ?", after).Order("id").Limit(limit).Find(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]model.ChangeEvent, 0, len(rows))
+	for _, r := range rows {
+		events = append(events, r.toModel())
+	}
+	return events, nil
+}
+
+func (s *gormStore) PurgeEvents(ctx context.Context, cutoff time.Time) error {
+	return s.op(ctx, queryTimeout, func(db *gorm.DB) error {
+		return db.Where("created_at < ?", cutoff.UTC()).Delete(&eventRow{}).Error
+	})
+}
+
+// marshalTags stores no tags as NULL rather than an empty array.
+func marshalTags(tags []string) []byte {
+	if len(tags) == 0 {
+		return nil
+	}
+	return marshalJSONB(&tags)
+}
+
+// marshalJSONB encodes an optional value for a jsonb column; nil stays SQL NULL.
+func marshalJSONB[T any](v *T) []byte {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return b
+}
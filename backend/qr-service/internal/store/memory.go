@@ -1,6 +1,7 @@
 package store
 
 import (
+	"context"
 	"sort"
 	"sync"
 	"time"
@@ -11,16 +12,27 @@ import (
 )
 
 type MemoryStore struct {
-	mu       sync.RWMutex
-	byID     map[string]model.QrCode
-	settings model.UserSettings
+	mu        sync.RWMutex
+	byID      map[string]model.QrCode
+	settings  map[string]model.UserSettings
+	templates map[string]model.QrTemplate
+	events    []model.ChangeEvent
+	// purged counts events dropped from the front of events.
+	purged int64
 }
 
 func NewMemoryStore() *MemoryStore {
-	return &MemoryStore{byID: make(map[string]model.QrCode)}
+	return &MemoryStore{byID: make(map[string]model.QrCode), settings: make(map[string]model.UserSettings), templates: make(map[string]model.QrTemplate)}
 }
 
-func (s *MemoryStore) List() []model.QrCode {
+func (s *MemoryStore) List(ctx context.Context) ([]model.QrCode, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.list(), nil
+}
+
+func (s *MemoryStore) list() []model.QrCode {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -37,7 +49,25 @@ func (s *MemoryStore) List() []model.QrCode {
 	return items
 }
 
-func (s *MemoryStore) Get(id string) (model.QrCode, error) {
+func (s *MemoryStore) ForEach(ctx context.Context, workspaceID string, fn func(model.QrCode) error) error {
+	for _, q := range s.list() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if q.WorkspaceID != workspaceID {
+			continue
+		}
+		if err := fn(q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, id string) (model.QrCode, error) {
+	if err := ctx.Err(); err != nil {
+		return model.QrCode{}, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -48,17 +78,71 @@ func (s *MemoryStore) Get(id string) (model.QrCode, error) {
 	return v, nil
 }
 
-func (s *MemoryStore) Create(input CreateInput) (model.QrCode, error) {
+func (s *MemoryStore) Create(ctx context.Context, input CreateInput) (model.QrCode, error) {
+	if err := ctx.Err(); err != nil {
+		return model.QrCode{}, err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	return s.createLocked(input)
+}
 
-	id := uuid.NewString()
+func (s *MemoryStore) CreateBatch(ctx context.Context, inputs []CreateInput) ([]model.QrCode, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	created := make([]model.QrCode, 0, len(inputs))
+	for _, input := range inputs {
+		q, err := s.createLocked(input)
+		if err != nil {
+			for _, c := range created {
+				delete(s.byID, c.ID)
+			}
+			s.events = s.events[:len(s.events)-len(created)]
+			return nil, err
+		}
+		created = append(created, q)
+	}
+	return created, nil
+}
+
+func (s *MemoryStore) createLocked(input CreateInput) (model.QrCode, error) {
+	id := input.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	if _, exists := s.byID[id]; exists {
+		return model.QrCode{}, ErrConflict
+	}
+	createdAt := input.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
 	q := model.QrCode{
-		ID:        id,
-		Label:     input.Label,
-		URL:       input.URL,
-		Active:    true,
-		CreatedAt: time.Now().UTC(),
+		ID:          id,
+		OwnerID:     input.OwnerID,
+		WorkspaceID: input.WorkspaceID,
+		Label:       input.Label,
+		URL:         input.URL,
+		Active:      true,
+		Campaign:    input.Campaign,
+		Tags:        normalizeTags(input.Tags),
+		Utm:         normalizeUtm(input.Utm),
+
+		DestinationType: normalizeDestinationType(input.DestinationType),
+		AppLink:         normalizeAppLink(input.AppLink),
+		FallbackURL:     input.FallbackURL,
+		LandingPage:     normalizeLandingPage(input.LandingPage),
+		Symbology:       normalizeSymbology(input.Symbology),
+		Style:           normalizeStyle(input.Style),
+		Frame:           normalizeFrame(input.Frame),
+		CreatedAt:       createdAt.UTC(),
+	}
+	if q.Style != nil {
+		q.Scannability = normalizeScanReport(input.Scannability)
 	}
 	if input.Active != nil {
 		q.Active = *input.Active
@@ -68,10 +152,14 @@ func (s *MemoryStore) Create(input CreateInput) (model.QrCode, error) {
 	}
 
 	s.byID[id] = q
+	s.recordLocked(model.CodeEvent(model.EventQrCodeCreated, q))
 	return q, nil
 }
 
-func (s *MemoryStore) Update(id string, input UpdateInput) (model.QrCode, error) {
+func (s *MemoryStore) Update(ctx context.Context, id string, input UpdateInput) (model.QrCode, error) {
+	if err := ctx.Err(); err != nil {
+		return model.QrCode{}, err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -80,61 +168,213 @@ func (s *MemoryStore) Update(id string, input UpdateInput) (model.QrCode, error)
 		return model.QrCode{}, ErrNotFound
 	}
 
-	if input.Label != nil {
-		q.Label = *input.Label
-	}
-	if input.URL != nil {
-		q.URL = *input.URL
-	}
-	if input.Active != nil {
-		q.Active = *input.Active
-	}
-	if q.Label == "" {
-		q.Label = "Untitled"
-	}
+	applyUpdate(&q, input)
 
 	s.byID[id] = q
+	s.recordLocked(model.CodeEvent(model.EventQrCodeUpdated, q))
 	return q, nil
 }
 
-func (s *MemoryStore) Delete(id string) error {
+func (s *MemoryStore) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, ok := s.byID[id]; !ok {
+	q, ok := s.byID[id]
+	if !ok {
 		return ErrNotFound
 	}
 	delete(s.byID, id)
+	s.recordLocked(model.CodeEvent(model.EventQrCodeDeleted, q))
 	return nil
 }
 
-func (s *MemoryStore) CountTotal() (int, error) {
+func (s *MemoryStore) ApplyBatch(ctx context.Context, changes []BatchChange) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range changes {
+		if _, ok := s.byID[c.ID]; !ok {
+			return ErrNotFound
+		}
+	}
+	for _, c := range changes {
+		q := s.byID[c.ID]
+		if c.Delete {
+			delete(s.byID, c.ID)
+			s.recordLocked(model.CodeEvent(model.EventQrCodeDeleted, q))
+			continue
+		}
+		applyUpdate(&q, c.Update)
+		s.byID[c.ID] = q
+		s.recordLocked(model.CodeEvent(model.EventQrCodeUpdated, q))
+	}
+	return nil
+}
+
+func (s *MemoryStore) CountTotal(ctx context.Context, ownerID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return len(s.byID), nil
+	total := 0
+	for _, v := range s.byID {
+		if v.OwnerID == ownerID {
+			total++
+		}
+	}
+	return total, nil
 }
 
-func (s *MemoryStore) CountActive() (int, error) {
+func (s *MemoryStore) CountActive(ctx context.Context, ownerID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	active := 0
 	for _, v := range s.byID {
-		if v.Active {
+		if v.OwnerID == ownerID && v.Active {
 			active++
 		}
 	}
 	return active, nil
 }
 
-func (s *MemoryStore) GetSettings() (model.UserSettings, error) {
+func (s *MemoryStore) GetSettings(ctx context.Context, ownerID string) (model.UserSettings, error) {
+	if err := ctx.Err(); err != nil {
+		return model.UserSettings{}, err
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.settings, nil
+	return s.settings[ownerID], nil
 }
 
-func (s *MemoryStore) UpdateSettings(settings model.UserSettings) error {
+func (s *MemoryStore) UpdateSettings(ctx context.Context, ownerID string, settings model.UserSettings) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.settings = settings
+	s.settings[ownerID] = settings
+	s.recordLocked(model.ChangeEvent{Type: model.EventSettingsUpdated, OwnerID: ownerID})
 	return nil
 }
+
+func (s *MemoryStore) ListTemplates(ctx context.Context) ([]model.QrTemplate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := make([]model.QrTemplate, 0, len(s.templates))
+	for _, v := range s.templates {
+		items = append(items, v)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].CreatedAt.After(items[j].CreatedAt)
+	})
+	return items, nil
+}
+
+func (s *MemoryStore) GetTemplate(ctx context.Context, id string) (model.QrTemplate, error) {
+	if err := ctx.Err(); err != nil {
+		return model.QrTemplate{}, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.templates[id]
+	if !ok {
+		return model.QrTemplate{}, ErrNotFound
+	}
+	return v, nil
+}
+
+func (s *MemoryStore) CreateTemplate(ctx context.Context, t model.QrTemplate) (model.QrTemplate, error) {
+	if err := ctx.Err(); err != nil {
+		return model.QrTemplate{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t = normalizeTemplate(t)
+	t.ID = uuid.NewString()
+	t.CreatedAt = time.Now().UTC()
+	s.templates[t.ID] = t
+	return t, nil
+}
+
+func (s *MemoryStore) UpdateTemplate(ctx context.Context, id string, t model.QrTemplate) (model.QrTemplate, error) {
+	if err := ctx.Err(); err != nil {
+		return model.QrTemplate{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.templates[id]
+	if !ok {
+		return model.QrTemplate{}, ErrNotFound
+	}
+	t = normalizeTemplate(t)
+	t.ID, t.OwnerID, t.WorkspaceID, t.CreatedAt = current.ID, current.OwnerID, current.WorkspaceID, current.CreatedAt
+	s.templates[id] = t
+	return t, nil
+}
+
+func (s *MemoryStore) DeleteTemplate(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.templates[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.templates, id)
+	return nil
+}
+
+func (s *MemoryStore) EventsAfter(ctx context.Context, after int64, limit int) ([]model.ChangeEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// IDs are 1-based positions in events, counting purged ones.
+	start := int(min(max(after-s.purged, 0), int64(len(s.events))))
+	end := min(start+limit, len(s.events))
+	return append([]model.ChangeEvent(nil), s.events[start:end]...), nil
+}
+
+func (s *MemoryStore) PurgeEvents(ctx context.Context, cutoff time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for n < len(s.events) && s.events[n].At.Before(cutoff) {
+		n++
+	}
+	s.events = append([]model.ChangeEvent(nil), s.events[n:]...)
+	s.purged += int64(n)
+	return nil
+}
+
+// recordLocked appends e to the change feed; s.mu must be held for writing.
+func (s *MemoryStore) recordLocked(e model.ChangeEvent) {
+	e.ID = s.purged + int64(len(s.events)) + 1
+	e.At = time.Now().UTC()
+	s.events = append(s.events, e)
+}
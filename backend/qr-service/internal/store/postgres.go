@@ -2,39 +2,20 @@ package store
 
 import (
 	"context"
-	"errors"
+	"log"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-
-	"qr-service/internal/model"
 )
 
 type PostgresStore struct {
-	db *gorm.DB
-}
-
-type qrCodeRow struct {
-	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Label     string    `gorm:"not null"`
-	URL       string    `gorm:"not null"`
-	Active    bool      `gorm:"not null;default:true;index:qr_codes_active_idx"`
-	CreatedAt time.Time `gorm:"not null;index:qr_codes_created_at_idx,sort:desc"`
-}
-
-func (qrCodeRow) TableName() string { return "qr_codes" }
-
-type settingsRow struct {
-	ID                 int    `gorm:"primaryKey;autoIncrement"`
-	DefaultRedirectURL string `gorm:"default:''"`
+	gormStore
 }
 
-func (settingsRow) TableName() string { return "user_settings" }
-
 func NewPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore, error) {
-	gdb, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{})
+	gdb, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +33,7 @@ func NewPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore,
 		return nil, err
 	}
 
-	s := &PostgresStore{db: gdb}
+	s := &PostgresStore{gormStore{db: gdb}}
 	if err := s.ensureSchema(ctx); err != nil {
 		_ = sqlDB.Close()
 		return nil, err
@@ -60,17 +41,6 @@ func NewPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore,
 	return s, nil
 }
 
-func (s *PostgresStore) Close() error {
-	if s == nil || s.db == nil {
-		return nil
-	}
-	sqlDB, err := s.db.DB()
-	if err != nil {
-		return err
-	}
-	return sqlDB.Close()
-}
-
 func (s *PostgresStore) ensureSchema(ctx context.Context) error {
 	db := s.db.WithContext(ctx)
 
@@ -84,9 +54,6 @@ func (s *PostgresStore) ensureSchema(ctx context.Context) error {
 		if err := db.Exec(`ALTER TABLE qr_codes ALTER COLUMN id TYPE uuid USING id::uuid;`).Error; err != nil {
 			return err
 		}
-		if err := db.Exec(`ALTER TABLE qr_codes ALTER COLUMN id SET DEFAULT gen_random_uuid();`).Error; err != nil {
-			return err
-		}
 
 		// Add active flag (default true) for existing rows.
 		if err := db.Exec(`ALTER TABLE qr_codes ADD COLUMN IF NOT EXISTS active boolean NOT NULL DEFAULT true;`).Error; err != nil {
@@ -94,140 +61,84 @@ func (s *PostgresStore) ensureSchema(ctx context.Context) error {
 		}
 	}
 
-	if err := db.AutoMigrate(&qrCodeRow{}); err != nil {
+	if err := db.AutoMigrate(&qrCodeRow{}, &settingsRow{}, &templateRow{}, &eventRow{}); err != nil {
 		return err
 	}
-	return db.AutoMigrate(&settingsRow{})
-}
-
-func (s *PostgresStore) List() []model.QrCode {
-	rows := make([]qrCodeRow, 0, 32)
-	if err := s.db.Order("created_at desc").Find(&rows).Error; err != nil {
-		return []model.QrCode{}
-	}
-
-	items := make([]model.QrCode, 0, len(rows))
-	for _, r := range rows {
-		items = append(items, model.QrCode{ID: r.ID.String(), Label: r.Label, URL: r.URL, Active: r.Active, CreatedAt: r.CreatedAt})
-	}
-	return items
-}
-
-func (s *PostgresStore) Get(id string) (model.QrCode, error) {
-	uid, err := uuid.Parse(id)
-	if err != nil {
-		return model.QrCode{}, ErrNotFound
-	}
-
-	var r qrCodeRow
-	err = s.db.First(&r, "id = ?", uid).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return model.QrCode{}, ErrNotFound
+	// IDs are generated in Go; the column defaults are for rows inserted by
+	// hand. They're set here because SQLite can't parse them in a struct tag.
+	for _, table := range []string{"qr_codes", "qr_templates"} {
+		if err := db.Exec(`ALTER TABLE ` + table + ` ALTER COLUMN id SET DEFAULT gen_random_uuid();`).Error; err != nil {
+			return err
 		}
-		return model.QrCode{}, err
 	}
-	return model.QrCode{ID: r.ID.String(), Label: r.Label, URL: r.URL, Active: r.Active, CreatedAt: r.CreatedAt}, nil
-}
-
-func (s *PostgresStore) Create(input CreateInput) (model.QrCode, error) {
-	id := uuid.New()
-	active := true
-	if input.Active != nil {
-		active = *input.Active
+	// Settings used to be one row saved with an explicit id of 1, which never
+	// advanced the sequence; move it past that row before owners add theirs.
+	if err := db.Exec(`SELECT setval(pg_get_serial_sequence('user_settings', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM user_settings;`).Error; err != nil {
+		return err
 	}
 
-	q := model.QrCode{
-		ID:        id.String(),
-		Label:     input.Label,
-		URL:       input.URL,
-		Active:    active,
-		CreatedAt: time.Now().UTC(),
-	}
-	if q.Label == "" {
-		q.Label = "Untitled"
+	// Every outbox insert wakes listeners; see ListenForEvents. The payload is
+	// empty because listeners read the outbox from their own cursor.
+	if err := db.Exec(`CREATE OR REPLACE FUNCTION qr_events_notify() RETURNS trigger AS $$
+BEGIN
+	PERFORM pg_notify('` + EventsChannel + `', '');
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`).Error; err != nil {
+		return err
 	}
-
-	r := qrCodeRow{ID: id, Label: q.Label, URL: q.URL, Active: q.Active, CreatedAt: q.CreatedAt}
-	if err := s.db.Create(&r).Error; err != nil {
-		return model.QrCode{}, err
+	if err := db.Exec(`DROP TRIGGER IF EXISTS qr_events_notify ON qr_events;`).Error; err != nil {
+		return err
 	}
-	return q, nil
+	return db.Exec(`CREATE TRIGGER qr_events_notify AFTER INSERT ON qr_events FOR EACH STATEMENT EXECUTE FUNCTION qr_events_notify();`).Error
 }
 
-func (s *PostgresStore) Update(id string, input UpdateInput) (model.QrCode, error) {
-	uid, err := uuid.Parse(id)
-	if err != nil {
-		return model.QrCode{}, ErrNotFound
-	}
-
-	// Load first so we can map not-found cleanly.
-	current, err := s.Get(id)
-	if err != nil {
-		return model.QrCode{}, err
-	}
-
-	if input.Label != nil {
-		current.Label = *input.Label
-	}
-	if input.URL != nil {
-		current.URL = *input.URL
-	}
-	if input.Active != nil {
-		current.Active = *input.Active
-	}
-	if current.Label == "" {
-		current.Label = "Untitled"
-	}
-
-	updates := map[string]any{"label": current.Label, "url": current.URL, "active": current.Active}
-	if err := s.db.Model(&qrCodeRow{}).Where("id = ?", uid).Updates(updates).Error; err != nil {
-		return model.QrCode{}, err
+// EventsChannel is the Postgres NOTIFY channel raised when change events are
+// committed.
+const EventsChannel = "qr_events"
+
+// ListenForEvents calls notify whenever a change event is committed to the
+// Postgres database at databaseURL, from any qr-service instance, until ctx
+// ends. It holds its own connection and reconnects when that drops; notify
+// is also called after every (re)connect, since events may have been
+// committed while nobody was listening.
+func ListenForEvents(ctx context.Context, databaseURL string, notify func()) {
+	backoff := time.Second
+	for ctx.Err() == nil {
+		listened, err := listenOnce(ctx, databaseURL, notify)
+		if ctx.Err() != nil {
+			return
+		}
+		if listened {
+			// The connection worked, so this outage starts the backoff over.
+			backoff = time.Second
+		}
+		log.Printf("event listener: %v; reconnecting in %s", err, backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, time.Minute)
 	}
-	return current, nil
 }
 
-func (s *PostgresStore) Delete(id string) error {
-	uid, err := uuid.Parse(id)
+// listenOnce listens on one connection until it fails. listened reports
+// whether LISTEN succeeded before that.
+func listenOnce(ctx context.Context, databaseURL string, notify func()) (listened bool, err error) {
+	conn, err := pgx.Connect(ctx, databaseURL)
 	if err != nil {
-		return ErrNotFound
-	}
-
-	res := s.db.Delete(&qrCodeRow{}, "id = ?", uid)
-	if res.Error != nil {
-		return res.Error
-	}
-	if res.RowsAffected == 0 {
-		return ErrNotFound
+		return false, err
 	}
-	return nil
-}
-
-func (s *PostgresStore) CountTotal() (int, error) {
-	var n int64
-	if err := s.db.Model(&qrCodeRow{}).Count(&n).Error; err != nil {
-		return 0, err
+	defer conn.Close(context.WithoutCancel(ctx))
+	if _, err := conn.Exec(ctx, "LISTEN "+EventsChannel); err != nil {
+		return false, err
 	}
-	return int(n), nil
-}
-
-func (s *PostgresStore) CountActive() (int, error) {
-	var n int64
-	if err := s.db.Model(&qrCodeRow{}).Where("active = ?", true).Count(&n).Error; err != nil {
-		return 0, err
-	}
-	return int(n), nil
-}
-
-func (s *PostgresStore) GetSettings() (model.UserSettings, error) {
-	var row settingsRow
-	err := s.db.FirstOrCreate(&row, settingsRow{ID: 1}).Error
-	if err != nil {
-		return model.UserSettings{}, err
+	notify()
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return true, err
+		}
+		notify()
 	}
-	return model.UserSettings{DefaultRedirectURL: row.DefaultRedirectURL}, nil
-}
-
-func (s *PostgresStore) UpdateSettings(settings model.UserSettings) error {
-	return s.db.Model(&settingsRow{}).Where("id = ?", 1).Update("default_redirect_url", settings.DefaultRedirectURL).Error
 }
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SQLiteStore keeps everything in one SQLite file, for single-node installs
+// that want data to survive a restart without running Postgres. It uses a
+// pure-Go driver, so the binary still builds without cgo.
+type SQLiteStore struct {
+	gormStore
+}
+
+// DBStore is a Store backed by a database connection.
+type DBStore interface {
+	Store
+	Close() error
+}
+
+// Open connects to the database databaseURL names: SQLite for sqlite:// URLs,
+// Postgres for anything else.
+func Open(ctx context.Context, databaseURL string) (DBStore, error) {
+	if IsSQLiteURL(databaseURL) {
+		s, err := NewSQLiteStore(ctx, databaseURL)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	s, err := NewPostgresStore(ctx, databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// IsSQLiteURL reports whether databaseURL selects SQLiteStore rather than
+// PostgresStore.
+func IsSQLiteURL(databaseURL string) bool {
+	return strings.HasPrefix(databaseURL, "sqlite:")
+}
+
+// BackendName names the backend Open picks for databaseURL, for logs.
+func BackendName(databaseURL string) string {
+	if IsSQLiteURL(databaseURL) {
+		return "sqlite"
+	}
+	return "postgres"
+}
+
+// NewSQLiteStore opens (creating if needed) the database file named by
+// databaseURL: sqlite:///data/qr.db for an absolute path, sqlite://qr.db for
+// one relative to the working directory.
+func NewSQLiteStore(ctx context.Context, databaseURL string) (*SQLiteStore, error) {
+	dsn, err := sqliteDSN(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	gdb, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		TranslateError: true,
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+
+	s := &SQLiteStore{gormStore{db: gdb}}
+	if err := s.db.WithContext(ctx).AutoMigrate(&qrCodeRow{}, &settingsRow{}, &templateRow{}, &eventRow{}); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// sqliteDSN turns a sqlite:// URL into the driver's file name. WAL lets
+// readers run alongside the single writer; busy_timeout makes a writer wait
+// for the lock instead of failing; and immediate transactions take the write
+// lock up front, so two transactions can't deadlock upgrading from a read.
+func sqliteDSN(databaseURL string) (string, error) {
+	path, ok := strings.CutPrefix(databaseURL, "sqlite://")
+	if !ok || path == "" || strings.ContainsAny(path, "?#") {
+		return "", errors.New("sqlite URL must look like sqlite:///path/to/file.db")
+	}
+	q := url.Values{}
+	q.Add("_pragma", "journal_mode(WAL)")
+	q.Add("_pragma", "busy_timeout(5000)")
+	q.Add("_pragma", "foreign_keys(1)")
+	q.Set("_txlock", "immediate")
+	return "file:" + path + "?" + q.Encode(), nil
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func newTestSQLiteStore(t *testing.T, path string) *SQLiteStore {
+	t.Helper()
+	s, err := NewSQLiteStore(context.Background(), "sqlite://"+path)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestSQLiteStore(t *testing.T) {
+	runStoreSuite(t, func(t *testing.T) Store {
+		return newTestSQLiteStore(t, filepath.Join(t.TempDir(), "qr.db"))
+	})
+}
+
+func TestSQLiteStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "qr.db")
+	s := newTestSQLiteStore(t, path)
+	created, err := s.Create(context.Background(), CreateInput{Label: "Menu", URL: "https://example.com/menu"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	var mode string
+	if err := s.db.Raw("PRAGMA journal_mode").Scan(&mode).Error; err != nil || mode != "wal" {
+		t.Fatalf("expected WAL mode, got %q %v", mode, err)
+	}
+	_ = s.Close()
+
+	got, err := newTestSQLiteStore(t, path).Get(context.Background(), created.ID)
+	if err != nil || got.Label != "Menu" {
+		t.Fatalf("after reopen: %+v %v", got, err)
+	}
+}
+
+func TestSQLiteDSN(t *testing.T) {
+	for _, bad := range []string{"sqlite:qr.db", "sqlite://", "sqlite:///data/qr.db?mode=ro"} {
+		if _, err := sqliteDSN(bad); err == nil {
+			t.Fatalf("%q: expected an error", bad)
+		}
+	}
+	if !IsSQLiteURL("sqlite:///data/qr.db") || IsSQLiteURL("postgres://localhost/qr") {
+		t.Fatal("IsSQLiteURL misclassified a URL")
+	}
+}
@@ -1,36 +1,286 @@
 package store
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"qr-service/internal/model"
 )
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("already exists")
+)
 
+// Store methods stop when ctx ends and then return ctx.Err(), so callers can
+// tell a timeout or cancellation apart from a failed query with errors.Is.
 type Store interface {
-	List() []model.QrCode
-	Get(id string) (model.QrCode, error)
-	Create(input CreateInput) (model.QrCode, error)
-	Update(id string, input UpdateInput) (model.QrCode, error)
-	Delete(id string) error
+	List(ctx context.Context) ([]model.QrCode, error)
+	// ForEach calls fn for every code in workspaceID ("" for codes outside
+	// any workspace) without loading them all at once, stopping at the first
+	// error fn returns.
+	ForEach(ctx context.Context, workspaceID string, fn func(model.QrCode) error) error
+	Get(ctx context.Context, id string) (model.QrCode, error)
+	Create(ctx context.Context, input CreateInput) (model.QrCode, error)
+	// CreateBatch creates every code or none of them.
+	CreateBatch(ctx context.Context, inputs []CreateInput) ([]model.QrCode, error)
+	Update(ctx context.Context, id string, input UpdateInput) (model.QrCode, error)
+	Delete(ctx context.Context, id string) error
+	// ApplyBatch applies every change or none of them. It returns ErrNotFound,
+	// and changes nothing, if any of the codes no longer exists.
+	ApplyBatch(ctx context.Context, changes []BatchChange) error
+
+	// CountTotal and CountActive count the codes ownerID owns, in any
+	// workspace; quotas are checked against them.
+	CountTotal(ctx context.Context, ownerID string) (int, error)
+	CountActive(ctx context.Context, ownerID string) (int, error)
+
+	// Settings belong to one owner ("" for codes made without one). An owner
+	// who never saved any gets the zero value.
+	GetSettings(ctx context.Context, ownerID string) (model.UserSettings, error)
+	UpdateSettings(ctx context.Context, ownerID string, settings model.UserSettings) error
 
-	CountTotal() (int, error)
-	CountActive() (int, error)
+	// Templates
+	ListTemplates(ctx context.Context) ([]model.QrTemplate, error)
+	GetTemplate(ctx context.Context, id string) (model.QrTemplate, error)
+	CreateTemplate(ctx context.Context, t model.QrTemplate) (model.QrTemplate, error)
+	// UpdateTemplate replaces every field except ID, OwnerID, WorkspaceID and CreatedAt.
+	UpdateTemplate(ctx context.Context, id string, t model.QrTemplate) (model.QrTemplate, error)
+	DeleteTemplate(ctx context.Context, id string) error
 
-	// Settings
-	GetSettings() (model.UserSettings, error)
-	UpdateSettings(settings model.UserSettings) error
+	// Change feed. Creating, updating and deleting codes and updating
+	// settings record an event in the same transaction as the change.
+
+	// EventsAfter returns up to limit events with IDs above after, oldest
+	// first. IDs are handed out in commit order, so a subscriber resuming
+	// after the last ID it saw misses nothing.
+	EventsAfter(ctx context.Context, after int64, limit int) ([]model.ChangeEvent, error)
+	// PurgeEvents deletes events recorded before cutoff. IDs are never
+	// reused, so a subscriber whose cursor was purged resumes at the oldest
+	// event left.
+	PurgeEvents(ctx context.Context, cutoff time.Time) error
 }
 
 type CreateInput struct {
-	Label  string
-	URL    string
-	Active *bool
+	// ID and CreatedAt are normally assigned by the store; seeding sets them
+	// so fixtures load deterministically.
+	ID        string
+	CreatedAt time.Time
+	OwnerID   string
+	// WorkspaceID shares the code with a team workspace.
+	WorkspaceID string
+
+	Label    string
+	URL      string
+	Active   *bool
+	Campaign string
+	Tags     []string
+	Utm      *model.UtmTemplate
+
+	DestinationType string
+	AppLink         *model.AppLink
+
+	FallbackURL string
+	LandingPage *model.LandingPage
+
+	Symbology    string
+	Style        *model.QrStyle
+	Scannability *model.ScanReport
+	Frame        *model.QrFrame
 }
 
 type UpdateInput struct {
-	Label  *string
-	URL    *string
-	Active *bool
+	Label    *string
+	URL      *string
+	Active   *bool
+	Campaign *string
+	// Tags replaces the code's tags; an empty list clears them.
+	Tags *[]string
+	// Utm replaces the code's template; an empty template clears it.
+	Utm *model.UtmTemplate
+
+	DestinationType *string
+	// AppLink replaces the code's app routes; an empty value clears them.
+	AppLink *model.AppLink
+
+	// FallbackURL replaces the inactive-code fallback; "" clears it.
+	FallbackURL *string
+	// LandingPage replaces the inactive-code page; an empty value clears it.
+	LandingPage *model.LandingPage
+
+	Symbology *string
+	// Style replaces the code's style; an empty style clears it, along with
+	// the scannability report.
+	Style *model.QrStyle
+	// Scannability replaces the report for the new Style or Symbology.
+	Scannability *model.ScanReport
+	// Frame replaces the code's frame; an empty frame clears it.
+	Frame *model.QrFrame
+
+	// OwnerID reassigns the code; only admins set it.
+	OwnerID *string
+	// DisabledReason sets (non-empty) or lifts (empty) an admin disable and
+	// stamps DisabledAt accordingly. Setting it does not change Active.
+	DisabledReason *string
+}
+
+// BatchChange is one code's part of an ApplyBatch call: Delete removes the
+// code, otherwise Update is applied to it.
+type BatchChange struct {
+	ID     string
+	Update UpdateInput
+	Delete bool
+}
+
+// applyUpdate copies the fields set in input onto q.
+func applyUpdate(q *model.QrCode, input UpdateInput) {
+	if input.Label != nil {
+		q.Label = *input.Label
+	}
+	if input.URL != nil {
+		q.URL = *input.URL
+	}
+	if input.Active != nil {
+		q.Active = *input.Active
+	}
+	if input.Campaign != nil {
+		q.Campaign = *input.Campaign
+	}
+	if input.Tags != nil {
+		q.Tags = normalizeTags(*input.Tags)
+	}
+	if input.Utm != nil {
+		q.Utm = normalizeUtm(input.Utm)
+	}
+	if input.DestinationType != nil {
+		q.DestinationType = normalizeDestinationType(*input.DestinationType)
+	}
+	if input.AppLink != nil {
+		q.AppLink = normalizeAppLink(input.AppLink)
+	}
+	if input.FallbackURL != nil {
+		q.FallbackURL = *input.FallbackURL
+	}
+	if input.LandingPage != nil {
+		q.LandingPage = normalizeLandingPage(input.LandingPage)
+	}
+	if input.Symbology != nil {
+		q.Symbology = normalizeSymbology(*input.Symbology)
+	}
+	if input.Style != nil {
+		q.Style = normalizeStyle(input.Style)
+		q.Scannability = nil
+	}
+	if input.Scannability != nil && q.Style != nil {
+		q.Scannability = normalizeScanReport(input.Scannability)
+	}
+	if input.Frame != nil {
+		q.Frame = normalizeFrame(input.Frame)
+	}
+	if input.OwnerID != nil {
+		q.OwnerID = *input.OwnerID
+	}
+	applyModeration(q, input.DisabledReason)
+	if q.Label == "" {
+		q.Label = "Untitled"
+	}
+}
+
+// normalizeTags copies tags so callers can't mutate stored state, and maps an
+// empty list to nil.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	return append([]string(nil), tags...)
+}
+
+// normalizeUtm copies the template so callers can't mutate stored state, and
+// maps an empty template to nil.
+func normalizeUtm(t *model.UtmTemplate) *model.UtmTemplate {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	v := *t
+	return &v
+}
+
+func normalizeAppLink(a *model.AppLink) *model.AppLink {
+	if a == nil || a.IsZero() {
+		return nil
+	}
+	v := *a
+	return &v
+}
+
+func normalizeLandingPage(p *model.LandingPage) *model.LandingPage {
+	if p == nil || p.IsZero() {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func normalizeStyle(s *model.QrStyle) *model.QrStyle {
+	if s == nil || s.IsZero() {
+		return nil
+	}
+	v := s.Clone()
+	return &v
+}
+
+func normalizeFrame(f *model.QrFrame) *model.QrFrame {
+	if f == nil || f.IsZero() {
+		return nil
+	}
+	v := *f
+	return &v
+}
+
+func normalizeScanReport(r *model.ScanReport) *model.ScanReport {
+	if r == nil {
+		return nil
+	}
+	v := *r
+	v.Warnings = append([]model.ScanWarning(nil), r.Warnings...)
+	return &v
+}
+
+// normalizeTemplate copies t's pointer fields and fills defaults, the same way
+// codes are normalized on write.
+func normalizeTemplate(t model.QrTemplate) model.QrTemplate {
+	t.Tags = normalizeTags(t.Tags)
+	t.Style = normalizeStyle(t.Style)
+	t.Utm = normalizeUtm(t.Utm)
+	t.AppLink = normalizeAppLink(t.AppLink)
+	t.DestinationType = normalizeDestinationType(t.DestinationType)
+	return t
+}
+
+func normalizeDestinationType(v string) string {
+	if v == "" {
+		return model.DestinationURL
+	}
+	return v
+}
+
+func normalizeSymbology(v string) string {
+	if v == "" {
+		return model.SymbologyQR
+	}
+	return v
+}
+
+// applyModeration updates the disable reason and timestamp from input.
+func applyModeration(q *model.QrCode, reason *string) {
+	if reason == nil {
+		return
+	}
+	q.DisabledReason = *reason
+	if q.DisabledReason == "" {
+		q.DisabledAt = time.Time{}
+	} else if q.DisabledAt.IsZero() {
+		q.DisabledAt = time.Now().UTC()
+	}
 }
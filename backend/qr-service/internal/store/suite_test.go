@@ -0,0 +1,462 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"qr-service/internal/model"
+)
+
+// runStoreSuite checks the behavior every Store implementation must share.
+// newStore returns an empty store for each subtest.
+func runStoreSuite(t *testing.T, newStore func(t *testing.T) Store) {
+	t.Run("CRUD", func(t *testing.T) { testCRUD(t, newStore(t)) })
+	t.Run("FieldsRoundTrip", func(t *testing.T) { testFieldsRoundTrip(t, newStore(t)) })
+	t.Run("ListAndForEach", func(t *testing.T) { testListAndForEach(t, newStore(t)) })
+	t.Run("ApplyBatchIsAllOrNothing", func(t *testing.T) { testApplyBatchIsAllOrNothing(t, newStore(t)) })
+	t.Run("CreateBatchIsAllOrNothing", func(t *testing.T) { testCreateBatchIsAllOrNothing(t, newStore(t)) })
+	t.Run("CountsByOwner", func(t *testing.T) { testCountsByOwner(t, newStore(t)) })
+	t.Run("Settings", func(t *testing.T) { testSettings(t, newStore(t)) })
+	t.Run("Templates", func(t *testing.T) { testTemplates(t, newStore(t)) })
+	t.Run("EndedContext", func(t *testing.T) { testEndedContext(t, newStore(t)) })
+	t.Run("ChangeFeed", func(t *testing.T) { testChangeFeed(t, newStore(t)) })
+	t.Run("PurgeEvents", func(t *testing.T) { testPurgeEvents(t, newStore(t)) })
+}
+
+func testCRUD(t *testing.T, s Store) {
+	created, err := s.Create(context.Background(), CreateInput{Label: "A", URL: "https://example.com"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.ID == "" {
+		t.Fatalf("expected id")
+	}
+	if created.URL != "https://example.com" {
+		t.Fatalf("expected url")
+	}
+	if !created.Active {
+		t.Fatalf("expected active=true by default")
+	}
+
+	got, err := s.Get(context.Background(), created.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.ID != created.ID {
+		t.Fatalf("expected same id")
+	}
+
+	newLabel := "B"
+	updated, err := s.Update(context.Background(), created.ID, UpdateInput{Label: &newLabel})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Label != "B" {
+		t.Fatalf("expected updated label")
+	}
+	if !updated.Active {
+		t.Fatalf("expected active to remain true")
+	}
+
+	deactivate := false
+	updated2, err := s.Update(context.Background(), created.ID, UpdateInput{Active: &deactivate})
+	if err != nil {
+		t.Fatalf("update active: %v", err)
+	}
+	if updated2.Active {
+		t.Fatalf("expected active=false after update")
+	}
+	if n, _ := s.CountActive(context.Background(), ""); n != 0 {
+		t.Fatalf("expected 0 active, got %d", n)
+	}
+
+	if list, err := s.List(context.Background()); err != nil || len(list) != 1 {
+		t.Fatalf("expected list size 1, got %d %v", len(list), err)
+	}
+
+	if err := s.Delete(context.Background(), created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := s.Get(context.Background(), created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if err := s.Delete(context.Background(), created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("delete again: expected not found, got %v", err)
+	}
+	if _, err := s.Update(context.Background(), "missing", UpdateInput{Label: &newLabel}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("update missing: expected not found, got %v", err)
+	}
+}
+
+func testFieldsRoundTrip(t *testing.T, s Store) {
+	created, err := s.Create(context.Background(), CreateInput{
+		ID:              "9b2f8a4e-6c1d-4f3a-8e5b-2d7c9a1b3e4f",
+		CreatedAt:       time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		OwnerID:         "u1",
+		WorkspaceID:     "ws1",
+		URL:             "https://example.com/app",
+		Campaign:        "spring",
+		Tags:            []string{"print", "menu"},
+		Utm:             &model.UtmTemplate{Source: "qr"},
+		DestinationType: model.DestinationAppLink,
+		AppLink:         &model.AppLink{IOSURL: "myapp://open"},
+		FallbackURL:     "https://example.com/closed",
+		LandingPage:     &model.LandingPage{Title: "Closed"},
+		Symbology:       model.SymbologyAztec,
+		Style:           &model.QrStyle{Foreground: "#1a237e", ErrorCorrection: model.ErrorCorrectionH},
+		Scannability:    &model.ScanReport{Score: 90, Readable: true, Warnings: []model.ScanWarning{{Code: "small_print"}}},
+		Frame:           &model.QrFrame{Border: model.FrameBorderBanner, Caption: "Scan me", ShowURL: true},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	got, err := s.Get(context.Background(), created.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Label != "Untitled" || got.OwnerID != "u1" || got.WorkspaceID != "ws1" || got.Campaign != "spring" ||
+		len(got.Tags) != 2 || got.Tags[1] != "menu" || got.Utm == nil || got.Utm.Source != "qr" ||
+		got.DestinationType != model.DestinationAppLink || got.AppLink == nil || got.AppLink.IOSURL != "myapp://open" ||
+		got.FallbackURL != "https://example.com/closed" || got.LandingPage == nil || got.LandingPage.Title != "Closed" || got.Symbology != model.SymbologyAztec ||
+		got.Style == nil || got.Style.Foreground != "#1a237e" || got.Scannability == nil || got.Scannability.Score != 90 || len(got.Scannability.Warnings) != 1 ||
+		got.Frame == nil || got.Frame.Caption != "Scan me" || !got.Frame.ShowURL ||
+		!got.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("round trip lost fields: %+v", got)
+	}
+
+	// Empty values clear, and an empty symbology means QR; moderation stamps
+	// the time.
+	reason, noTags, blank := "spam", []string{}, ""
+	got, err = s.Update(context.Background(), created.ID, UpdateInput{
+		Tags: &noTags, Utm: &model.UtmTemplate{}, FallbackURL: &blank, LandingPage: &model.LandingPage{}, Style: &model.QrStyle{}, Frame: &model.QrFrame{}, Symbology: &blank, DisabledReason: &reason,
+	})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if got, err = s.Get(context.Background(), created.ID); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Tags != nil || got.Utm != nil || got.FallbackURL != "" || got.LandingPage != nil || got.Style != nil || got.Scannability != nil || got.Symbology != model.SymbologyQR || got.Frame != nil || got.DisabledReason != "spam" || got.DisabledAt.IsZero() {
+		t.Fatalf("clear: unexpected %+v", got)
+	}
+
+	if _, err := s.Create(context.Background(), CreateInput{ID: created.ID, URL: "https://example.com"}); !errors.Is(err, ErrConflict) {
+		t.Fatalf("duplicate id: expected ErrConflict, got %v", err)
+	}
+}
+
+func testListAndForEach(t *testing.T, s Store) {
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i, ws := range []string{"", "ws1", "", ""} {
+		if _, err := s.Create(context.Background(), CreateInput{URL: "https://example.com", WorkspaceID: ws, CreatedAt: base.Add(time.Duration(i) * time.Hour)}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	list, err := s.List(context.Background())
+	if err != nil || len(list) != 4 || !list[0].CreatedAt.After(list[3].CreatedAt) {
+		t.Fatalf("expected 4 codes newest first, got %+v %v", list, err)
+	}
+
+	var personal int
+	if err := s.ForEach(context.Background(), "", func(q model.QrCode) error {
+		if q.WorkspaceID != "" {
+			t.Fatalf("ForEach leaked %+v", q)
+		}
+		personal++
+		return nil
+	}); err != nil || personal != 3 {
+		t.Fatalf("ForEach: %d codes, err %v", personal, err)
+	}
+	stop := errors.New("stop")
+	calls := 0
+	if err := s.ForEach(context.Background(), "", func(model.QrCode) error { calls++; return stop }); !errors.Is(err, stop) || calls != 1 {
+		t.Fatalf("ForEach: expected to stop after 1 call with fn's error, got %d %v", calls, err)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 4 {
+		t.Fatalf("expected 4 total, got %d", n)
+	}
+}
+
+func testApplyBatchIsAllOrNothing(t *testing.T, s Store) {
+	a, _ := s.Create(context.Background(), CreateInput{URL: "https://example.com"})
+	b, _ := s.Create(context.Background(), CreateInput{URL: "https://example.com"})
+
+	off := false
+	err := s.ApplyBatch(context.Background(), []BatchChange{{ID: a.ID, Update: UpdateInput{Active: &off}}, {ID: "missing", Delete: true}})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if got, _ := s.Get(context.Background(), a.ID); !got.Active {
+		t.Fatalf("expected no change after a failed batch")
+	}
+
+	tags := []string{"spring"}
+	if err := s.ApplyBatch(context.Background(), []BatchChange{{ID: a.ID, Update: UpdateInput{Active: &off, Tags: &tags}}, {ID: b.ID, Delete: true}}); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if got, _ := s.Get(context.Background(), a.ID); got.Active || len(got.Tags) != 1 {
+		t.Fatalf("expected update applied, got %+v", got)
+	}
+	if _, err := s.Get(context.Background(), b.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected b deleted, got %v", err)
+	}
+}
+
+func testCreateBatchIsAllOrNothing(t *testing.T, s Store) {
+	existing, _ := s.Create(context.Background(), CreateInput{URL: "https://example.com"})
+
+	_, err := s.CreateBatch(context.Background(), []CreateInput{{URL: "https://example.com/a"}, {ID: existing.ID, URL: "https://example.com/b"}})
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 1 {
+		t.Fatalf("expected no codes created, got %d total", n)
+	}
+
+	created, err := s.CreateBatch(context.Background(), []CreateInput{{URL: "https://example.com/a"}, {URL: "https://example.com/b"}})
+	if err != nil || len(created) != 2 {
+		t.Fatalf("create batch: %v %+v", err, created)
+	}
+	if n, _ := s.CountTotal(context.Background(), ""); n != 3 {
+		t.Fatalf("expected 3 codes, got %d", n)
+	}
+}
+
+func testCountsByOwner(t *testing.T, s Store) {
+	off := false
+	for _, in := range []CreateInput{
+		{OwnerID: "alice", URL: "https://example.com"},
+		{OwnerID: "alice", WorkspaceID: "ws1", URL: "https://example.com", Active: &off},
+		{OwnerID: "bob", URL: "https://example.com"},
+		{URL: "https://example.com"},
+	} {
+		if _, err := s.Create(context.Background(), in); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	for owner, want := range map[string][2]int{"alice": {2, 1}, "bob": {1, 1}, "": {1, 1}, "carol": {0, 0}} {
+		total, err := s.CountTotal(context.Background(), owner)
+		if err != nil {
+			t.Fatalf("count total: %v", err)
+		}
+		active, err := s.CountActive(context.Background(), owner)
+		if err != nil {
+			t.Fatalf("count active: %v", err)
+		}
+		if total != want[0] || active != want[1] {
+			t.Fatalf("%q: expected %d total %d active, got %d %d", owner, want[0], want[1], total, active)
+		}
+	}
+}
+
+func testSettings(t *testing.T, s Store) {
+	// Settings written before they're ever read must stick.
+	want := model.UserSettings{
+		DefaultRedirectURL: "https://example.com",
+		CampaignUtm:        map[string]model.UtmTemplate{"spring": {Medium: "print"}},
+	}
+	if err := s.UpdateSettings(context.Background(), "alice", want); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	got, err := s.GetSettings(context.Background(), "alice")
+	if err != nil || got.DefaultRedirectURL != want.DefaultRedirectURL || got.CampaignUtm["spring"].Medium != "print" {
+		t.Fatalf("unexpected settings %+v %v", got, err)
+	}
+
+	// Each owner has their own; saving again replaces them.
+	if got, err := s.GetSettings(context.Background(), "bob"); err != nil || got.DefaultRedirectURL != "" || got.CampaignUtm != nil {
+		t.Fatalf("expected no settings for bob, got %+v %v", got, err)
+	}
+	if err := s.UpdateSettings(context.Background(), "bob", model.UserSettings{DefaultRedirectURL: "https://example.com/bob"}); err != nil {
+		t.Fatalf("update bob: %v", err)
+	}
+	if err := s.UpdateSettings(context.Background(), "alice", model.UserSettings{DefaultRedirectURL: "https://example.com/alice"}); err != nil {
+		t.Fatalf("update again: %v", err)
+	}
+	got, _ = s.GetSettings(context.Background(), "alice")
+	bob, _ := s.GetSettings(context.Background(), "bob")
+	if got.DefaultRedirectURL != "https://example.com/alice" || len(got.CampaignUtm) != 0 || bob.DefaultRedirectURL != "https://example.com/bob" {
+		t.Fatalf("unexpected settings alice=%+v bob=%+v", got, bob)
+	}
+}
+
+func testTemplates(t *testing.T, s Store) {
+	created, err := s.CreateTemplate(context.Background(), model.QrTemplate{Name: "Doors", URLPattern: "https://example.com/{city}", Active: true, Tags: []string{"doors"}, Style: &model.QrStyle{Foreground: "#1a237e"}, Utm: &model.UtmTemplate{}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.ID == "" || created.Utm != nil || created.DestinationType != model.DestinationURL {
+		t.Fatalf("expected a normalized template, got %+v", created)
+	}
+	if got, err := s.GetTemplate(context.Background(), created.ID); err != nil || len(got.Tags) != 1 || got.Style == nil || got.Style.Foreground != "#1a237e" {
+		t.Fatalf("expected tags and style stored, got %+v %v", got, err)
+	}
+	updated, err := s.UpdateTemplate(context.Background(), created.ID, model.QrTemplate{Name: "Gates", URLPattern: "https://example.com/{city}/gate", OwnerID: "someone-else"})
+	if err != nil || updated.Name != "Gates" || updated.OwnerID != created.OwnerID {
+		t.Fatalf("update: %+v %v", updated, err)
+	}
+	if got, err := s.GetTemplate(context.Background(), created.ID); err != nil || got.URLPattern != "https://example.com/{city}/gate" || got.Active || got.Tags != nil || got.Style != nil {
+		t.Fatalf("get: %+v %v", got, err)
+	}
+	off, err := s.CreateTemplate(context.Background(), model.QrTemplate{Name: "Off", URLPattern: "https://example.com/{city}"})
+	if err != nil {
+		t.Fatalf("create inactive: %v", err)
+	}
+	if got, err := s.GetTemplate(context.Background(), off.ID); err != nil || got.Active {
+		t.Fatalf("expected an inactive template, got %+v %v", got, err)
+	}
+	if err := s.DeleteTemplate(context.Background(), off.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if list, err := s.ListTemplates(context.Background()); err != nil || len(list) != 1 {
+		t.Fatalf("list: %+v %v", list, err)
+	}
+	if err := s.DeleteTemplate(context.Background(), created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := s.GetTemplate(context.Background(), created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := s.UpdateTemplate(context.Background(), created.ID, model.QrTemplate{Name: "x"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("update missing: expected not found, got %v", err)
+	}
+}
+
+func testEndedContext(t *testing.T, s Store) {
+	created, err := s.Create(context.Background(), CreateInput{URL: "https://example.com"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.Get(ctx, created.ID); !errors.Is(err, context.Canceled) {
+		t.Fatalf("get: expected context.Canceled, got %v", err)
+	}
+	if _, err := s.List(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("list: expected context.Canceled, got %v", err)
+	}
+	if _, err := s.Create(ctx, CreateInput{URL: "https://example.com"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("create: expected context.Canceled, got %v", err)
+	}
+	err = s.ForEach(ctx, "", func(model.QrCode) error {
+		t.Fatalf("ForEach called fn after its context ended")
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ForEach: expected context.Canceled, got %v", err)
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if err := s.UpdateSettings(expired, "", model.UserSettings{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("update settings: expected context.DeadlineExceeded, got %v", err)
+	}
+
+	// Nothing was written by the calls that failed.
+	if n, err := s.CountTotal(context.Background(), ""); err != nil || n != 1 {
+		t.Fatalf("expected 1 code, got %d %v", n, err)
+	}
+}
+
+func testChangeFeed(t *testing.T, s Store) {
+	ctx := context.Background()
+	a, err := s.Create(ctx, CreateInput{URL: "https://example.com/a", OwnerID: "alice", WorkspaceID: "ws"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	batch, err := s.CreateBatch(ctx, []CreateInput{{URL: "https://example.com/b"}, {URL: "https://example.com/c"}})
+	if err != nil {
+		t.Fatalf("create batch: %v", err)
+	}
+	label := "A"
+	if _, err := s.Update(ctx, a.ID, UpdateInput{Label: &label}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := s.ApplyBatch(ctx, []BatchChange{{ID: batch[0].ID, Update: UpdateInput{Label: &label}}, {ID: batch[1].ID, Delete: true}}); err != nil {
+		t.Fatalf("apply batch: %v", err)
+	}
+	if err := s.Delete(ctx, a.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := s.UpdateSettings(ctx, "alice", model.UserSettings{}); err != nil {
+		t.Fatalf("settings: %v", err)
+	}
+
+	// Failed writes record nothing.
+	_ = s.Delete(ctx, a.ID)
+	_ = s.ApplyBatch(ctx, []BatchChange{{ID: batch[0].ID, Delete: true}, {ID: a.ID, Delete: true}})
+
+	want := []struct{ typ, id string }{
+		{model.EventQrCodeCreated, a.ID},
+		{model.EventQrCodeCreated, batch[0].ID},
+		{model.EventQrCodeCreated, batch[1].ID},
+		{model.EventQrCodeUpdated, a.ID},
+		{model.EventQrCodeUpdated, batch[0].ID},
+		{model.EventQrCodeDeleted, batch[1].ID},
+		{model.EventQrCodeDeleted, a.ID},
+		{model.EventSettingsUpdated, ""},
+	}
+	events, err := s.EventsAfter(ctx, 0, 100)
+	if err != nil || len(events) != len(want) {
+		t.Fatalf("expected %d events, got %+v %v", len(want), events, err)
+	}
+	for i, e := range events {
+		if e.Type != want[i].typ || e.QrCodeID != want[i].id || e.At.IsZero() {
+			t.Fatalf("event %d: expected %s %s, got %+v", i, want[i].typ, want[i].id, e)
+		}
+		if i > 0 && e.ID <= events[i-1].ID {
+			t.Fatalf("event IDs not increasing: %d after %d", e.ID, events[i-1].ID)
+		}
+	}
+	if e := events[7]; e.OwnerID != "alice" {
+		t.Fatalf("settings event lost its owner: %+v", e)
+	}
+	if e := events[6]; e.OwnerID != "alice" || e.WorkspaceID != "ws" {
+		t.Fatalf("delete event lost the code's owner: %+v", e)
+	}
+
+	// Paging resumes after the cursor.
+	page, err := s.EventsAfter(ctx, events[2].ID, 2)
+	if err != nil || len(page) != 2 || page[0].ID != events[3].ID {
+		t.Fatalf("unexpected page %+v %v", page, err)
+	}
+	if rest, err := s.EventsAfter(ctx, events[len(events)-1].ID, 100); err != nil || len(rest) != 0 {
+		t.Fatalf("expected nothing after the last event, got %+v %v", rest, err)
+	}
+}
+
+func testPurgeEvents(t *testing.T, s Store) {
+	ctx := context.Background()
+	for _, url := range []string{"https://example.com/a", "https://example.com/b"} {
+		if _, err := s.Create(ctx, CreateInput{URL: url}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	old, err := s.EventsAfter(ctx, 0, 100)
+	if err != nil || len(old) != 2 {
+		t.Fatalf("expected 2 events, got %+v %v", old, err)
+	}
+	if err := s.PurgeEvents(ctx, old[0].At.Add(-time.Minute)); err != nil {
+		t.Fatalf("purge nothing: %v", err)
+	}
+	if events, _ := s.EventsAfter(ctx, 0, 100); len(events) != 2 {
+		t.Fatalf("expected both events kept, got %+v", events)
+	}
+
+	if err := s.PurgeEvents(ctx, time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	c, err := s.Create(ctx, CreateInput{URL: "https://example.com/c"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	// A cursor from before the purge resumes at what's left, and IDs keep
+	// increasing.
+	events, err := s.EventsAfter(ctx, 0, 100)
+	if err != nil || len(events) != 1 || events[0].QrCodeID != c.ID || events[0].ID <= old[1].ID {
+		t.Fatalf("expected only the new event after %d, got %+v %v", old[1].ID, events, err)
+	}
+}
@@ -0,0 +1,66 @@
+// Package userplans reads owners' plans (userType) from user-service, so
+// admin usage reports compare each owner against their own quota.
+package userplans
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrUnknownUser means user-service has no such user.
+var ErrUnknownUser = errors.New("unknown user")
+
+type Client struct {
+	BaseURL string
+	// AdminKey is user-service's ADMIN_API_KEY, which lets services read
+	// any user.
+	AdminKey string
+	HTTP     *http.Client
+}
+
+func NewClient(baseURL, adminKey string) *Client {
+	return &Client{
+		BaseURL:  strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		AdminKey: adminKey,
+		HTTP:     &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// UserType returns userID's plan as user-service reports it; "" if the user
+// has none set.
+func (c *Client) UserType(ctx context.Context, userID string) (string, error) {
+	if userID == "" {
+		return "", ErrUnknownUser
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/api/users/"+url.PathEscape(userID), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Admin-Key", c.AdminKey)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", ErrUnknownUser
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("user-service returned %d", resp.StatusCode)
+	}
+	var out struct {
+		UserType string `json:"userType"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.UserType, nil
+}
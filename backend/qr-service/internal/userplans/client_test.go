@@ -0,0 +1,38 @@
+package userplans
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_UserType(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Admin-Key") != "k" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/users/alice":
+			_, _ = w.Write([]byte(`{"id":"alice","email":"alice@example.com","userType":"basic"}`))
+		case "/api/users/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient(ts.URL+"/", "k")
+	if got, err := c.UserType(context.Background(), "alice"); err != nil || got != "basic" {
+		t.Fatalf("expected basic, got %q %v", got, err)
+	}
+	if _, err := c.UserType(context.Background(), "mallory"); !errors.Is(err, ErrUnknownUser) {
+		t.Fatalf("expected ErrUnknownUser, got %v", err)
+	}
+	if _, err := c.UserType(context.Background(), "broken"); err == nil || errors.Is(err, ErrUnknownUser) {
+		t.Fatalf("expected a lookup error, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package apitoken
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func fakeUserService(t *testing.T, calls *int) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		if r.URL.Path != "/api/tokens/verify" || r.Header.Get("X-Admin-Key") != "k" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			return
+		}
+		var in struct {
+			Token string `json:"token"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		if in.Token != "qrd_good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"tokenId":"t1","userId":"alice","userType":"basic","scopes":["qr:read"]}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestClient_VerifyCachesValidTokens(t *testing.T) {
+	var calls int
+	ts := fakeUserService(t, &calls)
+	c := NewClient(ts.URL, "k")
+
+	for i := 0; i < 3; i++ {
+		id, err := c.Verify(context.Background(), "qrd_good")
+		if err != nil || id.UserID != "alice" || !id.HasScope(ScopeQrRead) || id.HasScope(ScopeQrWrite) {
+			t.Fatalf("verify: %+v %v", id, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected one lookup, got %d", calls)
+	}
+	if _, err := c.Verify(context.Background(), "qrd_bad"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+
+	wrongKey := NewClient(ts.URL, "nope")
+	if _, err := wrongKey.Verify(context.Background(), "qrd_good"); err == nil || errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("a rejected admin key must not look like a bad token, got %v", err)
+	}
+}
+
+type staticVerifier map[string]Identity
+
+func (s staticVerifier) Verify(_ context.Context, token string) (Identity, error) {
+	if token == "down" {
+		return Identity{}, errors.New("user-service down")
+	}
+	id, ok := s[token]
+	if !ok {
+		return Identity{}, ErrInvalidToken
+	}
+	return id, nil
+}
+
+func TestMiddleware(t *testing.T) {
+	v := staticVerifier{"reader": {UserID: "alice", UserType: "basic", Scopes: []string{ScopeQrRead}}}
+	sessions := staticVerifier{"signed-in": {UserID: "bob", UserType: "enterprise"}}
+	var seen *http.Request
+	h := Middleware(v, sessions, func(r *http.Request) string {
+		if r.Method == http.MethodGet {
+			return ScopeQrRead
+		}
+		return ScopeQrWrite
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serve := func(method, auth string, session ...string) int {
+		seen = nil
+		req := httptest.NewRequest(method, "/api/qr-codes", nil)
+		req.Header.Set("X-User-Id", "spoofed")
+		req.Header.Set("X-User-Type", "enterprise")
+		req.Header.Set("X-Admin-Key", "k")
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		for _, s := range session {
+			req.AddCookie(&http.Cookie{Name: SessionCookie, Value: s})
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := serve(http.MethodGet, "Bearer reader"); code != http.StatusNoContent {
+		t.Fatalf("valid token: got %d", code)
+	}
+	if seen.Header.Get("X-User-Id") != "alice" || seen.Header.Get("X-User-Type") != "basic" || seen.Header.Get("X-Admin-Key") != "" {
+		t.Fatalf("identity headers not replaced: %v", seen.Header)
+	}
+	if code := serve(http.MethodPost, "Bearer reader"); code != http.StatusForbidden {
+		t.Fatalf("missing scope: got %d", code)
+	}
+	if code := serve(http.MethodGet, "Bearer nope"); code != http.StatusUnauthorized {
+		t.Fatalf("bad token: got %d", code)
+	}
+	if code := serve(http.MethodGet, "Bearer down"); code != http.StatusServiceUnavailable {
+		t.Fatalf("verifier down: got %d", code)
+	}
+
+	// Without credentials the caller is anonymous, whatever headers it sent.
+	if code := serve(http.MethodGet, ""); code != http.StatusNoContent || seen.Header.Get("X-User-Id") != "" || seen.Header.Get("X-User-Type") != "" || seen.Header.Get("X-Admin-Key") != "k" {
+		t.Fatalf("anonymous: got %d %v", code, seen.Header)
+	}
+
+	// A session cookie stands in for the user, with every scope.
+	if code := serve(http.MethodPost, "", "signed-in"); code != http.StatusNoContent || seen.Header.Get("X-User-Id") != "bob" || seen.Header.Get("X-User-Type") != "enterprise" {
+		t.Fatalf("session: got %d %v", code, seen.Header)
+	}
+	if code := serve(http.MethodGet, "", "expired"); code != http.StatusNoContent || seen.Header.Get("X-User-Id") != "" {
+		t.Fatalf("expired session: got %d %v", code, seen.Header)
+	}
+	if code := serve(http.MethodGet, "", "down"); code != http.StatusServiceUnavailable {
+		t.Fatalf("sessions down: got %d", code)
+	}
+
+	off := Middleware(nil, nil, func(*http.Request) string { return "" })(http.NotFoundHandler())
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer reader")
+	w := httptest.NewRecorder()
+	off.ServeHTTP(w, req)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("nil verifier: got %d", w.Code)
+	}
+}
+
+func TestSessionClient_Verify(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		c, err := r.Cookie(SessionCookie)
+		if r.URL.Path != "/api/users/me" || err != nil || c.Value != "good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"not_authenticated"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"alice","email":"alice@example.com","userType":"basic"}`))
+	}))
+	t.Cleanup(ts.Close)
+	c := NewSessionClient(ts.URL)
+
+	for i := 0; i < 3; i++ {
+		id, err := c.Verify(context.Background(), "good")
+		if err != nil || id.UserID != "alice" || id.UserType != "basic" {
+			t.Fatalf("verify: %+v %v", id, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected one lookup, got %d", calls)
+	}
+	if _, err := c.Verify(context.Background(), "stale"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+	ts.Close()
+	if _, err := c.Verify(context.Background(), "other"); err == nil || errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("an unreachable user-service must not look like a bad session, got %v", err)
+	}
+}
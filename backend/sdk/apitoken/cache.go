@@ -0,0 +1,48 @@
+package apitoken
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+// maxCacheEntries bounds memory if many distinct secrets are presented;
+// the cache is simply emptied when it fills.
+const maxCacheEntries = 10000
+
+// identityCache remembers successful verifications by the secret's hash, so
+// a burst of requests costs one lookup. Failures aren't cached.
+type identityCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]cachedIdentity
+}
+
+type cachedIdentity struct {
+	id      Identity
+	expires time.Time
+}
+
+// lookup returns secret's cached identity, or calls verify and keeps its
+// result for ttl.
+func (c *identityCache) lookup(secret string, ttl time.Duration, verify func() (Identity, error)) (Identity, error) {
+	key := sha256.Sum256([]byte(secret))
+	now := time.Now()
+	c.mu.Lock()
+	if hit, ok := c.entries[key]; ok && now.Before(hit.expires) {
+		c.mu.Unlock()
+		return hit.id, nil
+	}
+	c.mu.Unlock()
+
+	id, err := verify()
+	if err != nil {
+		return Identity{}, err
+	}
+	c.mu.Lock()
+	if c.entries == nil || len(c.entries) >= maxCacheEntries {
+		c.entries = make(map[[sha256.Size]byte]cachedIdentity)
+	}
+	c.entries[key] = cachedIdentity{id: id, expires: now.Add(ttl)}
+	c.mu.Unlock()
+	return id, nil
+}
@@ -0,0 +1,114 @@
+// Package apitoken identifies the callers of qr-service and click-service
+// from credentials user-service issues: personal API tokens and the
+// access_token session cookie set at login.
+package apitoken
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrInvalidToken means the token (or session) is unknown, revoked or expired.
+var ErrInvalidToken = errors.New("invalid api token")
+
+// Scopes a token can carry.
+const (
+	ScopeQrRead     = "qr:read"
+	ScopeQrWrite    = "qr:write"
+	ScopeClicksRead = "clicks:read"
+)
+
+// Identity is who a verified token acts for.
+type Identity struct {
+	TokenID  string   `json:"tokenId"`
+	UserID   string   `json:"userId"`
+	UserType string   `json:"userType"`
+	Scopes   []string `json:"scopes"`
+}
+
+func (id Identity) HasScope(scope string) bool {
+	for _, s := range id.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifier checks a token's secret or a session's access token.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (Identity, error)
+}
+
+// Client verifies tokens against user-service's /api/tokens/verify, caching
+// successful results for TTL so a burst of requests costs one lookup. A
+// revoked token can keep working until its cache entry expires.
+type Client struct {
+	BaseURL string
+	// AdminKey is user-service's ADMIN_API_KEY, which the verify endpoint requires.
+	AdminKey string
+	HTTP     *http.Client
+	TTL      time.Duration
+
+	cache identityCache
+}
+
+func NewClient(baseURL, adminKey string) *Client {
+	return &Client{
+		BaseURL:  strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		AdminKey: adminKey,
+		HTTP:     &http.Client{Timeout: 5 * time.Second},
+		TTL:      30 * time.Second,
+	}
+}
+
+func (c *Client) Verify(ctx context.Context, token string) (Identity, error) {
+	if token == "" {
+		return Identity{}, ErrInvalidToken
+	}
+	return c.cache.lookup(token, c.TTL, func() (Identity, error) {
+		return c.verify(ctx, token)
+	})
+}
+
+func (c *Client) verify(ctx context.Context, token string) (Identity, error) {
+	body, _ := json.Marshal(map[string]string{"token": token})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/api/tokens/verify", bytes.NewReader(body))
+	if err != nil {
+		return Identity{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Key", c.AdminKey)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return Identity{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		var e struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		// A wrong admin key is our misconfiguration, not a bad token.
+		if e.Error == "unauthorized" {
+			return Identity{}, fmt.Errorf("user-service rejected the admin key")
+		}
+		return Identity{}, ErrInvalidToken
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Identity{}, fmt.Errorf("user-service returned %d", resp.StatusCode)
+	}
+	var out Identity
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return Identity{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,91 @@
+package apitoken
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Middleware sets the X-User-Id and X-User-Type headers the handlers trust
+// from credentials it has verified, after dropping any the client sent:
+//
+//   - "Authorization: Bearer <token>" is checked with tokens. A valid token
+//     with the scope scopeFor demands (none when it returns "") becomes the
+//     caller, and can never carry X-Admin-Key. A nil tokens rejects every
+//     token with 503.
+//   - Otherwise the session cookie is checked with sessions, on requests
+//     scopeFor names a scope for (the API, not redirects). An unknown or
+//     expired session leaves the caller anonymous, as does a nil sessions.
+//
+// Requests with neither are anonymous.
+func Middleware(tokens, sessions Verifier, scopeFor func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.Clone(r.Context())
+			r.Header.Del("X-User-Id")
+			r.Header.Del("X-User-Type")
+
+			scope := scopeFor(r)
+			if token, ok := bearerToken(r); ok {
+				if tokens == nil {
+					writeError(w, http.StatusServiceUnavailable, "tokens_unavailable")
+					return
+				}
+				id, err := tokens.Verify(r.Context(), token)
+				if errors.Is(err, ErrInvalidToken) {
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					writeError(w, http.StatusUnauthorized, "invalid_token")
+					return
+				}
+				if err != nil {
+					writeError(w, http.StatusServiceUnavailable, "tokens_unavailable")
+					return
+				}
+				if scope != "" && !id.HasScope(scope) {
+					w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="`+scope+`"`)
+					writeError(w, http.StatusForbidden, "insufficient_scope")
+					return
+				}
+				setIdentity(r, id)
+				r.Header.Del("X-Admin-Key")
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if c, err := r.Cookie(SessionCookie); err == nil && c.Value != "" && scope != "" && sessions != nil {
+				id, err := sessions.Verify(r.Context(), c.Value)
+				switch {
+				case errors.Is(err, ErrInvalidToken):
+					// Signed out or expired: carry on anonymously.
+				case err != nil:
+					writeError(w, http.StatusServiceUnavailable, "sessions_unavailable")
+					return
+				default:
+					setIdentity(r, id)
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func setIdentity(r *http.Request, id Identity) {
+	r.Header.Set("X-User-Id", id.UserID)
+	if id.UserType != "" {
+		r.Header.Set("X-User-Type", id.UserType)
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"error":"` + code + `"}`))
+}
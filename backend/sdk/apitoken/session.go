@@ -0,0 +1,78 @@
+package apitoken
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SessionCookie is the cookie user-service keeps a signed-in browser's
+// Cognito access token in.
+const SessionCookie = "access_token"
+
+// SessionClient verifies browser sessions by asking user-service who the
+// access token belongs to (GET /api/users/me), caching successful results for
+// TTL like Client. A session ended by logout can keep working until its cache
+// entry expires.
+type SessionClient struct {
+	BaseURL string
+	HTTP    *http.Client
+	TTL     time.Duration
+
+	cache identityCache
+}
+
+func NewSessionClient(baseURL string) *SessionClient {
+	return &SessionClient{
+		BaseURL: strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		HTTP:    &http.Client{Timeout: 5 * time.Second},
+		TTL:     30 * time.Second,
+	}
+}
+
+// Verify resolves accessToken to its user. Sessions aren't scoped: they act
+// with everything the user can do.
+func (c *SessionClient) Verify(ctx context.Context, accessToken string) (Identity, error) {
+	if accessToken == "" {
+		return Identity{}, ErrInvalidToken
+	}
+	return c.cache.lookup(accessToken, c.TTL, func() (Identity, error) {
+		return c.verify(ctx, accessToken)
+	})
+}
+
+func (c *SessionClient) verify(ctx context.Context, accessToken string) (Identity, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/api/users/me", nil)
+	if err != nil {
+		return Identity{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.AddCookie(&http.Cookie{Name: SessionCookie, Value: accessToken})
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return Identity{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return Identity{}, ErrInvalidToken
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Identity{}, fmt.Errorf("user-service returned %d", resp.StatusCode)
+	}
+	var user struct {
+		ID       string `json:"id"`
+		UserType string `json:"userType"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return Identity{}, err
+	}
+	if user.ID == "" {
+		return Identity{}, ErrInvalidToken
+	}
+	return Identity{UserID: user.ID, UserType: user.UserType}, nil
+}
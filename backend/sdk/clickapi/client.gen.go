@@ -0,0 +1,2126 @@
+// Package clickapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
+package clickapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerTokenScopes   = "BearerToken.Scopes"
+	SessionCookieScopes = "SessionCookie.Scopes"
+)
+
+// Defines values for ClickEventRoute.
+const (
+	AndroidApp   ClickEventRoute = "android_app"
+	AndroidStore ClickEventRoute = "android_store"
+	IosApp       ClickEventRoute = "ios_app"
+	IosStore     ClickEventRoute = "ios_store"
+	Web          ClickEventRoute = "web"
+)
+
+// ClickEvent defines model for ClickEvent.
+type ClickEvent struct {
+	AcceptLanguage *string   `json:"acceptLanguage,omitempty"`
+	AtIso          time.Time `json:"atIso"`
+	Country        *string   `json:"country,omitempty"`
+	Id             int64     `json:"id"`
+	Ip             *string   `json:"ip,omitempty"`
+	QrCodeId       string    `json:"qrCodeId"`
+	Referer        *string   `json:"referer,omitempty"`
+	RequestId      *string   `json:"requestId,omitempty"`
+
+	// Route The app-link branch taken; absent for plain URLs.
+	Route *ClickEventRoute `json:"route,omitempty"`
+
+	// TargetUrl Where the scan was sent, with UTM parameters applied.
+	TargetUrl *string `json:"targetUrl,omitempty"`
+	UserAgent *string `json:"userAgent,omitempty"`
+	UserType  *string `json:"userType,omitempty"`
+}
+
+// ClickEventRoute The app-link branch taken; absent for plain URLs.
+type ClickEventRoute string
+
+// ClickEventPage defines model for ClickEventPage.
+type ClickEventPage struct {
+	Events []ClickEvent `json:"events"`
+
+	// Next Cursor for the next page; absent on the last one.
+	Next *string `json:"next,omitempty"`
+}
+
+// ClickStats defines model for ClickStats.
+type ClickStats struct {
+	LastAtIso   *time.Time `json:"lastAtIso,omitempty"`
+	LastCountry *string    `json:"lastCountry,omitempty"`
+	QrCodeId    string     `json:"qrCodeId"`
+	Total       int        `json:"total"`
+}
+
+// DailyClickStats defines model for DailyClickStats.
+type DailyClickStats struct {
+	DayIso       openapi_types.Date `json:"dayIso"`
+	Hour00       *int               `json:"hour00,omitempty"`
+	Hour01       *int               `json:"hour01,omitempty"`
+	Hour02       *int               `json:"hour02,omitempty"`
+	Hour03       *int               `json:"hour03,omitempty"`
+	Hour04       *int               `json:"hour04,omitempty"`
+	Hour05       *int               `json:"hour05,omitempty"`
+	Hour06       *int               `json:"hour06,omitempty"`
+	Hour07       *int               `json:"hour07,omitempty"`
+	Hour08       *int               `json:"hour08,omitempty"`
+	Hour09       *int               `json:"hour09,omitempty"`
+	Hour10       *int               `json:"hour10,omitempty"`
+	Hour11       *int               `json:"hour11,omitempty"`
+	Hour12       *int               `json:"hour12,omitempty"`
+	Hour13       *int               `json:"hour13,omitempty"`
+	Hour14       *int               `json:"hour14,omitempty"`
+	Hour15       *int               `json:"hour15,omitempty"`
+	Hour16       *int               `json:"hour16,omitempty"`
+	Hour17       *int               `json:"hour17,omitempty"`
+	Hour18       *int               `json:"hour18,omitempty"`
+	Hour19       *int               `json:"hour19,omitempty"`
+	Hour20       *int               `json:"hour20,omitempty"`
+	Hour21       *int               `json:"hour21,omitempty"`
+	Hour22       *int               `json:"hour22,omitempty"`
+	Hour23       *int               `json:"hour23,omitempty"`
+	QrCodeId     string             `json:"qrCodeId"`
+	RegionCounts *map[string]int    `json:"regionCounts,omitempty"`
+
+	// RouteCounts Clicks per app-link route (`ios_app`, `ios_store`, `android_app`, `android_store`, `web`); empty for codes that aren't app links.
+	RouteCounts *map[string]int `json:"routeCounts,omitempty"`
+	Total       int             `json:"total"`
+}
+
+// DailyClickStatsByDay defines model for DailyClickStatsByDay.
+type DailyClickStatsByDay map[string]DailyClickStats
+
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// InvalidateRequest defines model for InvalidateRequest.
+type InvalidateRequest struct {
+	All *bool     `json:"all,omitempty"`
+	Ids *[]string `json:"ids,omitempty"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// AdminKey defines model for AdminKey.
+type AdminKey = string
+
+// Date defines model for Date.
+type Date = openapi_types.Date
+
+// Day defines model for Day.
+type Day = openapi_types.Date
+
+// Days defines model for Days.
+type Days = string
+
+// QrCodeIdPath defines model for QrCodeIdPath.
+type QrCodeIdPath = string
+
+// QrIdPath defines model for QrIdPath.
+type QrIdPath = string
+
+// QrIdQuery defines model for QrIdQuery.
+type QrIdQuery = string
+
+// InvalidateRedirectCacheParams defines parameters for InvalidateRedirectCache.
+type InvalidateRedirectCacheParams struct {
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// GetDailyClicksParams defines parameters for GetDailyClicks.
+type GetDailyClicksParams struct {
+	QrId QrIdQuery `form:"qrId" json:"qrId"`
+
+	// Day Defaults to today.
+	Day *Day `form:"day,omitempty" json:"day,omitempty"`
+
+	// Date Alias for day.
+	Date *Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetDailyClicksBatchParams defines parameters for GetDailyClicksBatch.
+type GetDailyClicksBatchParams struct {
+	QrId QrIdQuery `form:"qrId" json:"qrId"`
+
+	// Days Comma-separated days.
+	Days Days `form:"days" json:"days"`
+}
+
+// ListClickEventsParams defines parameters for ListClickEvents.
+type ListClickEventsParams struct {
+	QrId  QrIdQuery `form:"qrId" json:"qrId"`
+	Limit *int      `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Before Opaque cursor from a previous page's `next`.
+	Before *string `form:"before,omitempty" json:"before,omitempty"`
+}
+
+// GetClickStatsParams defines parameters for GetClickStats.
+type GetClickStatsParams struct {
+	QrId QrIdQuery `form:"qrId" json:"qrId"`
+}
+
+// GetDailyClicksLegacyParams defines parameters for GetDailyClicksLegacy.
+type GetDailyClicksLegacyParams struct {
+	// Day Defaults to today.
+	Day *Day `form:"day,omitempty" json:"day,omitempty"`
+
+	// Date Alias for day.
+	Date *Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetDailyClicksBatchLegacyParams defines parameters for GetDailyClicksBatchLegacy.
+type GetDailyClicksBatchLegacyParams struct {
+	// Days Comma-separated days.
+	Days Days `form:"days" json:"days"`
+}
+
+// InvalidateRedirectCacheJSONRequestBody defines body for InvalidateRedirectCache for application/json ContentType.
+type InvalidateRedirectCacheJSONRequestBody = InvalidateRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// InvalidateRedirectCacheWithBody request with any body
+	InvalidateRedirectCacheWithBody(ctx context.Context, params *InvalidateRedirectCacheParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InvalidateRedirectCache(ctx context.Context, params *InvalidateRedirectCacheParams, body InvalidateRedirectCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDailyClicks request
+	GetDailyClicks(ctx context.Context, params *GetDailyClicksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDailyClicksBatch request
+	GetDailyClicksBatch(ctx context.Context, params *GetDailyClicksBatchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClickEvents request
+	ListClickEvents(ctx context.Context, params *ListClickEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClickStats request
+	GetClickStats(ctx context.Context, params *GetClickStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClickStatsLegacy request
+	GetClickStatsLegacy(ctx context.Context, qrId QrIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDailyClicksLegacy request
+	GetDailyClicksLegacy(ctx context.Context, qrId QrIdPath, params *GetDailyClicksLegacyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDailyClicksBatchLegacy request
+	GetDailyClicksBatchLegacy(ctx context.Context, qrId QrIdPath, params *GetDailyClicksBatchLegacyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Redirect request
+	Redirect(ctx context.Context, id QrCodeIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) InvalidateRedirectCacheWithBody(ctx context.Context, params *InvalidateRedirectCacheParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvalidateRedirectCacheRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvalidateRedirectCache(ctx context.Context, params *InvalidateRedirectCacheParams, body InvalidateRedirectCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvalidateRedirectCacheRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDailyClicks(ctx context.Context, params *GetDailyClicksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDailyClicksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDailyClicksBatch(ctx context.Context, params *GetDailyClicksBatchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDailyClicksBatchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClickEvents(ctx context.Context, params *ListClickEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClickEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClickStats(ctx context.Context, params *GetClickStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClickStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClickStatsLegacy(ctx context.Context, qrId QrIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClickStatsLegacyRequest(c.Server, qrId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDailyClicksLegacy(ctx context.Context, qrId QrIdPath, params *GetDailyClicksLegacyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDailyClicksLegacyRequest(c.Server, qrId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDailyClicksBatchLegacy(ctx context.Context, qrId QrIdPath, params *GetDailyClicksBatchLegacyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDailyClicksBatchLegacyRequest(c.Server, qrId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Redirect(ctx context.Context, id QrCodeIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedirectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewInvalidateRedirectCacheRequest calls the generic InvalidateRedirectCache builder with application/json body
+func NewInvalidateRedirectCacheRequest(server string, params *InvalidateRedirectCacheParams, body InvalidateRedirectCacheJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInvalidateRedirectCacheRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewInvalidateRedirectCacheRequestWithBody generates requests for InvalidateRedirectCache with any type of body
+func NewInvalidateRedirectCacheRequestWithBody(server string, params *InvalidateRedirectCacheParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/redirect-cache/invalidate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetDailyClicksRequest generates requests for GetDailyClicks
+func NewGetDailyClicksRequest(server string, params *GetDailyClicksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/daily")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrId", runtime.ParamLocationQuery, params.QrId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Day != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "day", runtime.ParamLocationQuery, *params.Day); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Date != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, *params.Date); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDailyClicksBatchRequest generates requests for GetDailyClicksBatch
+func NewGetDailyClicksBatchRequest(server string, params *GetDailyClicksBatchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/daily-batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrId", runtime.ParamLocationQuery, params.QrId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, params.Days); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClickEventsRequest generates requests for ListClickEvents
+func NewListClickEventsRequest(server string, params *ListClickEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrId", runtime.ParamLocationQuery, params.QrId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClickStatsRequest generates requests for GetClickStats
+func NewGetClickStatsRequest(server string, params *GetClickStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrId", runtime.ParamLocationQuery, params.QrId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClickStatsLegacyRequest generates requests for GetClickStatsLegacy
+func NewGetClickStatsLegacyRequest(server string, qrId QrIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "qrId", runtime.ParamLocationPath, qrId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDailyClicksLegacyRequest generates requests for GetDailyClicksLegacy
+func NewGetDailyClicksLegacyRequest(server string, qrId QrIdPath, params *GetDailyClicksLegacyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "qrId", runtime.ParamLocationPath, qrId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/%s/daily", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Day != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "day", runtime.ParamLocationQuery, *params.Day); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Date != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, *params.Date); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDailyClicksBatchLegacyRequest generates requests for GetDailyClicksBatchLegacy
+func NewGetDailyClicksBatchLegacyRequest(server string, qrId QrIdPath, params *GetDailyClicksBatchLegacyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "qrId", runtime.ParamLocationPath, qrId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clicks/%s/daily-batch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, params.Days); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRedirectRequest generates requests for Redirect
+func NewRedirectRequest(server string, id QrCodeIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/r/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// InvalidateRedirectCacheWithBodyWithResponse request with any body
+	InvalidateRedirectCacheWithBodyWithResponse(ctx context.Context, params *InvalidateRedirectCacheParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidateRedirectCacheResponse, error)
+
+	InvalidateRedirectCacheWithResponse(ctx context.Context, params *InvalidateRedirectCacheParams, body InvalidateRedirectCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidateRedirectCacheResponse, error)
+
+	// GetDailyClicksWithResponse request
+	GetDailyClicksWithResponse(ctx context.Context, params *GetDailyClicksParams, reqEditors ...RequestEditorFn) (*GetDailyClicksResponse, error)
+
+	// GetDailyClicksBatchWithResponse request
+	GetDailyClicksBatchWithResponse(ctx context.Context, params *GetDailyClicksBatchParams, reqEditors ...RequestEditorFn) (*GetDailyClicksBatchResponse, error)
+
+	// ListClickEventsWithResponse request
+	ListClickEventsWithResponse(ctx context.Context, params *ListClickEventsParams, reqEditors ...RequestEditorFn) (*ListClickEventsResponse, error)
+
+	// GetClickStatsWithResponse request
+	GetClickStatsWithResponse(ctx context.Context, params *GetClickStatsParams, reqEditors ...RequestEditorFn) (*GetClickStatsResponse, error)
+
+	// GetClickStatsLegacyWithResponse request
+	GetClickStatsLegacyWithResponse(ctx context.Context, qrId QrIdPath, reqEditors ...RequestEditorFn) (*GetClickStatsLegacyResponse, error)
+
+	// GetDailyClicksLegacyWithResponse request
+	GetDailyClicksLegacyWithResponse(ctx context.Context, qrId QrIdPath, params *GetDailyClicksLegacyParams, reqEditors ...RequestEditorFn) (*GetDailyClicksLegacyResponse, error)
+
+	// GetDailyClicksBatchLegacyWithResponse request
+	GetDailyClicksBatchLegacyWithResponse(ctx context.Context, qrId QrIdPath, params *GetDailyClicksBatchLegacyParams, reqEditors ...RequestEditorFn) (*GetDailyClicksBatchLegacyResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+
+	// RedirectWithResponse request
+	RedirectWithResponse(ctx context.Context, id QrCodeIdPath, reqEditors ...RequestEditorFn) (*RedirectResponse, error)
+}
+
+type InvalidateRedirectCacheResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON413      *Error
+	JSON415      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InvalidateRedirectCacheResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvalidateRedirectCacheResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDailyClicksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DailyClickStats
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDailyClicksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDailyClicksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDailyClicksBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DailyClickStatsByDay
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDailyClicksBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDailyClicksBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClickEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClickEventPage
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClickEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClickEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClickStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClickStats
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClickStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClickStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClickStatsLegacyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClickStats
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClickStatsLegacyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClickStatsLegacyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDailyClicksLegacyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DailyClickStats
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDailyClicksLegacyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDailyClicksLegacyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDailyClicksBatchLegacyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DailyClickStatsByDay
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDailyClicksBatchLegacyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDailyClicksBatchLegacyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RedirectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RedirectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RedirectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// InvalidateRedirectCacheWithBodyWithResponse request with arbitrary body returning *InvalidateRedirectCacheResponse
+func (c *ClientWithResponses) InvalidateRedirectCacheWithBodyWithResponse(ctx context.Context, params *InvalidateRedirectCacheParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidateRedirectCacheResponse, error) {
+	rsp, err := c.InvalidateRedirectCacheWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvalidateRedirectCacheResponse(rsp)
+}
+
+func (c *ClientWithResponses) InvalidateRedirectCacheWithResponse(ctx context.Context, params *InvalidateRedirectCacheParams, body InvalidateRedirectCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidateRedirectCacheResponse, error) {
+	rsp, err := c.InvalidateRedirectCache(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvalidateRedirectCacheResponse(rsp)
+}
+
+// GetDailyClicksWithResponse request returning *GetDailyClicksResponse
+func (c *ClientWithResponses) GetDailyClicksWithResponse(ctx context.Context, params *GetDailyClicksParams, reqEditors ...RequestEditorFn) (*GetDailyClicksResponse, error) {
+	rsp, err := c.GetDailyClicks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDailyClicksResponse(rsp)
+}
+
+// GetDailyClicksBatchWithResponse request returning *GetDailyClicksBatchResponse
+func (c *ClientWithResponses) GetDailyClicksBatchWithResponse(ctx context.Context, params *GetDailyClicksBatchParams, reqEditors ...RequestEditorFn) (*GetDailyClicksBatchResponse, error) {
+	rsp, err := c.GetDailyClicksBatch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDailyClicksBatchResponse(rsp)
+}
+
+// ListClickEventsWithResponse request returning *ListClickEventsResponse
+func (c *ClientWithResponses) ListClickEventsWithResponse(ctx context.Context, params *ListClickEventsParams, reqEditors ...RequestEditorFn) (*ListClickEventsResponse, error) {
+	rsp, err := c.ListClickEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClickEventsResponse(rsp)
+}
+
+// GetClickStatsWithResponse request returning *GetClickStatsResponse
+func (c *ClientWithResponses) GetClickStatsWithResponse(ctx context.Context, params *GetClickStatsParams, reqEditors ...RequestEditorFn) (*GetClickStatsResponse, error) {
+	rsp, err := c.GetClickStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClickStatsResponse(rsp)
+}
+
+// GetClickStatsLegacyWithResponse request returning *GetClickStatsLegacyResponse
+func (c *ClientWithResponses) GetClickStatsLegacyWithResponse(ctx context.Context, qrId QrIdPath, reqEditors ...RequestEditorFn) (*GetClickStatsLegacyResponse, error) {
+	rsp, err := c.GetClickStatsLegacy(ctx, qrId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClickStatsLegacyResponse(rsp)
+}
+
+// GetDailyClicksLegacyWithResponse request returning *GetDailyClicksLegacyResponse
+func (c *ClientWithResponses) GetDailyClicksLegacyWithResponse(ctx context.Context, qrId QrIdPath, params *GetDailyClicksLegacyParams, reqEditors ...RequestEditorFn) (*GetDailyClicksLegacyResponse, error) {
+	rsp, err := c.GetDailyClicksLegacy(ctx, qrId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDailyClicksLegacyResponse(rsp)
+}
+
+// GetDailyClicksBatchLegacyWithResponse request returning *GetDailyClicksBatchLegacyResponse
+func (c *ClientWithResponses) GetDailyClicksBatchLegacyWithResponse(ctx context.Context, qrId QrIdPath, params *GetDailyClicksBatchLegacyParams, reqEditors ...RequestEditorFn) (*GetDailyClicksBatchLegacyResponse, error) {
+	rsp, err := c.GetDailyClicksBatchLegacy(ctx, qrId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDailyClicksBatchLegacyResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// RedirectWithResponse request returning *RedirectResponse
+func (c *ClientWithResponses) RedirectWithResponse(ctx context.Context, id QrCodeIdPath, reqEditors ...RequestEditorFn) (*RedirectResponse, error) {
+	rsp, err := c.Redirect(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedirectResponse(rsp)
+}
+
+// ParseInvalidateRedirectCacheResponse parses an HTTP response from a InvalidateRedirectCacheWithResponse call
+func ParseInvalidateRedirectCacheResponse(rsp *http.Response) (*InvalidateRedirectCacheResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InvalidateRedirectCacheResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDailyClicksResponse parses an HTTP response from a GetDailyClicksWithResponse call
+func ParseGetDailyClicksResponse(rsp *http.Response) (*GetDailyClicksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDailyClicksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DailyClickStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDailyClicksBatchResponse parses an HTTP response from a GetDailyClicksBatchWithResponse call
+func ParseGetDailyClicksBatchResponse(rsp *http.Response) (*GetDailyClicksBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDailyClicksBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DailyClickStatsByDay
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClickEventsResponse parses an HTTP response from a ListClickEventsWithResponse call
+func ParseListClickEventsResponse(rsp *http.Response) (*ListClickEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClickEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClickEventPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClickStatsResponse parses an HTTP response from a GetClickStatsWithResponse call
+func ParseGetClickStatsResponse(rsp *http.Response) (*GetClickStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClickStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClickStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClickStatsLegacyResponse parses an HTTP response from a GetClickStatsLegacyWithResponse call
+func ParseGetClickStatsLegacyResponse(rsp *http.Response) (*GetClickStatsLegacyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClickStatsLegacyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClickStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDailyClicksLegacyResponse parses an HTTP response from a GetDailyClicksLegacyWithResponse call
+func ParseGetDailyClicksLegacyResponse(rsp *http.Response) (*GetDailyClicksLegacyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDailyClicksLegacyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DailyClickStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDailyClicksBatchLegacyResponse parses an HTTP response from a GetDailyClicksBatchLegacyWithResponse call
+func ParseGetDailyClicksBatchLegacyResponse(rsp *http.Response) (*GetDailyClicksBatchLegacyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDailyClicksBatchLegacyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DailyClickStatsByDay
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRedirectResponse parses an HTTP response from a RedirectWithResponse call
+func ParseRedirectResponse(rsp *http.Response) (*RedirectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RedirectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
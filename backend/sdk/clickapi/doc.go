@@ -0,0 +1,5 @@
+// Package clickapi is a client for click-service, generated from
+// click-service/internal/httpapi/openapi.yaml.
+package clickapi
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 -config oapi-codegen.yaml ../../click-service/internal/httpapi/openapi.yaml
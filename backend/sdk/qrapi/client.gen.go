@@ -0,0 +1,7128 @@
+// Package qrapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
+package qrapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerTokenScopes   = "BearerToken.Scopes"
+	SessionCookieScopes = "SessionCookie.Scopes"
+)
+
+// Defines values for BulkItemResultStatus.
+const (
+	BulkItemResultStatusDeleted   BulkItemResultStatus = "deleted"
+	BulkItemResultStatusFailed    BulkItemResultStatus = "failed"
+	BulkItemResultStatusUnchanged BulkItemResultStatus = "unchanged"
+	BulkItemResultStatusUpdated   BulkItemResultStatus = "updated"
+)
+
+// Defines values for BulkRequestAction.
+const (
+	Activate   BulkRequestAction = "activate"
+	Deactivate BulkRequestAction = "deactivate"
+	Delete     BulkRequestAction = "delete"
+	ReplaceUrl BulkRequestAction = "replace_url"
+	Tag        BulkRequestAction = "tag"
+)
+
+// Defines values for ChangeEventType.
+const (
+	QrCodeCreated   ChangeEventType = "qr_code.created"
+	QrCodeDeleted   ChangeEventType = "qr_code.deleted"
+	QrCodeUpdated   ChangeEventType = "qr_code.updated"
+	SettingsUpdated ChangeEventType = "settings.updated"
+)
+
+// Defines values for DestinationType.
+const (
+	DestinationTypeAppLink DestinationType = "app_link"
+	DestinationTypeUrl     DestinationType = "url"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	ImportRowStatusCreated ImportRowStatus = "created"
+	ImportRowStatusFailed  ImportRowStatus = "failed"
+	ImportRowStatusValid   ImportRowStatus = "valid"
+)
+
+// Defines values for PdfLayoutLabelText.
+const (
+	PdfLayoutLabelTextId    PdfLayoutLabelText = "id"
+	PdfLayoutLabelTextLabel PdfLayoutLabelText = "label"
+	PdfLayoutLabelTextNone  PdfLayoutLabelText = "none"
+	PdfLayoutLabelTextUrl   PdfLayoutLabelText = "url"
+)
+
+// Defines values for PdfLayoutPageSize.
+const (
+	A4     PdfLayoutPageSize = "a4"
+	Letter PdfLayoutPageSize = "letter"
+)
+
+// Defines values for PdfLayoutTemplate.
+const (
+	Avery22805 PdfLayoutTemplate = "avery-22805"
+	Avery5160  PdfLayoutTemplate = "avery-5160"
+	Avery5163  PdfLayoutTemplate = "avery-5163"
+	AveryL7160 PdfLayoutTemplate = "avery-l7160"
+)
+
+// Defines values for QrFrameBorder.
+const (
+	Banner  QrFrameBorder = "banner"
+	Line    QrFrameBorder = "line"
+	None    QrFrameBorder = "none"
+	Rounded QrFrameBorder = "rounded"
+)
+
+// Defines values for QrFrameCaptionPosition.
+const (
+	Above QrFrameCaptionPosition = "above"
+	Below QrFrameCaptionPosition = "below"
+)
+
+// Defines values for QrFrameFont.
+const (
+	Bold    QrFrameFont = "bold"
+	Italic  QrFrameFont = "italic"
+	Mono    QrFrameFont = "mono"
+	Regular QrFrameFont = "regular"
+)
+
+// Defines values for QrStyleErrorCorrection.
+const (
+	H QrStyleErrorCorrection = "H"
+	L QrStyleErrorCorrection = "L"
+	M QrStyleErrorCorrection = "M"
+	Q QrStyleErrorCorrection = "Q"
+)
+
+// Defines values for ScanWarningCode.
+const (
+	BlurSensitive  ScanWarningCode = "blur_sensitive"
+	InvertedColors ScanWarningCode = "inverted_colors"
+	LogoTooLarge   ScanWarningCode = "logo_too_large"
+	LowContrast    ScanWarningCode = "low_contrast"
+	SmallPrint     ScanWarningCode = "small_print"
+	Unreadable     ScanWarningCode = "unreadable"
+	Unverified     ScanWarningCode = "unverified"
+)
+
+// Defines values for Symbology.
+const (
+	Aztec      Symbology = "aztec"
+	Code128    Symbology = "code128"
+	DataMatrix Symbology = "data_matrix"
+	Pdf417     Symbology = "pdf417"
+	Qr         Symbology = "qr"
+)
+
+// Defines values for ImageFormat.
+const (
+	ImageFormatEps ImageFormat = "eps"
+	ImageFormatPdf ImageFormat = "pdf"
+	ImageFormatPng ImageFormat = "png"
+	ImageFormatSvg ImageFormat = "svg"
+)
+
+// Defines values for ImageUnit.
+const (
+	ImageUnitIn ImageUnit = "in"
+	ImageUnitMm ImageUnit = "mm"
+)
+
+// Defines values for AdminGetUsageParamsUserType.
+const (
+	Admin      AdminGetUsageParamsUserType = "admin"
+	Basic      AdminGetUsageParamsUserType = "basic"
+	Enterprise AdminGetUsageParamsUserType = "enterprise"
+	Free       AdminGetUsageParamsUserType = "free"
+)
+
+// Defines values for ExportBackupParamsFormat.
+const (
+	Csv  ExportBackupParamsFormat = "csv"
+	Json ExportBackupParamsFormat = "json"
+	Zip  ExportBackupParamsFormat = "zip"
+)
+
+// Defines values for GetQrCodeImageParamsFormat.
+const (
+	GetQrCodeImageParamsFormatEps GetQrCodeImageParamsFormat = "eps"
+	GetQrCodeImageParamsFormatPdf GetQrCodeImageParamsFormat = "pdf"
+	GetQrCodeImageParamsFormatPng GetQrCodeImageParamsFormat = "png"
+	GetQrCodeImageParamsFormatSvg GetQrCodeImageParamsFormat = "svg"
+)
+
+// Defines values for GetQrCodeImageParamsUnit.
+const (
+	GetQrCodeImageParamsUnitIn GetQrCodeImageParamsUnit = "in"
+	GetQrCodeImageParamsUnitMm GetQrCodeImageParamsUnit = "mm"
+)
+
+// Defines values for GetQrCodeImageByDigestParamsFormat.
+const (
+	Eps GetQrCodeImageByDigestParamsFormat = "eps"
+	Pdf GetQrCodeImageByDigestParamsFormat = "pdf"
+	Png GetQrCodeImageByDigestParamsFormat = "png"
+	Svg GetQrCodeImageByDigestParamsFormat = "svg"
+)
+
+// Defines values for GetQrCodeImageByDigestParamsUnit.
+const (
+	In GetQrCodeImageByDigestParamsUnit = "in"
+	Mm GetQrCodeImageByDigestParamsUnit = "mm"
+)
+
+// AppLink defines model for AppLink.
+type AppLink struct {
+	AndroidUrl   *string `json:"androidUrl,omitempty"`
+	AppStoreUrl  *string `json:"appStoreUrl,omitempty"`
+	IosUrl       *string `json:"iosUrl,omitempty"`
+	PlayStoreUrl *string `json:"playStoreUrl,omitempty"`
+}
+
+// Backup defines model for Backup.
+type Backup struct {
+	ExportedAtIso time.Time `json:"exportedAtIso"`
+	QrCodes       []QrCode  `json:"qrCodes"`
+	Settings      Settings  `json:"settings"`
+
+	// WorkspaceId Empty for personal codes.
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// BulkError defines model for BulkError.
+type BulkError struct {
+	Error string `json:"error"`
+
+	// Ids Offending IDs, for batch endpoints.
+	Ids *[]string `json:"ids,omitempty"`
+
+	// Results Set for bulk_items_failed; nothing was applied.
+	Results *[]BulkItemResult `json:"results,omitempty"`
+}
+
+// BulkItemResult defines model for BulkItemResult.
+type BulkItemResult struct {
+	Error  *string              `json:"error,omitempty"`
+	Id     string               `json:"id"`
+	Status BulkItemResultStatus `json:"status"`
+
+	// Url The new destination, for replace_url.
+	Url *string `json:"url,omitempty"`
+}
+
+// BulkItemResultStatus defines model for BulkItemResult.Status.
+type BulkItemResultStatus string
+
+// BulkRequest defines model for BulkRequest.
+type BulkRequest struct {
+	Action BulkRequestAction `json:"action"`
+	DryRun *bool             `json:"dryRun,omitempty"`
+	Filter *QrCodeFilter     `json:"filter,omitempty"`
+	Ids    *[]string         `json:"ids,omitempty"`
+
+	// Replace Required for replace_url. Destination URLs whose host is `find`
+	// (case-insensitive) get `replace` as their host; path and query are kept.
+	Replace *struct {
+		Find    string `json:"find"`
+		Replace string `json:"replace"`
+	} `json:"replace,omitempty"`
+
+	// Tags Required for the tag action; removals win over additions.
+	Tags *struct {
+		Add    *[]string `json:"add,omitempty"`
+		Remove *[]string `json:"remove,omitempty"`
+	} `json:"tags,omitempty"`
+}
+
+// BulkRequestAction defines model for BulkRequest.Action.
+type BulkRequestAction string
+
+// BulkResult defines model for BulkResult.
+type BulkResult struct {
+	Action  string           `json:"action"`
+	Changed int              `json:"changed"`
+	DryRun  bool             `json:"dryRun"`
+	Results []BulkItemResult `json:"results"`
+}
+
+// ChangeEvent defines model for ChangeEvent.
+type ChangeEvent struct {
+	AtIso   time.Time `json:"atIso"`
+	Id      int64     `json:"id"`
+	OwnerId *string   `json:"ownerId,omitempty"`
+
+	// QrCodeId Empty for settings events, like workspaceId; ownerId names whose settings changed.
+	QrCodeId    *string         `json:"qrCodeId,omitempty"`
+	Type        ChangeEventType `json:"type"`
+	WorkspaceId *string         `json:"workspaceId,omitempty"`
+}
+
+// ChangeEventType defines model for ChangeEvent.Type.
+type ChangeEventType string
+
+// CloneQrCodeRequest Omitted fields are copied from the source; the label defaults to "<label> (copy)".
+type CloneQrCodeRequest struct {
+	Active *bool   `json:"active,omitempty"`
+	Label  *string `json:"label,omitempty"`
+	Url    *string `json:"url,omitempty"`
+}
+
+// CmykColor Process color for EPS and PDF exports, each ink 0 to 100 percent.
+// When the matching hex color is empty, screen formats and the
+// scannability check use a naive conversion of this one.
+type CmykColor struct {
+	C float32 `json:"c"`
+	K float32 `json:"k"`
+	M float32 `json:"m"`
+	Y float32 `json:"y"`
+}
+
+// CreateQrCodeRequest defines model for CreateQrCodeRequest.
+type CreateQrCodeRequest struct {
+	Active          *bool            `json:"active,omitempty"`
+	AppLink         *AppLink         `json:"appLink,omitempty"`
+	Campaign        *string          `json:"campaign,omitempty"`
+	DestinationType *DestinationType `json:"destinationType,omitempty"`
+
+	// FallbackUrl Where scans go while the code is inactive (https), ahead of the owner's defaultRedirectUrl.
+	FallbackUrl *string `json:"fallbackUrl,omitempty"`
+
+	// Frame Call-to-action frame drawn around the code in every export format.
+	// Captions are set in fonts embedded in the service and drawn as
+	// outlines, so files need no fonts installed. Bad values get 400
+	// `frame_invalid`; send {} to remove the frame.
+	Frame *QrFrame `json:"frame,omitempty"`
+	Label *string  `json:"label,omitempty"`
+
+	// LandingPage Hosted page click-service shows while the code is inactive and has no
+	// fallbackUrl. Empty fields get generic text; send {} to remove the page.
+	LandingPage *LandingPage `json:"landingPage,omitempty"`
+
+	// Style How the code is drawn; omitted fields mean plain black on white at
+	// error correction level M. Saving a style renders it and decodes it at
+	// several simulated print sizes and blur levels. A style that can't be
+	// read even large and sharp is refused with 422 `style_unscannable`;
+	// malformed values get 400 `style_invalid`.
+	Style *QrStyle `json:"style,omitempty"`
+
+	// Symbology Barcode the tracking link is printed as; every one is tracked the
+	// same way. Data Matrix, Aztec, PDF417 and Code 128 take no logo, and
+	// Code 128 holds at most 80 characters, so a tracking link that doesn't
+	// fit is refused with 422 `symbology_capacity_exceeded`. Unknown values
+	// get 400 `symbology_invalid`.
+	Symbology *Symbology `json:"symbology,omitempty"`
+	Tags      *[]string  `json:"tags,omitempty"`
+	Url       string     `json:"url"`
+
+	// Utm Values may contain the placeholders {qr_id}, {label}, {campaign},
+	// {country} and {date}, expanded by click-service at redirect time.
+	Utm *UtmTemplate `json:"utm,omitempty"`
+}
+
+// DecodeResult defines model for DecodeResult.
+type DecodeResult struct {
+	Symbols []DecodedSymbol `json:"symbols"`
+}
+
+// DecodedSymbol defines model for DecodedSymbol.
+type DecodedSymbol struct {
+	QrCode *QrCode `json:"qrCode,omitempty"`
+
+	// QrCodeId Set when text is one of this service's tracking links.
+	QrCodeId *string `json:"qrCodeId,omitempty"`
+
+	// Text The symbol's payload.
+	Text string `json:"text"`
+}
+
+// DestinationType defines model for DestinationType.
+type DestinationType string
+
+// DisableRequest defines model for DisableRequest.
+type DisableRequest struct {
+	// Reason Shown to the owner.
+	Reason string `json:"reason"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+
+	// Ids Offending IDs, for batch endpoints.
+	Ids *[]string `json:"ids,omitempty"`
+}
+
+// EventsPage defines model for EventsPage.
+type EventsPage struct {
+	Events []ChangeEvent `json:"events"`
+
+	// Next Cursor for the next call; the request's own when there were no events.
+	Next int64 `json:"next"`
+}
+
+// ImportError defines model for ImportError.
+type ImportError struct {
+	Error string `json:"error"`
+
+	// Ids Offending IDs, for batch endpoints.
+	Ids *[]string `json:"ids,omitempty"`
+
+	// Rows Set for import_rows_failed; nothing was created.
+	Rows *[]ImportRow `json:"rows,omitempty"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Created int         `json:"created"`
+	DryRun  bool        `json:"dryRun"`
+	Rows    []ImportRow `json:"rows"`
+}
+
+// ImportRow defines model for ImportRow.
+type ImportRow struct {
+	Error *string `json:"error,omitempty"`
+
+	// Id The created code, once committed.
+	Id    *string `json:"id,omitempty"`
+	Label *string `json:"label,omitempty"`
+
+	// Row Spreadsheet row number; the header is row 1.
+	Row    int             `json:"row"`
+	Status ImportRowStatus `json:"status"`
+	Url    *string         `json:"url,omitempty"`
+}
+
+// ImportRowStatus defines model for ImportRow.Status.
+type ImportRowStatus string
+
+// InstanceError defines model for InstanceError.
+type InstanceError struct {
+	Error    string  `json:"error"`
+	Index    *int    `json:"index,omitempty"`
+	Variable *string `json:"variable,omitempty"`
+}
+
+// InstantiateRequest defines model for InstantiateRequest.
+type InstantiateRequest struct {
+	Instances []TemplateInstance `json:"instances"`
+}
+
+// LandingPage Hosted page click-service shows while the code is inactive and has no
+// fallbackUrl. Empty fields get generic text; send {} to remove the page.
+type LandingPage struct {
+	// ButtonText Needs buttonUrl; defaults to "Continue".
+	ButtonText *string `json:"buttonText,omitempty"`
+
+	// ButtonUrl https only.
+	ButtonUrl *string `json:"buttonUrl,omitempty"`
+
+	// LogoUrl https only.
+	LogoUrl *string `json:"logoUrl,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Title   *string `json:"title,omitempty"`
+}
+
+// OwnerUsage defines model for OwnerUsage.
+type OwnerUsage struct {
+	Active    int    `json:"active"`
+	Disabled  int    `json:"disabled"`
+	MaxActive int    `json:"maxActive"`
+	MaxTotal  int    `json:"maxTotal"`
+	OverQuota bool   `json:"overQuota"`
+	OwnerId   string `json:"ownerId"`
+	Total     int    `json:"total"`
+	UserType  string `json:"userType"`
+}
+
+// PdfExportRequest Give ids or a filter with at least one condition; otherwise the request is refused with `selection_required`.
+type PdfExportRequest struct {
+	Filter *QrCodeFilter `json:"filter,omitempty"`
+	Ids    *[]string     `json:"ids,omitempty"`
+
+	// Layout Starts from a named template and overrides individual dimensions (millimetres).
+	Layout *PdfLayout `json:"layout,omitempty"`
+}
+
+// PdfLayout Starts from a named template and overrides individual dimensions (millimetres).
+type PdfLayout struct {
+	// BleedMm Fill each label with its code's background ink this far past the die-cut edge.
+	BleedMm       *float32            `json:"bleedMm,omitempty"`
+	CodeSizeMm    *float32            `json:"codeSizeMm,omitempty"`
+	Columns       *int                `json:"columns,omitempty"`
+	FontSizePt    *float32            `json:"fontSizePt,omitempty"`
+	LabelHeightMm *float32            `json:"labelHeightMm,omitempty"`
+	LabelText     *PdfLayoutLabelText `json:"labelText,omitempty"`
+	LabelWidthMm  *float32            `json:"labelWidthMm,omitempty"`
+	MarginLeftMm  *float32            `json:"marginLeftMm,omitempty"`
+	MarginTopMm   *float32            `json:"marginTopMm,omitempty"`
+	PageSize      *PdfLayoutPageSize  `json:"pageSize,omitempty"`
+	PitchXMm      *float32            `json:"pitchXMm,omitempty"`
+	PitchYMm      *float32            `json:"pitchYMm,omitempty"`
+	Rows          *int                `json:"rows,omitempty"`
+	Template      *PdfLayoutTemplate  `json:"template,omitempty"`
+}
+
+// PdfLayoutLabelText defines model for PdfLayout.LabelText.
+type PdfLayoutLabelText string
+
+// PdfLayoutPageSize defines model for PdfLayout.PageSize.
+type PdfLayoutPageSize string
+
+// PdfLayoutTemplate defines model for PdfLayout.Template.
+type PdfLayoutTemplate string
+
+// QrCode defines model for QrCode.
+type QrCode struct {
+	Active          bool            `json:"active"`
+	AppLink         *AppLink        `json:"appLink,omitempty"`
+	Campaign        *string         `json:"campaign,omitempty"`
+	CreatedAtIso    time.Time       `json:"createdAtIso"`
+	DestinationType DestinationType `json:"destinationType"`
+	DisabledAtIso   *time.Time      `json:"disabledAtIso,omitempty"`
+
+	// DisabledReason Set when an admin has force-disabled the code.
+	DisabledReason *string `json:"disabledReason,omitempty"`
+
+	// FallbackUrl Where scans go while the code is inactive (https), ahead of the owner's defaultRedirectUrl.
+	FallbackUrl *string `json:"fallbackUrl,omitempty"`
+
+	// Frame Call-to-action frame drawn around the code in every export format.
+	// Captions are set in fonts embedded in the service and drawn as
+	// outlines, so files need no fonts installed. Bad values get 400
+	// `frame_invalid`; send {} to remove the frame.
+	Frame *QrFrame `json:"frame,omitempty"`
+	Id    string   `json:"id"`
+	Label string   `json:"label"`
+
+	// LandingPage Hosted page click-service shows while the code is inactive and has no
+	// fallbackUrl. Empty fields get generic text; send {} to remove the page.
+	LandingPage *LandingPage `json:"landingPage,omitempty"`
+	OwnerId     *string      `json:"ownerId,omitempty"`
+
+	// Scannability How well the code's style scans, measured when it was saved.
+	Scannability *ScanReport `json:"scannability,omitempty"`
+
+	// Style How the code is drawn; omitted fields mean plain black on white at
+	// error correction level M. Saving a style renders it and decodes it at
+	// several simulated print sizes and blur levels. A style that can't be
+	// read even large and sharp is refused with 422 `style_unscannable`;
+	// malformed values get 400 `style_invalid`.
+	Style *QrStyle `json:"style,omitempty"`
+
+	// Symbology Barcode the tracking link is printed as; every one is tracked the
+	// same way. Data Matrix, Aztec, PDF417 and Code 128 take no logo, and
+	// Code 128 holds at most 80 characters, so a tracking link that doesn't
+	// fit is refused with 422 `symbology_capacity_exceeded`. Unknown values
+	// get 400 `symbology_invalid`.
+	Symbology Symbology `json:"symbology"`
+	Tags      *[]string `json:"tags,omitempty"`
+	Url       string    `json:"url"`
+
+	// Utm Values may contain the placeholders {qr_id}, {label}, {campaign},
+	// {country} and {date}, expanded by click-service at redirect time.
+	Utm *UtmTemplate `json:"utm,omitempty"`
+
+	// WorkspaceId Team workspace the code belongs to; absent for personal codes.
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+}
+
+// QrCodeFilter defines model for QrCodeFilter.
+type QrCodeFilter struct {
+	Active   *bool   `json:"active,omitempty"`
+	Campaign *string `json:"campaign,omitempty"`
+
+	// Search Case-insensitive substring of the label or URL.
+	Search *string `json:"search,omitempty"`
+
+	// Tag Only codes carrying this tag.
+	Tag *string `json:"tag,omitempty"`
+}
+
+// QrFrame Call-to-action frame drawn around the code in every export format.
+// Captions are set in fonts embedded in the service and drawn as
+// outlines, so files need no fonts installed. Bad values get 400
+// `frame_invalid`; send {} to remove the frame.
+type QrFrame struct {
+	// Border `banner` sets the caption in a solid band of the frame color.
+	Border          *QrFrameBorder          `json:"border,omitempty"`
+	Caption         *string                 `json:"caption,omitempty"`
+	CaptionPosition *QrFrameCaptionPosition `json:"captionPosition,omitempty"`
+
+	// Color Border and caption color; defaults to the style's foreground.
+	Color *string      `json:"color,omitempty"`
+	Font  *QrFrameFont `json:"font,omitempty"`
+
+	// ShowUrl Print the tracking link beneath the code for phones that can't scan it.
+	ShowUrl *bool `json:"showUrl,omitempty"`
+}
+
+// QrFrameBorder `banner` sets the caption in a solid band of the frame color.
+type QrFrameBorder string
+
+// QrFrameCaptionPosition defines model for QrFrame.CaptionPosition.
+type QrFrameCaptionPosition string
+
+// QrFrameFont defines model for QrFrame.Font.
+type QrFrameFont string
+
+// QrStyle How the code is drawn; omitted fields mean plain black on white at
+// error correction level M. Saving a style renders it and decodes it at
+// several simulated print sizes and blur levels. A style that can't be
+// read even large and sharp is refused with 422 `style_unscannable`;
+// malformed values get 400 `style_invalid`.
+type QrStyle struct {
+	Background *string `json:"background,omitempty"`
+
+	// BackgroundCmyk Process color for EPS and PDF exports, each ink 0 to 100 percent.
+	// When the matching hex color is empty, screen formats and the
+	// scannability check use a naive conversion of this one.
+	BackgroundCmyk *CmykColor `json:"backgroundCmyk,omitempty"`
+
+	// ErrorCorrection QR codes only; other symbologies use their own fixed level.
+	ErrorCorrection *QrStyleErrorCorrection `json:"errorCorrection,omitempty"`
+	Foreground      *string                 `json:"foreground,omitempty"`
+
+	// ForegroundCmyk Process color for EPS and PDF exports, each ink 0 to 100 percent.
+	// When the matching hex color is empty, screen formats and the
+	// scannability check use a naive conversion of this one.
+	ForegroundCmyk *CmykColor `json:"foregroundCmyk,omitempty"`
+
+	// LogoScale Width of a centered logo as a fraction of the code's width; the modules beneath it are hidden. QR codes only.
+	LogoScale *float32 `json:"logoScale,omitempty"`
+}
+
+// QrStyleErrorCorrection QR codes only; other symbologies use their own fixed level.
+type QrStyleErrorCorrection string
+
+// QrTemplate defines model for QrTemplate.
+type QrTemplate struct {
+	Active          bool            `json:"active"`
+	AppLink         *AppLink        `json:"appLink,omitempty"`
+	Campaign        *string         `json:"campaign,omitempty"`
+	CreatedAtIso    time.Time       `json:"createdAtIso"`
+	DestinationType DestinationType `json:"destinationType"`
+	Id              string          `json:"id"`
+
+	// LabelPattern May contain {variable} placeholders; {n} is the 1-based instance number.
+	LabelPattern string  `json:"labelPattern"`
+	Name         string  `json:"name"`
+	OwnerId      *string `json:"ownerId,omitempty"`
+
+	// Style How the code is drawn; omitted fields mean plain black on white at
+	// error correction level M. Saving a style renders it and decodes it at
+	// several simulated print sizes and blur levels. A style that can't be
+	// read even large and sharp is refused with 422 `style_unscannable`;
+	// malformed values get 400 `style_invalid`.
+	Style *QrStyle  `json:"style,omitempty"`
+	Tags  *[]string `json:"tags,omitempty"`
+
+	// UrlPattern Like labelPattern; values are URL-escaped when expanded.
+	UrlPattern string `json:"urlPattern"`
+
+	// Utm Values may contain the placeholders {qr_id}, {label}, {campaign},
+	// {country} and {date}, expanded by click-service at redirect time.
+	Utm         *UtmTemplate `json:"utm,omitempty"`
+	WorkspaceId *string      `json:"workspaceId,omitempty"`
+}
+
+// QrTemplateRequest defines model for QrTemplateRequest.
+type QrTemplateRequest struct {
+	Active          *bool            `json:"active,omitempty"`
+	AppLink         *AppLink         `json:"appLink,omitempty"`
+	Campaign        *string          `json:"campaign,omitempty"`
+	DestinationType *DestinationType `json:"destinationType,omitempty"`
+
+	// LabelPattern May contain {variable} placeholders; {n} is the 1-based instance number.
+	LabelPattern *string `json:"labelPattern,omitempty"`
+	Name         string  `json:"name"`
+
+	// Style How the code is drawn; omitted fields mean plain black on white at
+	// error correction level M. Saving a style renders it and decodes it at
+	// several simulated print sizes and blur levels. A style that can't be
+	// read even large and sharp is refused with 422 `style_unscannable`;
+	// malformed values get 400 `style_invalid`.
+	Style *QrStyle  `json:"style,omitempty"`
+	Tags  *[]string `json:"tags,omitempty"`
+
+	// UrlPattern Like labelPattern; values are URL-escaped when expanded.
+	UrlPattern string `json:"urlPattern"`
+
+	// Utm Values may contain the placeholders {qr_id}, {label}, {campaign},
+	// {country} and {date}, expanded by click-service at redirect time.
+	Utm *UtmTemplate `json:"utm,omitempty"`
+}
+
+// SampleDataResult defines model for SampleDataResult.
+type SampleDataResult struct {
+	Created int    `json:"created"`
+	Message string `json:"message"`
+}
+
+// ScanReport How well the code's style scans, measured when it was saved.
+type ScanReport struct {
+	// ContrastRatio WCAG contrast ratio between the colors, 1 to 21.
+	ContrastRatio float32 `json:"contrastRatio"`
+
+	// MinPrintSizeMm Smallest simulated print width that read at every blur level; absent for PDF417, which is only checked for contrast.
+	MinPrintSizeMm *int `json:"minPrintSizeMm,omitempty"`
+	Readable       bool `json:"readable"`
+
+	// Score 0 (never read) to 100 (read at every simulated size and blur level, with ample contrast).
+	Score    int            `json:"score"`
+	Warnings *[]ScanWarning `json:"warnings,omitempty"`
+}
+
+// ScanWarning defines model for ScanWarning.
+type ScanWarning struct {
+	Code    ScanWarningCode `json:"code"`
+	Message string          `json:"message"`
+}
+
+// ScanWarningCode defines model for ScanWarning.Code.
+type ScanWarningCode string
+
+// Settings defines model for Settings.
+type Settings struct {
+	CampaignUtm        *map[string]UtmTemplate `json:"campaignUtm,omitempty"`
+	DefaultRedirectUrl *string                 `json:"defaultRedirectUrl,omitempty"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// Symbology Barcode the tracking link is printed as; every one is tracked the
+// same way. Data Matrix, Aztec, PDF417 and Code 128 take no logo, and
+// Code 128 holds at most 80 characters, so a tracking link that doesn't
+// fit is refused with 422 `symbology_capacity_exceeded`. Unknown values
+// get 400 `symbology_invalid`.
+type Symbology string
+
+// TemplateInstance defines model for TemplateInstance.
+type TemplateInstance struct {
+	// Label Overrides the label pattern for this instance.
+	Label     *string            `json:"label,omitempty"`
+	Variables *map[string]string `json:"variables,omitempty"`
+}
+
+// TransferRequest Give either ids or fromOwnerId.
+type TransferRequest struct {
+	FromOwnerId *string   `json:"fromOwnerId,omitempty"`
+	Ids         *[]string `json:"ids,omitempty"`
+	ToOwnerId   string    `json:"toOwnerId"`
+}
+
+// TransferResult defines model for TransferResult.
+type TransferResult struct {
+	Ids         []string `json:"ids"`
+	Transferred int      `json:"transferred"`
+}
+
+// UpdateQrCodeRequest An empty fallbackUrl removes it.
+type UpdateQrCodeRequest struct {
+	Active          *bool            `json:"active,omitempty"`
+	AppLink         *AppLink         `json:"appLink,omitempty"`
+	Campaign        *string          `json:"campaign,omitempty"`
+	DestinationType *DestinationType `json:"destinationType,omitempty"`
+
+	// FallbackUrl Where scans go while the code is inactive (https), ahead of the owner's defaultRedirectUrl.
+	FallbackUrl *string `json:"fallbackUrl,omitempty"`
+
+	// Frame Call-to-action frame drawn around the code in every export format.
+	// Captions are set in fonts embedded in the service and drawn as
+	// outlines, so files need no fonts installed. Bad values get 400
+	// `frame_invalid`; send {} to remove the frame.
+	Frame *QrFrame `json:"frame,omitempty"`
+	Label *string  `json:"label,omitempty"`
+
+	// LandingPage Hosted page click-service shows while the code is inactive and has no
+	// fallbackUrl. Empty fields get generic text; send {} to remove the page.
+	LandingPage *LandingPage `json:"landingPage,omitempty"`
+
+	// Style How the code is drawn; omitted fields mean plain black on white at
+	// error correction level M. Saving a style renders it and decodes it at
+	// several simulated print sizes and blur levels. A style that can't be
+	// read even large and sharp is refused with 422 `style_unscannable`;
+	// malformed values get 400 `style_invalid`.
+	Style *QrStyle `json:"style,omitempty"`
+
+	// Symbology Barcode the tracking link is printed as; every one is tracked the
+	// same way. Data Matrix, Aztec, PDF417 and Code 128 take no logo, and
+	// Code 128 holds at most 80 characters, so a tracking link that doesn't
+	// fit is refused with 422 `symbology_capacity_exceeded`. Unknown values
+	// get 400 `symbology_invalid`.
+	Symbology *Symbology `json:"symbology,omitempty"`
+	Tags      *[]string  `json:"tags,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+
+	// Utm Values may contain the placeholders {qr_id}, {label}, {campaign},
+	// {country} and {date}, expanded by click-service at redirect time.
+	Utm *UtmTemplate `json:"utm,omitempty"`
+}
+
+// UtmTemplate Values may contain the placeholders {qr_id}, {label}, {campaign},
+// {country} and {date}, expanded by click-service at redirect time.
+type UtmTemplate struct {
+	Campaign *string `json:"campaign,omitempty"`
+	Content  *string `json:"content,omitempty"`
+	Medium   *string `json:"medium,omitempty"`
+	Source   *string `json:"source,omitempty"`
+	Term     *string `json:"term,omitempty"`
+}
+
+// AdminKey defines model for AdminKey.
+type AdminKey = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// ImageDpi defines model for ImageDpi.
+type ImageDpi = float32
+
+// ImageFormat defines model for ImageFormat.
+type ImageFormat string
+
+// ImageQuietZone defines model for ImageQuietZone.
+type ImageQuietZone = int
+
+// ImageSize defines model for ImageSize.
+type ImageSize = float32
+
+// ImageUnit defines model for ImageUnit.
+type ImageUnit string
+
+// QrCodeId defines model for QrCodeId.
+type QrCodeId = string
+
+// TemplateId defines model for TemplateId.
+type TemplateId = string
+
+// WorkspaceId defines model for WorkspaceId.
+type WorkspaceId = string
+
+// StyleUnscannable defines model for StyleUnscannable.
+type StyleUnscannable struct {
+	Error string `json:"error"`
+
+	// Scannability How well the code's style scans, measured when it was saved.
+	Scannability *ScanReport `json:"scannability,omitempty"`
+}
+
+// AdminGenerateSampleDataParams defines parameters for AdminGenerateSampleData.
+type AdminGenerateSampleDataParams struct {
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminSearchQrCodesParams defines parameters for AdminSearchQrCodes.
+type AdminSearchQrCodesParams struct {
+	// Q Case-insensitive substring of the label or URL.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Domain Destination host, including its subdomains.
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty"`
+
+	// Label Case-insensitive substring of the label.
+	Label    *string `form:"label,omitempty" json:"label,omitempty"`
+	OwnerId  *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+	Campaign *string `form:"campaign,omitempty" json:"campaign,omitempty"`
+	Active   *bool   `form:"active,omitempty" json:"active,omitempty"`
+
+	// Disabled Only codes an admin has (true) or hasn't (false) disabled.
+	Disabled  *bool     `form:"disabled,omitempty" json:"disabled,omitempty"`
+	Limit     *int      `form:"limit,omitempty" json:"limit,omitempty"`
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminTransferQrCodesParams defines parameters for AdminTransferQrCodes.
+type AdminTransferQrCodesParams struct {
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminDisableQrCodeParams defines parameters for AdminDisableQrCode.
+type AdminDisableQrCodeParams struct {
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminEnableQrCodeParams defines parameters for AdminEnableQrCode.
+type AdminEnableQrCodeParams struct {
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminGetUsageParams defines parameters for AdminGetUsage.
+type AdminGetUsageParams struct {
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// UserType Only owners on this plan. Without user-service, the plan every
+	// owner is compared against (default free).
+	UserType  *AdminGetUsageParamsUserType `form:"userType,omitempty" json:"userType,omitempty"`
+	XAdminKey *AdminKey                    `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminGetUsageParamsUserType defines parameters for AdminGetUsage.
+type AdminGetUsageParamsUserType string
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// After Return events with IDs above this; 0 replays from the start.
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Wait Seconds to wait for a new event when there is none yet.
+	Wait      *int      `form:"wait,omitempty" json:"wait,omitempty"`
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// ListQrCodesParams defines parameters for ListQrCodes.
+type ListQrCodesParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// CreateQrCodeParams defines parameters for CreateQrCode.
+type CreateQrCodeParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// BulkQrCodesParams defines parameters for BulkQrCodes.
+type BulkQrCodesParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// ExportBackupParams defines parameters for ExportBackup.
+type ExportBackupParams struct {
+	Format *ExportBackupParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// ExportBackupParamsFormat defines parameters for ExportBackup.
+type ExportBackupParamsFormat string
+
+// ExportPdfParams defines parameters for ExportPdf.
+type ExportPdfParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// ImportQrCodesParams defines parameters for ImportQrCodes.
+type ImportQrCodesParams struct {
+	// DryRun Validate and report without creating anything.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// CloneQrCodeParams defines parameters for CloneQrCode.
+type CloneQrCodeParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// GetQrCodeImageParams defines parameters for GetQrCodeImage.
+type GetQrCodeImageParams struct {
+	Format *GetQrCodeImageParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	Size   *ImageSize                  `form:"size,omitempty" json:"size,omitempty"`
+	Unit   *GetQrCodeImageParamsUnit   `form:"unit,omitempty" json:"unit,omitempty"`
+
+	// Dpi PNG resolution; ignored by vector formats.
+	Dpi *ImageDpi `form:"dpi,omitempty" json:"dpi,omitempty"`
+
+	// QuietZone Light border in modules; defaults to the symbology's own (QR 4, Data Matrix 1, Aztec and PDF417 2, Code 128 10).
+	QuietZone *ImageQuietZone `form:"quietZone,omitempty" json:"quietZone,omitempty"`
+
+	// IfNoneMatch ETags the caller already has; a match answers 304.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetQrCodeImageParamsFormat defines parameters for GetQrCodeImage.
+type GetQrCodeImageParamsFormat string
+
+// GetQrCodeImageParamsUnit defines parameters for GetQrCodeImage.
+type GetQrCodeImageParamsUnit string
+
+// GetQrCodeImageByDigestParams defines parameters for GetQrCodeImageByDigest.
+type GetQrCodeImageByDigestParams struct {
+	Format *GetQrCodeImageByDigestParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	Size   *ImageSize                          `form:"size,omitempty" json:"size,omitempty"`
+	Unit   *GetQrCodeImageByDigestParamsUnit   `form:"unit,omitempty" json:"unit,omitempty"`
+
+	// Dpi PNG resolution; ignored by vector formats.
+	Dpi *ImageDpi `form:"dpi,omitempty" json:"dpi,omitempty"`
+
+	// QuietZone Light border in modules; defaults to the symbology's own (QR 4, Data Matrix 1, Aztec and PDF417 2, Code 128 10).
+	QuietZone *ImageQuietZone `form:"quietZone,omitempty" json:"quietZone,omitempty"`
+
+	// IfNoneMatch ETags the caller already has; a match answers 304.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetQrCodeImageByDigestParamsFormat defines parameters for GetQrCodeImageByDigest.
+type GetQrCodeImageByDigestParamsFormat string
+
+// GetQrCodeImageByDigestParamsUnit defines parameters for GetQrCodeImageByDigest.
+type GetQrCodeImageByDigestParamsUnit string
+
+// ListTemplatesParams defines parameters for ListTemplates.
+type ListTemplatesParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// CreateTemplateParams defines parameters for CreateTemplate.
+type CreateTemplateParams struct {
+	// XWorkspaceId Team workspace the caller is working in; omit for personal codes.
+	// Reading needs the viewer role and writing the editor role. Codes in
+	// a workspace the caller doesn't belong to answer 404.
+	XWorkspaceId *WorkspaceId `json:"X-Workspace-Id,omitempty"`
+}
+
+// GetSettingsParams defines parameters for GetSettings.
+type GetSettingsParams struct {
+	// OwnerId Read this owner's settings instead; needs X-Admin-Key.
+	OwnerId   *string   `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+	XAdminKey *AdminKey `json:"X-Admin-Key,omitempty"`
+}
+
+// AdminTransferQrCodesJSONRequestBody defines body for AdminTransferQrCodes for application/json ContentType.
+type AdminTransferQrCodesJSONRequestBody = TransferRequest
+
+// AdminDisableQrCodeJSONRequestBody defines body for AdminDisableQrCode for application/json ContentType.
+type AdminDisableQrCodeJSONRequestBody = DisableRequest
+
+// CreateQrCodeJSONRequestBody defines body for CreateQrCode for application/json ContentType.
+type CreateQrCodeJSONRequestBody = CreateQrCodeRequest
+
+// BulkQrCodesJSONRequestBody defines body for BulkQrCodes for application/json ContentType.
+type BulkQrCodesJSONRequestBody = BulkRequest
+
+// ExportPdfJSONRequestBody defines body for ExportPdf for application/json ContentType.
+type ExportPdfJSONRequestBody = PdfExportRequest
+
+// UpdateQrCodeJSONRequestBody defines body for UpdateQrCode for application/json ContentType.
+type UpdateQrCodeJSONRequestBody = UpdateQrCodeRequest
+
+// CloneQrCodeJSONRequestBody defines body for CloneQrCode for application/json ContentType.
+type CloneQrCodeJSONRequestBody = CloneQrCodeRequest
+
+// CreateTemplateJSONRequestBody defines body for CreateTemplate for application/json ContentType.
+type CreateTemplateJSONRequestBody = QrTemplateRequest
+
+// UpdateTemplateJSONRequestBody defines body for UpdateTemplate for application/json ContentType.
+type UpdateTemplateJSONRequestBody = QrTemplateRequest
+
+// InstantiateTemplateJSONRequestBody defines body for InstantiateTemplate for application/json ContentType.
+type InstantiateTemplateJSONRequestBody = InstantiateRequest
+
+// UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
+type UpdateSettingsJSONRequestBody = Settings
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AdminGenerateSampleData request
+	AdminGenerateSampleData(ctx context.Context, params *AdminGenerateSampleDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSearchQrCodes request
+	AdminSearchQrCodes(ctx context.Context, params *AdminSearchQrCodesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminTransferQrCodesWithBody request with any body
+	AdminTransferQrCodesWithBody(ctx context.Context, params *AdminTransferQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminTransferQrCodes(ctx context.Context, params *AdminTransferQrCodesParams, body AdminTransferQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDisableQrCodeWithBody request with any body
+	AdminDisableQrCodeWithBody(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminDisableQrCode(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, body AdminDisableQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminEnableQrCode request
+	AdminEnableQrCode(ctx context.Context, id QrCodeId, params *AdminEnableQrCodeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetUsage request
+	AdminGetUsage(ctx context.Context, params *AdminGetUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DevGenerateSampleData request
+	DevGenerateSampleData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEvents request
+	ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListQrCodes request
+	ListQrCodes(ctx context.Context, params *ListQrCodesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateQrCodeWithBody request with any body
+	CreateQrCodeWithBody(ctx context.Context, params *CreateQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateQrCode(ctx context.Context, params *CreateQrCodeParams, body CreateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkQrCodesWithBody request with any body
+	BulkQrCodesWithBody(ctx context.Context, params *BulkQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkQrCodes(ctx context.Context, params *BulkQrCodesParams, body BulkQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DecodeQrCodesWithBody request with any body
+	DecodeQrCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportBackup request
+	ExportBackup(ctx context.Context, params *ExportBackupParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPdfWithBody request with any body
+	ExportPdfWithBody(ctx context.Context, params *ExportPdfParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportPdf(ctx context.Context, params *ExportPdfParams, body ExportPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportQrCodesWithBody request with any body
+	ImportQrCodesWithBody(ctx context.Context, params *ImportQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteQrCode request
+	DeleteQrCode(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQrCode request
+	GetQrCode(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateQrCodeWithBody request with any body
+	UpdateQrCodeWithBody(ctx context.Context, id QrCodeId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateQrCode(ctx context.Context, id QrCodeId, body UpdateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneQrCodeWithBody request with any body
+	CloneQrCodeWithBody(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloneQrCode(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, body CloneQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQrCodeImage request
+	GetQrCodeImage(ctx context.Context, id QrCodeId, params *GetQrCodeImageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQrCodeImageByDigest request
+	GetQrCodeImageByDigest(ctx context.Context, id QrCodeId, digest string, params *GetQrCodeImageByDigestParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTemplates request
+	ListTemplates(ctx context.Context, params *ListTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTemplateWithBody request with any body
+	CreateTemplateWithBody(ctx context.Context, params *CreateTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTemplate(ctx context.Context, params *CreateTemplateParams, body CreateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTemplate request
+	DeleteTemplate(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTemplate request
+	GetTemplate(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTemplateWithBody request with any body
+	UpdateTemplateWithBody(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTemplate(ctx context.Context, id TemplateId, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InstantiateTemplateWithBody request with any body
+	InstantiateTemplateWithBody(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InstantiateTemplate(ctx context.Context, id TemplateId, body InstantiateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSettings request
+	GetSettings(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSettingsWithBody request with any body
+	UpdateSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSettings(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AdminGenerateSampleData(ctx context.Context, params *AdminGenerateSampleDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGenerateSampleDataRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminSearchQrCodes(ctx context.Context, params *AdminSearchQrCodesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSearchQrCodesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminTransferQrCodesWithBody(ctx context.Context, params *AdminTransferQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminTransferQrCodesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminTransferQrCodes(ctx context.Context, params *AdminTransferQrCodesParams, body AdminTransferQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminTransferQrCodesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDisableQrCodeWithBody(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDisableQrCodeRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDisableQrCode(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, body AdminDisableQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDisableQrCodeRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminEnableQrCode(ctx context.Context, id QrCodeId, params *AdminEnableQrCodeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminEnableQrCodeRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetUsage(ctx context.Context, params *AdminGetUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetUsageRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DevGenerateSampleData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDevGenerateSampleDataRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListQrCodes(ctx context.Context, params *ListQrCodesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListQrCodesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateQrCodeWithBody(ctx context.Context, params *CreateQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateQrCodeRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateQrCode(ctx context.Context, params *CreateQrCodeParams, body CreateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateQrCodeRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkQrCodesWithBody(ctx context.Context, params *BulkQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkQrCodesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkQrCodes(ctx context.Context, params *BulkQrCodesParams, body BulkQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkQrCodesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DecodeQrCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDecodeQrCodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportBackup(ctx context.Context, params *ExportBackupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportBackupRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPdfWithBody(ctx context.Context, params *ExportPdfParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPdfRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPdf(ctx context.Context, params *ExportPdfParams, body ExportPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPdfRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportQrCodesWithBody(ctx context.Context, params *ImportQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportQrCodesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteQrCode(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteQrCodeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetQrCode(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQrCodeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateQrCodeWithBody(ctx context.Context, id QrCodeId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateQrCodeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateQrCode(ctx context.Context, id QrCodeId, body UpdateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateQrCodeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneQrCodeWithBody(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneQrCodeRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneQrCode(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, body CloneQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneQrCodeRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetQrCodeImage(ctx context.Context, id QrCodeId, params *GetQrCodeImageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQrCodeImageRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetQrCodeImageByDigest(ctx context.Context, id QrCodeId, digest string, params *GetQrCodeImageByDigestParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQrCodeImageByDigestRequest(c.Server, id, digest, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTemplates(ctx context.Context, params *ListTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTemplatesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTemplateWithBody(ctx context.Context, params *CreateTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTemplateRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTemplate(ctx context.Context, params *CreateTemplateParams, body CreateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTemplateRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTemplate(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTemplateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTemplate(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTemplateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTemplateWithBody(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTemplateRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTemplate(ctx context.Context, id TemplateId, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTemplateRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InstantiateTemplateWithBody(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInstantiateTemplateRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InstantiateTemplate(ctx context.Context, id TemplateId, body InstantiateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInstantiateTemplateRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSettings(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSettings(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAdminGenerateSampleDataRequest generates requests for AdminGenerateSampleData
+func NewAdminGenerateSampleDataRequest(server string, params *AdminGenerateSampleDataParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/generate-sample-data")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAdminSearchQrCodesRequest generates requests for AdminSearchQrCodes
+func NewAdminSearchQrCodesRequest(server string, params *AdminSearchQrCodesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/qr-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Domain != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "domain", runtime.ParamLocationQuery, *params.Domain); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Label != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "label", runtime.ParamLocationQuery, *params.Label); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OwnerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ownerId", runtime.ParamLocationQuery, *params.OwnerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Campaign != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "campaign", runtime.ParamLocationQuery, *params.Campaign); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Disabled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "disabled", runtime.ParamLocationQuery, *params.Disabled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAdminTransferQrCodesRequest calls the generic AdminTransferQrCodes builder with application/json body
+func NewAdminTransferQrCodesRequest(server string, params *AdminTransferQrCodesParams, body AdminTransferQrCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminTransferQrCodesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewAdminTransferQrCodesRequestWithBody generates requests for AdminTransferQrCodes with any type of body
+func NewAdminTransferQrCodesRequestWithBody(server string, params *AdminTransferQrCodesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/qr-codes/transfer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAdminDisableQrCodeRequest calls the generic AdminDisableQrCode builder with application/json body
+func NewAdminDisableQrCodeRequest(server string, id QrCodeId, params *AdminDisableQrCodeParams, body AdminDisableQrCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminDisableQrCodeRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewAdminDisableQrCodeRequestWithBody generates requests for AdminDisableQrCode with any type of body
+func NewAdminDisableQrCodeRequestWithBody(server string, id QrCodeId, params *AdminDisableQrCodeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/qr-codes/%s/disable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAdminEnableQrCodeRequest generates requests for AdminEnableQrCode
+func NewAdminEnableQrCodeRequest(server string, id QrCodeId, params *AdminEnableQrCodeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/qr-codes/%s/enable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAdminGetUsageRequest generates requests for AdminGetUsage
+func NewAdminGetUsageRequest(server string, params *AdminGetUsageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OwnerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ownerId", runtime.ParamLocationQuery, *params.OwnerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userType", runtime.ParamLocationQuery, *params.UserType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDevGenerateSampleDataRequest generates requests for DevGenerateSampleData
+func NewDevGenerateSampleDataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dev/generate-sample-data")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListEventsRequest generates requests for ListEvents
+func NewListEventsRequest(server string, params *ListEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListQrCodesRequest generates requests for ListQrCodes
+func NewListQrCodesRequest(server string, params *ListQrCodesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateQrCodeRequest calls the generic CreateQrCode builder with application/json body
+func NewCreateQrCodeRequest(server string, params *CreateQrCodeParams, body CreateQrCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateQrCodeRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateQrCodeRequestWithBody generates requests for CreateQrCode with any type of body
+func NewCreateQrCodeRequestWithBody(server string, params *CreateQrCodeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewBulkQrCodesRequest calls the generic BulkQrCodes builder with application/json body
+func NewBulkQrCodesRequest(server string, params *BulkQrCodesParams, body BulkQrCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkQrCodesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewBulkQrCodesRequestWithBody generates requests for BulkQrCodes with any type of body
+func NewBulkQrCodesRequestWithBody(server string, params *BulkQrCodesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDecodeQrCodesRequestWithBody generates requests for DecodeQrCodes with any type of body
+func NewDecodeQrCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/decode")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportBackupRequest generates requests for ExportBackup
+func NewExportBackupRequest(server string, params *ExportBackupParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewExportPdfRequest calls the generic ExportPdf builder with application/json body
+func NewExportPdfRequest(server string, params *ExportPdfParams, body ExportPdfJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportPdfRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewExportPdfRequestWithBody generates requests for ExportPdf with any type of body
+func NewExportPdfRequestWithBody(server string, params *ExportPdfParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/export/pdf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewImportQrCodesRequestWithBody generates requests for ImportQrCodes with any type of body
+func NewImportQrCodesRequestWithBody(server string, params *ImportQrCodesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteQrCodeRequest generates requests for DeleteQrCode
+func NewDeleteQrCodeRequest(server string, id QrCodeId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetQrCodeRequest generates requests for GetQrCode
+func NewGetQrCodeRequest(server string, id QrCodeId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateQrCodeRequest calls the generic UpdateQrCode builder with application/json body
+func NewUpdateQrCodeRequest(server string, id QrCodeId, body UpdateQrCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateQrCodeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateQrCodeRequestWithBody generates requests for UpdateQrCode with any type of body
+func NewUpdateQrCodeRequestWithBody(server string, id QrCodeId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCloneQrCodeRequest calls the generic CloneQrCode builder with application/json body
+func NewCloneQrCodeRequest(server string, id QrCodeId, params *CloneQrCodeParams, body CloneQrCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloneQrCodeRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewCloneQrCodeRequestWithBody generates requests for CloneQrCode with any type of body
+func NewCloneQrCodeRequestWithBody(server string, id QrCodeId, params *CloneQrCodeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetQrCodeImageRequest generates requests for GetQrCodeImage
+func NewGetQrCodeImageRequest(server string, id QrCodeId, params *GetQrCodeImageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/%s/image", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Unit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Dpi != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dpi", runtime.ParamLocationQuery, *params.Dpi); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.QuietZone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quietZone", runtime.ParamLocationQuery, *params.QuietZone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetQrCodeImageByDigestRequest generates requests for GetQrCodeImageByDigest
+func NewGetQrCodeImageByDigestRequest(server string, id QrCodeId, digest string, params *GetQrCodeImageByDigestParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "digest", runtime.ParamLocationPath, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-codes/%s/image/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Unit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Dpi != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dpi", runtime.ParamLocationQuery, *params.Dpi); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.QuietZone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quietZone", runtime.ParamLocationQuery, *params.QuietZone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListTemplatesRequest generates requests for ListTemplates
+func NewListTemplatesRequest(server string, params *ListTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateTemplateRequest calls the generic CreateTemplate builder with application/json body
+func NewCreateTemplateRequest(server string, params *CreateTemplateParams, body CreateTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTemplateRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTemplateRequestWithBody generates requests for CreateTemplate with any type of body
+func NewCreateTemplateRequestWithBody(server string, params *CreateTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XWorkspaceId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Workspace-Id", runtime.ParamLocationHeader, *params.XWorkspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Workspace-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteTemplateRequest generates requests for DeleteTemplate
+func NewDeleteTemplateRequest(server string, id TemplateId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTemplateRequest generates requests for GetTemplate
+func NewGetTemplateRequest(server string, id TemplateId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTemplateRequest calls the generic UpdateTemplate builder with application/json body
+func NewUpdateTemplateRequest(server string, id TemplateId, body UpdateTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTemplateRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateTemplateRequestWithBody generates requests for UpdateTemplate with any type of body
+func NewUpdateTemplateRequestWithBody(server string, id TemplateId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInstantiateTemplateRequest calls the generic InstantiateTemplate builder with application/json body
+func NewInstantiateTemplateRequest(server string, id TemplateId, body InstantiateTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInstantiateTemplateRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewInstantiateTemplateRequestWithBody generates requests for InstantiateTemplate with any type of body
+func NewInstantiateTemplateRequestWithBody(server string, id TemplateId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/qr-templates/%s/instantiate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string, params *GetSettingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OwnerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ownerId", runtime.ParamLocationQuery, *params.OwnerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAdminKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Key", runtime.ParamLocationHeader, *params.XAdminKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateSettingsRequest calls the generic UpdateSettings builder with application/json body
+func NewUpdateSettingsRequest(server string, body UpdateSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateSettingsRequestWithBody generates requests for UpdateSettings with any type of body
+func NewUpdateSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminGenerateSampleDataWithResponse request
+	AdminGenerateSampleDataWithResponse(ctx context.Context, params *AdminGenerateSampleDataParams, reqEditors ...RequestEditorFn) (*AdminGenerateSampleDataResponse, error)
+
+	// AdminSearchQrCodesWithResponse request
+	AdminSearchQrCodesWithResponse(ctx context.Context, params *AdminSearchQrCodesParams, reqEditors ...RequestEditorFn) (*AdminSearchQrCodesResponse, error)
+
+	// AdminTransferQrCodesWithBodyWithResponse request with any body
+	AdminTransferQrCodesWithBodyWithResponse(ctx context.Context, params *AdminTransferQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminTransferQrCodesResponse, error)
+
+	AdminTransferQrCodesWithResponse(ctx context.Context, params *AdminTransferQrCodesParams, body AdminTransferQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminTransferQrCodesResponse, error)
+
+	// AdminDisableQrCodeWithBodyWithResponse request with any body
+	AdminDisableQrCodeWithBodyWithResponse(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminDisableQrCodeResponse, error)
+
+	AdminDisableQrCodeWithResponse(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, body AdminDisableQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminDisableQrCodeResponse, error)
+
+	// AdminEnableQrCodeWithResponse request
+	AdminEnableQrCodeWithResponse(ctx context.Context, id QrCodeId, params *AdminEnableQrCodeParams, reqEditors ...RequestEditorFn) (*AdminEnableQrCodeResponse, error)
+
+	// AdminGetUsageWithResponse request
+	AdminGetUsageWithResponse(ctx context.Context, params *AdminGetUsageParams, reqEditors ...RequestEditorFn) (*AdminGetUsageResponse, error)
+
+	// DevGenerateSampleDataWithResponse request
+	DevGenerateSampleDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DevGenerateSampleDataResponse, error)
+
+	// ListEventsWithResponse request
+	ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error)
+
+	// ListQrCodesWithResponse request
+	ListQrCodesWithResponse(ctx context.Context, params *ListQrCodesParams, reqEditors ...RequestEditorFn) (*ListQrCodesResponse, error)
+
+	// CreateQrCodeWithBodyWithResponse request with any body
+	CreateQrCodeWithBodyWithResponse(ctx context.Context, params *CreateQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateQrCodeResponse, error)
+
+	CreateQrCodeWithResponse(ctx context.Context, params *CreateQrCodeParams, body CreateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateQrCodeResponse, error)
+
+	// BulkQrCodesWithBodyWithResponse request with any body
+	BulkQrCodesWithBodyWithResponse(ctx context.Context, params *BulkQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkQrCodesResponse, error)
+
+	BulkQrCodesWithResponse(ctx context.Context, params *BulkQrCodesParams, body BulkQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkQrCodesResponse, error)
+
+	// DecodeQrCodesWithBodyWithResponse request with any body
+	DecodeQrCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecodeQrCodesResponse, error)
+
+	// ExportBackupWithResponse request
+	ExportBackupWithResponse(ctx context.Context, params *ExportBackupParams, reqEditors ...RequestEditorFn) (*ExportBackupResponse, error)
+
+	// ExportPdfWithBodyWithResponse request with any body
+	ExportPdfWithBodyWithResponse(ctx context.Context, params *ExportPdfParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportPdfResponse, error)
+
+	ExportPdfWithResponse(ctx context.Context, params *ExportPdfParams, body ExportPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportPdfResponse, error)
+
+	// ImportQrCodesWithBodyWithResponse request with any body
+	ImportQrCodesWithBodyWithResponse(ctx context.Context, params *ImportQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportQrCodesResponse, error)
+
+	// DeleteQrCodeWithResponse request
+	DeleteQrCodeWithResponse(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*DeleteQrCodeResponse, error)
+
+	// GetQrCodeWithResponse request
+	GetQrCodeWithResponse(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*GetQrCodeResponse, error)
+
+	// UpdateQrCodeWithBodyWithResponse request with any body
+	UpdateQrCodeWithBodyWithResponse(ctx context.Context, id QrCodeId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateQrCodeResponse, error)
+
+	UpdateQrCodeWithResponse(ctx context.Context, id QrCodeId, body UpdateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateQrCodeResponse, error)
+
+	// CloneQrCodeWithBodyWithResponse request with any body
+	CloneQrCodeWithBodyWithResponse(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneQrCodeResponse, error)
+
+	CloneQrCodeWithResponse(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, body CloneQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneQrCodeResponse, error)
+
+	// GetQrCodeImageWithResponse request
+	GetQrCodeImageWithResponse(ctx context.Context, id QrCodeId, params *GetQrCodeImageParams, reqEditors ...RequestEditorFn) (*GetQrCodeImageResponse, error)
+
+	// GetQrCodeImageByDigestWithResponse request
+	GetQrCodeImageByDigestWithResponse(ctx context.Context, id QrCodeId, digest string, params *GetQrCodeImageByDigestParams, reqEditors ...RequestEditorFn) (*GetQrCodeImageByDigestResponse, error)
+
+	// ListTemplatesWithResponse request
+	ListTemplatesWithResponse(ctx context.Context, params *ListTemplatesParams, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error)
+
+	// CreateTemplateWithBodyWithResponse request with any body
+	CreateTemplateWithBodyWithResponse(ctx context.Context, params *CreateTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTemplateResponse, error)
+
+	CreateTemplateWithResponse(ctx context.Context, params *CreateTemplateParams, body CreateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTemplateResponse, error)
+
+	// DeleteTemplateWithResponse request
+	DeleteTemplateWithResponse(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*DeleteTemplateResponse, error)
+
+	// GetTemplateWithResponse request
+	GetTemplateWithResponse(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*GetTemplateResponse, error)
+
+	// UpdateTemplateWithBodyWithResponse request with any body
+	UpdateTemplateWithBodyWithResponse(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error)
+
+	UpdateTemplateWithResponse(ctx context.Context, id TemplateId, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error)
+
+	// InstantiateTemplateWithBodyWithResponse request with any body
+	InstantiateTemplateWithBodyWithResponse(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InstantiateTemplateResponse, error)
+
+	InstantiateTemplateWithResponse(ctx context.Context, id TemplateId, body InstantiateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*InstantiateTemplateResponse, error)
+
+	// GetSettingsWithResponse request
+	GetSettingsWithResponse(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+
+	// UpdateSettingsWithBodyWithResponse request with any body
+	UpdateSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error)
+
+	UpdateSettingsWithResponse(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+}
+
+type AdminGenerateSampleDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SampleDataResult
+	JSON401      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGenerateSampleDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGenerateSampleDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSearchQrCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]QrCode
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSearchQrCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSearchQrCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminTransferQrCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminTransferQrCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminTransferQrCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDisableQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QrCode
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDisableQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDisableQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminEnableQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QrCode
+	JSON401      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminEnableQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminEnableQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OwnerUsage
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DevGenerateSampleDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SampleDataResult
+	JSON401      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DevGenerateSampleDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DevGenerateSampleDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventsPage
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListQrCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]QrCode
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListQrCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListQrCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *QrCode
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON422      *StyleUnscannable
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkQrCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkResult
+	JSON400      *BulkError
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkQrCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkQrCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DecodeQrCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DecodeResult
+	JSON400      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DecodeQrCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DecodeQrCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Backup
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportPdfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPdfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPdfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportQrCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON201      *ImportReport
+	JSON400      *ImportError
+	JSON403      *Error
+	JSON404      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportQrCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportQrCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QrCode
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QrCode
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON422      *StyleUnscannable
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneQrCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *QrCode
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneQrCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneQrCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQrCodeImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQrCodeImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQrCodeImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQrCodeImageByDigestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQrCodeImageByDigestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQrCodeImageByDigestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]QrTemplate
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *QrTemplate
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QrTemplate
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QrTemplate
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InstantiateTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *[]QrCode
+	JSON400      *InstanceError
+	JSON403      *Error
+	JSON404      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON502      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InstantiateTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InstantiateTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSON403      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSON400      *Error
+	JSON415      *Error
+	JSON500      *Error
+	JSON503      *Error
+	JSON504      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AdminGenerateSampleDataWithResponse request returning *AdminGenerateSampleDataResponse
+func (c *ClientWithResponses) AdminGenerateSampleDataWithResponse(ctx context.Context, params *AdminGenerateSampleDataParams, reqEditors ...RequestEditorFn) (*AdminGenerateSampleDataResponse, error) {
+	rsp, err := c.AdminGenerateSampleData(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGenerateSampleDataResponse(rsp)
+}
+
+// AdminSearchQrCodesWithResponse request returning *AdminSearchQrCodesResponse
+func (c *ClientWithResponses) AdminSearchQrCodesWithResponse(ctx context.Context, params *AdminSearchQrCodesParams, reqEditors ...RequestEditorFn) (*AdminSearchQrCodesResponse, error) {
+	rsp, err := c.AdminSearchQrCodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSearchQrCodesResponse(rsp)
+}
+
+// AdminTransferQrCodesWithBodyWithResponse request with arbitrary body returning *AdminTransferQrCodesResponse
+func (c *ClientWithResponses) AdminTransferQrCodesWithBodyWithResponse(ctx context.Context, params *AdminTransferQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminTransferQrCodesResponse, error) {
+	rsp, err := c.AdminTransferQrCodesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminTransferQrCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminTransferQrCodesWithResponse(ctx context.Context, params *AdminTransferQrCodesParams, body AdminTransferQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminTransferQrCodesResponse, error) {
+	rsp, err := c.AdminTransferQrCodes(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminTransferQrCodesResponse(rsp)
+}
+
+// AdminDisableQrCodeWithBodyWithResponse request with arbitrary body returning *AdminDisableQrCodeResponse
+func (c *ClientWithResponses) AdminDisableQrCodeWithBodyWithResponse(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminDisableQrCodeResponse, error) {
+	rsp, err := c.AdminDisableQrCodeWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDisableQrCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminDisableQrCodeWithResponse(ctx context.Context, id QrCodeId, params *AdminDisableQrCodeParams, body AdminDisableQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminDisableQrCodeResponse, error) {
+	rsp, err := c.AdminDisableQrCode(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDisableQrCodeResponse(rsp)
+}
+
+// AdminEnableQrCodeWithResponse request returning *AdminEnableQrCodeResponse
+func (c *ClientWithResponses) AdminEnableQrCodeWithResponse(ctx context.Context, id QrCodeId, params *AdminEnableQrCodeParams, reqEditors ...RequestEditorFn) (*AdminEnableQrCodeResponse, error) {
+	rsp, err := c.AdminEnableQrCode(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminEnableQrCodeResponse(rsp)
+}
+
+// AdminGetUsageWithResponse request returning *AdminGetUsageResponse
+func (c *ClientWithResponses) AdminGetUsageWithResponse(ctx context.Context, params *AdminGetUsageParams, reqEditors ...RequestEditorFn) (*AdminGetUsageResponse, error) {
+	rsp, err := c.AdminGetUsage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetUsageResponse(rsp)
+}
+
+// DevGenerateSampleDataWithResponse request returning *DevGenerateSampleDataResponse
+func (c *ClientWithResponses) DevGenerateSampleDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DevGenerateSampleDataResponse, error) {
+	rsp, err := c.DevGenerateSampleData(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDevGenerateSampleDataResponse(rsp)
+}
+
+// ListEventsWithResponse request returning *ListEventsResponse
+func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error) {
+	rsp, err := c.ListEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventsResponse(rsp)
+}
+
+// ListQrCodesWithResponse request returning *ListQrCodesResponse
+func (c *ClientWithResponses) ListQrCodesWithResponse(ctx context.Context, params *ListQrCodesParams, reqEditors ...RequestEditorFn) (*ListQrCodesResponse, error) {
+	rsp, err := c.ListQrCodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListQrCodesResponse(rsp)
+}
+
+// CreateQrCodeWithBodyWithResponse request with arbitrary body returning *CreateQrCodeResponse
+func (c *ClientWithResponses) CreateQrCodeWithBodyWithResponse(ctx context.Context, params *CreateQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateQrCodeResponse, error) {
+	rsp, err := c.CreateQrCodeWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateQrCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateQrCodeWithResponse(ctx context.Context, params *CreateQrCodeParams, body CreateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateQrCodeResponse, error) {
+	rsp, err := c.CreateQrCode(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateQrCodeResponse(rsp)
+}
+
+// BulkQrCodesWithBodyWithResponse request with arbitrary body returning *BulkQrCodesResponse
+func (c *ClientWithResponses) BulkQrCodesWithBodyWithResponse(ctx context.Context, params *BulkQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkQrCodesResponse, error) {
+	rsp, err := c.BulkQrCodesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkQrCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkQrCodesWithResponse(ctx context.Context, params *BulkQrCodesParams, body BulkQrCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkQrCodesResponse, error) {
+	rsp, err := c.BulkQrCodes(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkQrCodesResponse(rsp)
+}
+
+// DecodeQrCodesWithBodyWithResponse request with arbitrary body returning *DecodeQrCodesResponse
+func (c *ClientWithResponses) DecodeQrCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecodeQrCodesResponse, error) {
+	rsp, err := c.DecodeQrCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDecodeQrCodesResponse(rsp)
+}
+
+// ExportBackupWithResponse request returning *ExportBackupResponse
+func (c *ClientWithResponses) ExportBackupWithResponse(ctx context.Context, params *ExportBackupParams, reqEditors ...RequestEditorFn) (*ExportBackupResponse, error) {
+	rsp, err := c.ExportBackup(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportBackupResponse(rsp)
+}
+
+// ExportPdfWithBodyWithResponse request with arbitrary body returning *ExportPdfResponse
+func (c *ClientWithResponses) ExportPdfWithBodyWithResponse(ctx context.Context, params *ExportPdfParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportPdfResponse, error) {
+	rsp, err := c.ExportPdfWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPdfResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExportPdfWithResponse(ctx context.Context, params *ExportPdfParams, body ExportPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportPdfResponse, error) {
+	rsp, err := c.ExportPdf(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPdfResponse(rsp)
+}
+
+// ImportQrCodesWithBodyWithResponse request with arbitrary body returning *ImportQrCodesResponse
+func (c *ClientWithResponses) ImportQrCodesWithBodyWithResponse(ctx context.Context, params *ImportQrCodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportQrCodesResponse, error) {
+	rsp, err := c.ImportQrCodesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportQrCodesResponse(rsp)
+}
+
+// DeleteQrCodeWithResponse request returning *DeleteQrCodeResponse
+func (c *ClientWithResponses) DeleteQrCodeWithResponse(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*DeleteQrCodeResponse, error) {
+	rsp, err := c.DeleteQrCode(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteQrCodeResponse(rsp)
+}
+
+// GetQrCodeWithResponse request returning *GetQrCodeResponse
+func (c *ClientWithResponses) GetQrCodeWithResponse(ctx context.Context, id QrCodeId, reqEditors ...RequestEditorFn) (*GetQrCodeResponse, error) {
+	rsp, err := c.GetQrCode(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQrCodeResponse(rsp)
+}
+
+// UpdateQrCodeWithBodyWithResponse request with arbitrary body returning *UpdateQrCodeResponse
+func (c *ClientWithResponses) UpdateQrCodeWithBodyWithResponse(ctx context.Context, id QrCodeId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateQrCodeResponse, error) {
+	rsp, err := c.UpdateQrCodeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateQrCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateQrCodeWithResponse(ctx context.Context, id QrCodeId, body UpdateQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateQrCodeResponse, error) {
+	rsp, err := c.UpdateQrCode(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateQrCodeResponse(rsp)
+}
+
+// CloneQrCodeWithBodyWithResponse request with arbitrary body returning *CloneQrCodeResponse
+func (c *ClientWithResponses) CloneQrCodeWithBodyWithResponse(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneQrCodeResponse, error) {
+	rsp, err := c.CloneQrCodeWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneQrCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloneQrCodeWithResponse(ctx context.Context, id QrCodeId, params *CloneQrCodeParams, body CloneQrCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneQrCodeResponse, error) {
+	rsp, err := c.CloneQrCode(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneQrCodeResponse(rsp)
+}
+
+// GetQrCodeImageWithResponse request returning *GetQrCodeImageResponse
+func (c *ClientWithResponses) GetQrCodeImageWithResponse(ctx context.Context, id QrCodeId, params *GetQrCodeImageParams, reqEditors ...RequestEditorFn) (*GetQrCodeImageResponse, error) {
+	rsp, err := c.GetQrCodeImage(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQrCodeImageResponse(rsp)
+}
+
+// GetQrCodeImageByDigestWithResponse request returning *GetQrCodeImageByDigestResponse
+func (c *ClientWithResponses) GetQrCodeImageByDigestWithResponse(ctx context.Context, id QrCodeId, digest string, params *GetQrCodeImageByDigestParams, reqEditors ...RequestEditorFn) (*GetQrCodeImageByDigestResponse, error) {
+	rsp, err := c.GetQrCodeImageByDigest(ctx, id, digest, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQrCodeImageByDigestResponse(rsp)
+}
+
+// ListTemplatesWithResponse request returning *ListTemplatesResponse
+func (c *ClientWithResponses) ListTemplatesWithResponse(ctx context.Context, params *ListTemplatesParams, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error) {
+	rsp, err := c.ListTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTemplatesResponse(rsp)
+}
+
+// CreateTemplateWithBodyWithResponse request with arbitrary body returning *CreateTemplateResponse
+func (c *ClientWithResponses) CreateTemplateWithBodyWithResponse(ctx context.Context, params *CreateTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTemplateResponse, error) {
+	rsp, err := c.CreateTemplateWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTemplateWithResponse(ctx context.Context, params *CreateTemplateParams, body CreateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTemplateResponse, error) {
+	rsp, err := c.CreateTemplate(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTemplateResponse(rsp)
+}
+
+// DeleteTemplateWithResponse request returning *DeleteTemplateResponse
+func (c *ClientWithResponses) DeleteTemplateWithResponse(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*DeleteTemplateResponse, error) {
+	rsp, err := c.DeleteTemplate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTemplateResponse(rsp)
+}
+
+// GetTemplateWithResponse request returning *GetTemplateResponse
+func (c *ClientWithResponses) GetTemplateWithResponse(ctx context.Context, id TemplateId, reqEditors ...RequestEditorFn) (*GetTemplateResponse, error) {
+	rsp, err := c.GetTemplate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTemplateResponse(rsp)
+}
+
+// UpdateTemplateWithBodyWithResponse request with arbitrary body returning *UpdateTemplateResponse
+func (c *ClientWithResponses) UpdateTemplateWithBodyWithResponse(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error) {
+	rsp, err := c.UpdateTemplateWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTemplateWithResponse(ctx context.Context, id TemplateId, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error) {
+	rsp, err := c.UpdateTemplate(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTemplateResponse(rsp)
+}
+
+// InstantiateTemplateWithBodyWithResponse request with arbitrary body returning *InstantiateTemplateResponse
+func (c *ClientWithResponses) InstantiateTemplateWithBodyWithResponse(ctx context.Context, id TemplateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InstantiateTemplateResponse, error) {
+	rsp, err := c.InstantiateTemplateWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInstantiateTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) InstantiateTemplateWithResponse(ctx context.Context, id TemplateId, body InstantiateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*InstantiateTemplateResponse, error) {
+	rsp, err := c.InstantiateTemplate(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInstantiateTemplateResponse(rsp)
+}
+
+// GetSettingsWithResponse request returning *GetSettingsResponse
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
+	rsp, err := c.GetSettings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsResponse(rsp)
+}
+
+// UpdateSettingsWithBodyWithResponse request with arbitrary body returning *UpdateSettingsResponse
+func (c *ClientWithResponses) UpdateSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error) {
+	rsp, err := c.UpdateSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSettingsWithResponse(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error) {
+	rsp, err := c.UpdateSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// ParseAdminGenerateSampleDataResponse parses an HTTP response from a AdminGenerateSampleDataWithResponse call
+func ParseAdminGenerateSampleDataResponse(rsp *http.Response) (*AdminGenerateSampleDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGenerateSampleDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SampleDataResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminSearchQrCodesResponse parses an HTTP response from a AdminSearchQrCodesWithResponse call
+func ParseAdminSearchQrCodesResponse(rsp *http.Response) (*AdminSearchQrCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSearchQrCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminTransferQrCodesResponse parses an HTTP response from a AdminTransferQrCodesWithResponse call
+func ParseAdminTransferQrCodesResponse(rsp *http.Response) (*AdminTransferQrCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminTransferQrCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDisableQrCodeResponse parses an HTTP response from a AdminDisableQrCodeWithResponse call
+func ParseAdminDisableQrCodeResponse(rsp *http.Response) (*AdminDisableQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDisableQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminEnableQrCodeResponse parses an HTTP response from a AdminEnableQrCodeWithResponse call
+func ParseAdminEnableQrCodeResponse(rsp *http.Response) (*AdminEnableQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminEnableQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetUsageResponse parses an HTTP response from a AdminGetUsageWithResponse call
+func ParseAdminGetUsageResponse(rsp *http.Response) (*AdminGetUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OwnerUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDevGenerateSampleDataResponse parses an HTTP response from a DevGenerateSampleDataWithResponse call
+func ParseDevGenerateSampleDataResponse(rsp *http.Response) (*DevGenerateSampleDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DevGenerateSampleDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SampleDataResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEventsResponse parses an HTTP response from a ListEventsWithResponse call
+func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListQrCodesResponse parses an HTTP response from a ListQrCodesWithResponse call
+func ParseListQrCodesResponse(rsp *http.Response) (*ListQrCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListQrCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateQrCodeResponse parses an HTTP response from a CreateQrCodeWithResponse call
+func ParseCreateQrCodeResponse(rsp *http.Response) (*CreateQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest StyleUnscannable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkQrCodesResponse parses an HTTP response from a BulkQrCodesWithResponse call
+func ParseBulkQrCodesResponse(rsp *http.Response) (*BulkQrCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkQrCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BulkError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDecodeQrCodesResponse parses an HTTP response from a DecodeQrCodesWithResponse call
+func ParseDecodeQrCodesResponse(rsp *http.Response) (*DecodeQrCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DecodeQrCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DecodeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportBackupResponse parses an HTTP response from a ExportBackupWithResponse call
+func ParseExportBackupResponse(rsp *http.Response) (*ExportBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Backup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPdfResponse parses an HTTP response from a ExportPdfWithResponse call
+func ParseExportPdfResponse(rsp *http.Response) (*ExportPdfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPdfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportQrCodesResponse parses an HTTP response from a ImportQrCodesWithResponse call
+func ParseImportQrCodesResponse(rsp *http.Response) (*ImportQrCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportQrCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ImportError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteQrCodeResponse parses an HTTP response from a DeleteQrCodeWithResponse call
+func ParseDeleteQrCodeResponse(rsp *http.Response) (*DeleteQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetQrCodeResponse parses an HTTP response from a GetQrCodeWithResponse call
+func ParseGetQrCodeResponse(rsp *http.Response) (*GetQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateQrCodeResponse parses an HTTP response from a UpdateQrCodeWithResponse call
+func ParseUpdateQrCodeResponse(rsp *http.Response) (*UpdateQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest StyleUnscannable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloneQrCodeResponse parses an HTTP response from a CloneQrCodeWithResponse call
+func ParseCloneQrCodeResponse(rsp *http.Response) (*CloneQrCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloneQrCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetQrCodeImageResponse parses an HTTP response from a GetQrCodeImageWithResponse call
+func ParseGetQrCodeImageResponse(rsp *http.Response) (*GetQrCodeImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQrCodeImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetQrCodeImageByDigestResponse parses an HTTP response from a GetQrCodeImageByDigestWithResponse call
+func ParseGetQrCodeImageByDigestResponse(rsp *http.Response) (*GetQrCodeImageByDigestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQrCodeImageByDigestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTemplatesResponse parses an HTTP response from a ListTemplatesWithResponse call
+func ParseListTemplatesResponse(rsp *http.Response) (*ListTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []QrTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTemplateResponse parses an HTTP response from a CreateTemplateWithResponse call
+func ParseCreateTemplateResponse(rsp *http.Response) (*CreateTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest QrTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTemplateResponse parses an HTTP response from a DeleteTemplateWithResponse call
+func ParseDeleteTemplateResponse(rsp *http.Response) (*DeleteTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplateResponse parses an HTTP response from a GetTemplateWithResponse call
+func ParseGetTemplateResponse(rsp *http.Response) (*GetTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QrTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTemplateResponse parses an HTTP response from a UpdateTemplateWithResponse call
+func ParseUpdateTemplateResponse(rsp *http.Response) (*UpdateTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QrTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInstantiateTemplateResponse parses an HTTP response from a InstantiateTemplateWithResponse call
+func ParseInstantiateTemplateResponse(rsp *http.Response) (*InstantiateTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InstantiateTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest []QrCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InstanceError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSettingsResponse parses an HTTP response from a GetSettingsWithResponse call
+func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSettingsResponse parses an HTTP response from a UpdateSettingsWithResponse call
+func ParseUpdateSettingsResponse(rsp *http.Response) (*UpdateSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,45 @@
+package qrapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateQrCode_SendsHeadersAndDecodes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/qr-codes" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("X-Workspace-Id"); got != "ws1" {
+			t.Errorf("expected X-Workspace-Id ws1, got %q", got)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("expected JSON content type, got %q", ct)
+		}
+		var body CreateQrCodeRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id": "abc", "label": "Menu", "url": body.Url, "active": true,
+			"destinationType": "url", "createdAtIso": "2026-01-02T03:04:05Z",
+		})
+	}))
+	defer srv.Close()
+
+	c, err := NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	workspaceID := "ws1"
+	resp, err := c.CreateQrCodeWithResponse(context.Background(), &CreateQrCodeParams{XWorkspaceId: &workspaceID}, CreateQrCodeRequest{Url: "https://example.com"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if resp.JSON201 == nil || resp.JSON201.Id != "abc" || resp.JSON201.Url != "https://example.com" {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode(), resp.Body)
+	}
+}
@@ -0,0 +1,5 @@
+// Package qrapi is a client for qr-service, generated from
+// qr-service/internal/httpapi/openapi.yaml.
+package qrapi
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 -config oapi-codegen.yaml ../../qr-service/internal/httpapi/openapi.yaml
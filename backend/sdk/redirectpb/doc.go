@@ -0,0 +1,5 @@
+// Package redirectpb is generated from proto/redirect/v1/redirect.proto, the
+// internal API qr-service serves and click-service calls.
+package redirectpb
+
+//go:generate protoc -I ../../proto --go_out=. --go_opt=module=github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb --go_opt=Mredirect/v1/redirect.proto=github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb --go-grpc_out=. --go-grpc_opt=module=github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb --go-grpc_opt=Mredirect/v1/redirect.proto=github.com/qr-dragonfly/qr-dragonfly/backend/sdk/redirectpb redirect/v1/redirect.proto
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: redirect/v1/redirect.proto
+
+// Internal API between click-service and qr-service. Not exposed publicly.
+
+package redirectpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveRedirectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QrCodeId      string                 `protobuf:"bytes,1,opt,name=qr_code_id,json=qrCodeId,proto3" json:"qr_code_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveRedirectRequest) Reset() {
+	*x = ResolveRedirectRequest{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveRedirectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRedirectRequest) ProtoMessage() {}
+
+func (x *ResolveRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRedirectRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveRedirectRequest) GetQrCodeId() string {
+	if x != nil {
+		return x.QrCodeId
+	}
+	return ""
+}
+
+type ResolveRedirectResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QrCode *QrCode                `protobuf:"bytes,1,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	// Settings of the code's owner: the inactive-code fallback and campaign
+	// UTM templates.
+	Settings      *Settings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveRedirectResponse) Reset() {
+	*x = ResolveRedirectResponse{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveRedirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRedirectResponse) ProtoMessage() {}
+
+func (x *ResolveRedirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRedirectResponse.ProtoReflect.Descriptor instead.
+func (*ResolveRedirectResponse) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveRedirectResponse) GetQrCode() *QrCode {
+	if x != nil {
+		return x.QrCode
+	}
+	return nil
+}
+
+func (x *ResolveRedirectResponse) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type QrCode struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId  string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Label    string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Url      string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Active   bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	Campaign string                 `protobuf:"bytes,6,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Utm      *UtmTemplate           `protobuf:"bytes,7,opt,name=utm,proto3" json:"utm,omitempty"`
+	// "url" or "app_link".
+	DestinationType string   `protobuf:"bytes,8,opt,name=destination_type,json=destinationType,proto3" json:"destination_type,omitempty"`
+	AppLink         *AppLink `protobuf:"bytes,9,opt,name=app_link,json=appLink,proto3" json:"app_link,omitempty"`
+	// Empty for personal codes.
+	WorkspaceId string `protobuf:"bytes,10,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// Where to send scans while the code is inactive, ahead of
+	// Settings.default_redirect_url.
+	FallbackUrl string `protobuf:"bytes,11,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	// Shown while the code is inactive and has no fallback URL.
+	LandingPage *LandingPage `protobuf:"bytes,12,opt,name=landing_page,json=landingPage,proto3" json:"landing_page,omitempty"`
+	// Why an admin disabled the code; empty unless moderated. Scans of a
+	// moderated code get a generic page, never the owner's fallback URL,
+	// landing page or default URL.
+	DisabledReason string `protobuf:"bytes,13,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QrCode) Reset() {
+	*x = QrCode{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QrCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QrCode) ProtoMessage() {}
+
+func (x *QrCode) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QrCode.ProtoReflect.Descriptor instead.
+func (*QrCode) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QrCode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QrCode) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *QrCode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *QrCode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *QrCode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *QrCode) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *QrCode) GetUtm() *UtmTemplate {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *QrCode) GetDestinationType() string {
+	if x != nil {
+		return x.DestinationType
+	}
+	return ""
+}
+
+func (x *QrCode) GetAppLink() *AppLink {
+	if x != nil {
+		return x.AppLink
+	}
+	return nil
+}
+
+func (x *QrCode) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *QrCode) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *QrCode) GetLandingPage() *LandingPage {
+	if x != nil {
+		return x.LandingPage
+	}
+	return nil
+}
+
+func (x *QrCode) GetDisabledReason() string {
+	if x != nil {
+		return x.DisabledReason
+	}
+	return ""
+}
+
+type UtmTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Medium        string                 `protobuf:"bytes,2,opt,name=medium,proto3" json:"medium,omitempty"`
+	Campaign      string                 `protobuf:"bytes,3,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Term          string                 `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UtmTemplate) Reset() {
+	*x = UtmTemplate{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UtmTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtmTemplate) ProtoMessage() {}
+
+func (x *UtmTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtmTemplate.ProtoReflect.Descriptor instead.
+func (*UtmTemplate) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UtmTemplate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *UtmTemplate) GetMedium() string {
+	if x != nil {
+		return x.Medium
+	}
+	return ""
+}
+
+func (x *UtmTemplate) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *UtmTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UtmTemplate) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type AppLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IosUrl        string                 `protobuf:"bytes,1,opt,name=ios_url,json=iosUrl,proto3" json:"ios_url,omitempty"`
+	AndroidUrl    string                 `protobuf:"bytes,2,opt,name=android_url,json=androidUrl,proto3" json:"android_url,omitempty"`
+	AppStoreUrl   string                 `protobuf:"bytes,3,opt,name=app_store_url,json=appStoreUrl,proto3" json:"app_store_url,omitempty"`
+	PlayStoreUrl  string                 `protobuf:"bytes,4,opt,name=play_store_url,json=playStoreUrl,proto3" json:"play_store_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppLink) Reset() {
+	*x = AppLink{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLink) ProtoMessage() {}
+
+func (x *AppLink) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppLink.ProtoReflect.Descriptor instead.
+func (*AppLink) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AppLink) GetIosUrl() string {
+	if x != nil {
+		return x.IosUrl
+	}
+	return ""
+}
+
+func (x *AppLink) GetAndroidUrl() string {
+	if x != nil {
+		return x.AndroidUrl
+	}
+	return ""
+}
+
+func (x *AppLink) GetAppStoreUrl() string {
+	if x != nil {
+		return x.AppStoreUrl
+	}
+	return ""
+}
+
+func (x *AppLink) GetPlayStoreUrl() string {
+	if x != nil {
+		return x.PlayStoreUrl
+	}
+	return ""
+}
+
+type LandingPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	ButtonText    string                 `protobuf:"bytes,4,opt,name=button_text,json=buttonText,proto3" json:"button_text,omitempty"`
+	ButtonUrl     string                 `protobuf:"bytes,5,opt,name=button_url,json=buttonUrl,proto3" json:"button_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandingPage) Reset() {
+	*x = LandingPage{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandingPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandingPage) ProtoMessage() {}
+
+func (x *LandingPage) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandingPage.ProtoReflect.Descriptor instead.
+func (*LandingPage) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LandingPage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LandingPage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LandingPage) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *LandingPage) GetButtonText() string {
+	if x != nil {
+		return x.ButtonText
+	}
+	return ""
+}
+
+func (x *LandingPage) GetButtonUrl() string {
+	if x != nil {
+		return x.ButtonUrl
+	}
+	return ""
+}
+
+type Settings struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	DefaultRedirectUrl string                  `protobuf:"bytes,1,opt,name=default_redirect_url,json=defaultRedirectUrl,proto3" json:"default_redirect_url,omitempty"`
+	CampaignUtm        map[string]*UtmTemplate `protobuf:"bytes,2,rep,name=campaign_utm,json=campaignUtm,proto3" json:"campaign_utm,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_redirect_v1_redirect_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_redirect_v1_redirect_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_redirect_v1_redirect_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Settings) GetDefaultRedirectUrl() string {
+	if x != nil {
+		return x.DefaultRedirectUrl
+	}
+	return ""
+}
+
+func (x *Settings) GetCampaignUtm() map[string]*UtmTemplate {
+	if x != nil {
+		return x.CampaignUtm
+	}
+	return nil
+}
+
+var File_redirect_v1_redirect_proto protoreflect.FileDescriptor
+
+var file_redirect_v1_redirect_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x22, 0x36, 0x0a, 0x16, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x22, 0x7a, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07,
+	0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x06, 0x71, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc3, 0x03,
+	0x0a, 0x06, 0x51, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12,
+	0x2a, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x6d, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a,
+	0x0c, 0x6c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x6c,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x55, 0x74, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x64,
+	0x69, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x22, 0x8d, 0x01,
+	0x0a, 0x07, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f, 0x73,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6f, 0x73, 0x55,
+	0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64,
+	0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x98, 0x01,
+	0x0a, 0x0b, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x74, 0x74,
+	0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x75, 0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x74,
+	0x74, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x75, 0x74, 0x74, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0xe1, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x49, 0x0a, 0x0c, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x75, 0x74, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x55, 0x74, 0x6d,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x55,
+	0x74, 0x6d, 0x1a, 0x58, 0x0a, 0x10, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x55, 0x74,
+	0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x6f, 0x0a, 0x0f,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x12, 0x23, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_redirect_v1_redirect_proto_rawDescOnce sync.Once
+	file_redirect_v1_redirect_proto_rawDescData []byte
+)
+
+func file_redirect_v1_redirect_proto_rawDescGZIP() []byte {
+	file_redirect_v1_redirect_proto_rawDescOnce.Do(func() {
+		file_redirect_v1_redirect_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_redirect_v1_redirect_proto_rawDesc), len(file_redirect_v1_redirect_proto_rawDesc)))
+	})
+	return file_redirect_v1_redirect_proto_rawDescData
+}
+
+var file_redirect_v1_redirect_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_redirect_v1_redirect_proto_goTypes = []any{
+	(*ResolveRedirectRequest)(nil),  // 0: redirect.v1.ResolveRedirectRequest
+	(*ResolveRedirectResponse)(nil), // 1: redirect.v1.ResolveRedirectResponse
+	(*QrCode)(nil),                  // 2: redirect.v1.QrCode
+	(*UtmTemplate)(nil),             // 3: redirect.v1.UtmTemplate
+	(*AppLink)(nil),                 // 4: redirect.v1.AppLink
+	(*LandingPage)(nil),             // 5: redirect.v1.LandingPage
+	(*Settings)(nil),                // 6: redirect.v1.Settings
+	nil,                             // 7: redirect.v1.Settings.CampaignUtmEntry
+}
+var file_redirect_v1_redirect_proto_depIdxs = []int32{
+	2, // 0: redirect.v1.ResolveRedirectResponse.qr_code:type_name -> redirect.v1.QrCode
+	6, // 1: redirect.v1.ResolveRedirectResponse.settings:type_name -> redirect.v1.Settings
+	3, // 2: redirect.v1.QrCode.utm:type_name -> redirect.v1.UtmTemplate
+	4, // 3: redirect.v1.QrCode.app_link:type_name -> redirect.v1.AppLink
+	5, // 4: redirect.v1.QrCode.landing_page:type_name -> redirect.v1.LandingPage
+	7, // 5: redirect.v1.Settings.campaign_utm:type_name -> redirect.v1.Settings.CampaignUtmEntry
+	3, // 6: redirect.v1.Settings.CampaignUtmEntry.value:type_name -> redirect.v1.UtmTemplate
+	0, // 7: redirect.v1.RedirectService.ResolveRedirect:input_type -> redirect.v1.ResolveRedirectRequest
+	1, // 8: redirect.v1.RedirectService.ResolveRedirect:output_type -> redirect.v1.ResolveRedirectResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_redirect_v1_redirect_proto_init() }
+func file_redirect_v1_redirect_proto_init() {
+	if File_redirect_v1_redirect_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_redirect_v1_redirect_proto_rawDesc), len(file_redirect_v1_redirect_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_redirect_v1_redirect_proto_goTypes,
+		DependencyIndexes: file_redirect_v1_redirect_proto_depIdxs,
+		MessageInfos:      file_redirect_v1_redirect_proto_msgTypes,
+	}.Build()
+	File_redirect_v1_redirect_proto = out.File
+	file_redirect_v1_redirect_proto_goTypes = nil
+	file_redirect_v1_redirect_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: redirect/v1/redirect.proto
+
+// Internal API between click-service and qr-service. Not exposed publicly.
+
+package redirectpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RedirectService_ResolveRedirect_FullMethodName = "/redirect.v1.RedirectService/ResolveRedirect"
+)
+
+// RedirectServiceClient is the client API for RedirectService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RedirectServiceClient interface {
+	// ResolveRedirect returns everything click-service needs to answer a scan
+	// in one round trip. Unknown codes fail with NOT_FOUND.
+	ResolveRedirect(ctx context.Context, in *ResolveRedirectRequest, opts ...grpc.CallOption) (*ResolveRedirectResponse, error)
+}
+
+type redirectServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRedirectServiceClient(cc grpc.ClientConnInterface) RedirectServiceClient {
+	return &redirectServiceClient{cc}
+}
+
+func (c *redirectServiceClient) ResolveRedirect(ctx context.Context, in *ResolveRedirectRequest, opts ...grpc.CallOption) (*ResolveRedirectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveRedirectResponse)
+	err := c.cc.Invoke(ctx, RedirectService_ResolveRedirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RedirectServiceServer is the server API for RedirectService service.
+// All implementations must embed UnimplementedRedirectServiceServer
+// for forward compatibility.
+type RedirectServiceServer interface {
+	// ResolveRedirect returns everything click-service needs to answer a scan
+	// in one round trip. Unknown codes fail with NOT_FOUND.
+	ResolveRedirect(context.Context, *ResolveRedirectRequest) (*ResolveRedirectResponse, error)
+	mustEmbedUnimplementedRedirectServiceServer()
+}
+
+// UnimplementedRedirectServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRedirectServiceServer struct{}
+
+func (UnimplementedRedirectServiceServer) ResolveRedirect(context.Context, *ResolveRedirectRequest) (*ResolveRedirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveRedirect not implemented")
+}
+func (UnimplementedRedirectServiceServer) mustEmbedUnimplementedRedirectServiceServer() {}
+func (UnimplementedRedirectServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRedirectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RedirectServiceServer will
+// result in compilation errors.
+type UnsafeRedirectServiceServer interface {
+	mustEmbedUnimplementedRedirectServiceServer()
+}
+
+func RegisterRedirectServiceServer(s grpc.ServiceRegistrar, srv RedirectServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRedirectServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RedirectService_ServiceDesc, srv)
+}
+
+func _RedirectService_ResolveRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRedirectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedirectServiceServer).ResolveRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RedirectService_ResolveRedirect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedirectServiceServer).ResolveRedirect(ctx, req.(*ResolveRedirectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RedirectService_ServiceDesc is the grpc.ServiceDesc for RedirectService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RedirectService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "redirect.v1.RedirectService",
+	HandlerType: (*RedirectServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveRedirect",
+			Handler:    _RedirectService_ResolveRedirect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "redirect/v1/redirect.proto",
+}
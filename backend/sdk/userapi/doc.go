@@ -0,0 +1,5 @@
+// Package userapi is a client for user-service, generated from
+// user-service/internal/httpapi/openapi.yaml.
+package userapi
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 -config oapi-codegen.yaml ../../user-service/internal/httpapi/openapi.yaml
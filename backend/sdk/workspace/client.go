@@ -0,0 +1,85 @@
+// Package workspace looks up team workspace roles from user-service.
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrNotMember means the user has no role in the workspace, or the workspace
+// doesn't exist.
+var ErrNotMember = errors.New("not a workspace member")
+
+// Role mirrors user-service's workspace roles.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+var roleRank = map[Role]int{RoleViewer: 1, RoleEditor: 2, RoleAdmin: 3, RoleOwner: 4}
+
+// AtLeast reports whether r grants everything min does.
+func (r Role) AtLeast(min Role) bool {
+	rank, ok := roleRank[r]
+	return ok && rank >= roleRank[min]
+}
+
+type Client struct {
+	BaseURL string
+	// AdminKey is user-service's ADMIN_API_KEY, which lets services read
+	// any workspace's members.
+	AdminKey string
+	HTTP     *http.Client
+}
+
+func NewClient(baseURL, adminKey string) *Client {
+	return &Client{
+		BaseURL:  strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		AdminKey: adminKey,
+		HTTP:     &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Role returns userID's role in workspaceID.
+func (c *Client) Role(ctx context.Context, workspaceID, userID string) (Role, error) {
+	if workspaceID == "" || userID == "" {
+		return "", ErrNotMember
+	}
+	endpoint := fmt.Sprintf("%s/api/workspaces/%s/members/%s", c.BaseURL, url.PathEscape(workspaceID), url.PathEscape(userID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Admin-Key", c.AdminKey)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", ErrNotMember
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("user-service returned %d", resp.StatusCode)
+	}
+	var out struct {
+		Role Role `json:"role"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.Role, nil
+}
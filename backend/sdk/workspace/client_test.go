@@ -0,0 +1,36 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Role(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Admin-Key") != "k" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/workspaces/ws1/members/alice" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"workspaceId":"ws1","userId":"alice","role":"editor"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient(ts.URL+"/", "k")
+	role, err := c.Role(context.Background(), "ws1", "alice")
+	if err != nil || role != RoleEditor {
+		t.Fatalf("expected editor, got %q %v", role, err)
+	}
+	if !role.AtLeast(RoleViewer) || role.AtLeast(RoleAdmin) {
+		t.Fatalf("unexpected ordering for %q", role)
+	}
+	if _, err := c.Role(context.Background(), "ws1", "mallory"); !errors.Is(err, ErrNotMember) {
+		t.Fatalf("expected ErrNotMember, got %v", err)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"user-service/internal/cognito"
 	"user-service/internal/httpapi"
 	"user-service/internal/middleware"
+	"user-service/internal/store"
 	"user-service/internal/stripe"
 )
 
@@ -65,6 +66,20 @@ func main() {
 		log.Printf("stripe not configured (missing STRIPE_SECRET_KEY or STRIPE_WEBHOOK_SECRET)")
 	}
 
+	// Workspaces and API tokens persist in Postgres when DATABASE_URL is set;
+	// the in-memory store is for local development only.
+	var st store.Store = store.NewMemoryStore()
+	if databaseURL := envOr("DATABASE_URL", ""); databaseURL != "" {
+		pg, err := store.NewPostgresStore(ctx, databaseURL)
+		if err != nil {
+			log.Fatalf("postgres init failed: %v", err)
+		}
+		defer pg.Close()
+		st = pg
+	} else {
+		log.Printf("DATABASE_URL not set; workspaces and API tokens are kept in memory")
+	}
+
 	router := httpapi.NewRouter(httpapi.Server{
 		Cognito:        awsClient,
 		UserPoolID:     userPoolID,
@@ -74,6 +89,7 @@ func main() {
 		CookieSecure:   cookieSecure,
 		CookieSameSite: sameSite,
 		StripeClient:   stripeClient,
+		Store:          st,
 	})
 
 	// Apply middleware layers (order matters!)
@@ -0,0 +1,201 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"user-service/internal/cognito"
+	"user-service/internal/store"
+)
+
+// specRouter loads openapi.yaml and fails the test if the document is invalid.
+func specRouter(t *testing.T) routers.Router {
+	t.Helper()
+	doc, err := openapi3.NewLoader().LoadFromData(openAPISpec)
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("invalid spec: %v", err)
+	}
+	// Match requests regardless of host.
+	doc.Servers = nil
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("router: %v", err)
+	}
+	return router
+}
+
+// serveValidated runs req through h and checks both sides of the exchange
+// against the spec.
+func serveValidated(t *testing.T, spec routers.Router, h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	route, params, err := spec.FindRoute(req)
+	if err != nil {
+		t.Fatalf("%s %s: not in spec: %v", req.Method, req.URL.Path, err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	in := &openapi3filter.RequestValidationInput{Request: req, PathParams: params, Route: route}
+	if err := openapi3filter.ValidateRequest(req.Context(), in); err != nil {
+		t.Fatalf("%s %s: request does not match spec: %v", req.Method, req.URL.Path, err)
+	}
+	out := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: in,
+		Status:                 w.Code,
+		Header:                 w.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(w.Body.Bytes())),
+	}
+	if err := openapi3filter.ValidateResponse(req.Context(), out); err != nil {
+		t.Fatalf("%s %s: %d response does not match spec: %v", req.Method, req.URL.Path, w.Code, err)
+	}
+	return w
+}
+
+// cognitoFake implements the calls exercised below; anything else panics
+// through the nil embedded interface.
+type cognitoFake struct {
+	cognito.API
+}
+
+func (cognitoFake) InitiateAuth(context.Context, *cognitoidentityprovider.InitiateAuthInput, ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	return &cognitoidentityprovider.InitiateAuthOutput{AuthenticationResult: &types.AuthenticationResultType{
+		AccessToken: aws.String("access"),
+		IdToken:     aws.String("id"),
+	}}, nil
+}
+
+func (cognitoFake) GetUser(context.Context, *cognitoidentityprovider.GetUserInput, ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.GetUserOutput, error) {
+	return &cognitoidentityprovider.GetUserOutput{
+		Username: aws.String("u1"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("a@example.com")},
+			{Name: aws.String(cognitoUserTypeAttr), Value: aws.String("basic")},
+		},
+	}, nil
+}
+
+func (cognitoFake) GlobalSignOut(context.Context, *cognitoidentityprovider.GlobalSignOutInput, ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.GlobalSignOutOutput, error) {
+	return &cognitoidentityprovider.GlobalSignOutOutput{}, nil
+}
+
+func (cognitoFake) ListUsers(context.Context, *cognitoidentityprovider.ListUsersInput, ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUsersOutput, error) {
+	return &cognitoidentityprovider.ListUsersOutput{Users: []types.UserType{{
+		Username:       aws.String("u1"),
+		Attributes:     []types.AttributeType{{Name: aws.String("email"), Value: aws.String("a@example.com")}},
+		UserCreateDate: aws.Time(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}}}, nil
+}
+
+func (cognitoFake) AdminGetUser(context.Context, *cognitoidentityprovider.AdminGetUserInput, ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.AdminGetUserOutput, error) {
+	return &cognitoidentityprovider.AdminGetUserOutput{
+		Username:       aws.String("u1"),
+		UserAttributes: []types.AttributeType{{Name: aws.String("email"), Value: aws.String("a@example.com")}},
+	}, nil
+}
+
+func jsonRequest(method, path string, body any) *http.Request {
+	var r io.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		r = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, path, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req
+}
+
+func TestOpenAPI_TrafficMatchesSpec(t *testing.T) {
+	spec := specRouter(t)
+	h := NewRouter(Server{Cognito: cognitoFake{}, AdminAPIKey: "k", Store: store.NewMemoryStore()})
+
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/healthz", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/openapi.yaml", nil))
+
+	w := serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/users/login", map[string]string{"email": "a@example.com", "password": "pw"}))
+	if w.Code != http.StatusOK {
+		t.Fatalf("login: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/users/login", map[string]string{"email": "", "password": ""}))
+
+	me := jsonRequest(http.MethodGet, "/api/users/me", nil)
+	me.AddCookie(&http.Cookie{Name: "access_token", Value: "access"})
+	if w := serveValidated(t, spec, h, me); w.Code != http.StatusOK {
+		t.Fatalf("me: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/users/me", nil))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/users/change-password", map[string]string{"oldPassword": "a", "newPassword": "b"}))
+	serveValidated(t, spec, h, jsonRequest(http.MethodPost, "/api/users/forgot-password", map[string]string{"email": ""}))
+
+	list := jsonRequest(http.MethodGet, "/api/users", nil)
+	list.Header.Set("X-Admin-Key", "k")
+	if w := serveValidated(t, spec, h, list); w.Code != http.StatusOK {
+		t.Fatalf("admin list: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/users", nil))
+
+	create := jsonRequest(http.MethodPost, "/api/workspaces", map[string]string{"name": "Team"})
+	create.AddCookie(&http.Cookie{Name: "access_token", Value: "access"})
+	w = serveValidated(t, spec, h, create)
+	var ws struct {
+		ID string `json:"id"`
+	}
+	_ = json.Unmarshal(w.Body.Bytes(), &ws)
+	mine := jsonRequest(http.MethodGet, "/api/workspaces", nil)
+	mine.AddCookie(&http.Cookie{Name: "access_token", Value: "access"})
+	serveValidated(t, spec, h, mine)
+	member := jsonRequest(http.MethodGet, "/api/workspaces/"+ws.ID+"/members/u1", nil)
+	member.Header.Set("X-Admin-Key", "k")
+	if w := serveValidated(t, spec, h, member); w.Code != http.StatusOK {
+		t.Fatalf("member lookup: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	serveValidated(t, spec, h, jsonRequest(http.MethodGet, "/api/workspaces/"+ws.ID+"/members", nil))
+
+	withSession := func(req *http.Request) *http.Request {
+		req.AddCookie(&http.Cookie{Name: "access_token", Value: "access"})
+		return req
+	}
+	w = serveValidated(t, spec, h, withSession(jsonRequest(http.MethodPost, "/api/tokens", map[string]any{"name": "CI", "scopes": []string{"qr:read"}, "expiresInDays": 7})))
+	var tok createdToken
+	_ = json.Unmarshal(w.Body.Bytes(), &tok)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create token: expected %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	serveValidated(t, spec, h, withSession(jsonRequest(http.MethodPost, "/api/tokens", map[string]any{"name": " ", "scopes": []string{"qr:read"}})))
+	serveValidated(t, spec, h, withSession(jsonRequest(http.MethodGet, "/api/tokens", nil)))
+	verify := jsonRequest(http.MethodPost, "/api/tokens/verify", map[string]string{"token": tok.Token})
+	verify.Header.Set("X-Admin-Key", "k")
+	if w := serveValidated(t, spec, h, verify); w.Code != http.StatusOK {
+		t.Fatalf("verify token: expected %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	serveValidated(t, spec, h, withSession(jsonRequest(http.MethodDelete, "/api/tokens/"+tok.ID, nil)))
+	serveValidated(t, spec, h, withSession(jsonRequest(http.MethodDelete, "/api/tokens/"+tok.ID, nil)))
+
+	logout := jsonRequest(http.MethodPost, "/api/users/logout", nil)
+	logout.Header.Set("Content-Type", "application/json")
+	serveValidated(t, spec, h, logout)
+}
@@ -20,6 +20,7 @@ import (
 	"user-service/internal/cognito"
 	"user-service/internal/middleware"
 	"user-service/internal/model"
+	"user-service/internal/store"
 )
 
 func smithyErrorCode(err error) string {
@@ -76,6 +77,10 @@ type Server struct {
 
 	AdminAPIKey string
 
+	// Store holds team workspaces and API tokens; nil disables the
+	// /api/workspaces and /api/tokens endpoints.
+	Store store.Store
+
 	CookieSecure   bool
 	CookieSameSite http.SameSite
 
@@ -234,6 +239,7 @@ func NewRouter(srv Server) http.Handler {
 	})
 
 	mux.Handle("/healthz", wrap(healthHandler))
+	mux.Handle("/openapi.yaml", wrap(http.HandlerFunc(openAPIHandler)))
 
 	mux.Handle("/api/users/register", wrap(registerHandler))
 	mux.Handle("/api/users/login", wrap(loginHandler))
@@ -245,6 +251,15 @@ func NewRouter(srv Server) http.Handler {
 	mux.Handle("/api/users/confirm-forgot-password", wrap(confirmForgotPasswordHandler))
 	mux.Handle("/api/users/change-password", wrap(changePasswordHandler))
 
+	// Team workspaces (if a workspace store is configured)
+	if srv.Store != nil {
+		mux.Handle("/api/workspaces", wrap(http.HandlerFunc(srv.workspaceCollectionHandler)))
+		mux.Handle("/api/workspaces/", wrap(http.HandlerFunc(srv.workspaceItemHandler)))
+		mux.Handle("/api/tokens", wrap(http.HandlerFunc(srv.tokenCollectionHandler)))
+		mux.Handle("/api/tokens/verify", wrap(http.HandlerFunc(srv.tokenVerifyHandler)))
+		mux.Handle("/api/tokens/", wrap(http.HandlerFunc(srv.tokenItemHandler)))
+	}
+
 	// Admin-style CRUD (guarded)
 	mux.Handle("/api/users", wrap(http.HandlerFunc(requireAdmin(srv.AdminAPIKey, adminCollectionHandler))))
 	mux.Handle("/api/users/", wrap(http.HandlerFunc(requireAdmin(srv.AdminAPIKey, adminItemHandler))))
@@ -712,22 +727,27 @@ func (srv Server) handleAdminGetUser(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 		return
 	}
-	username := id
-	derived := derivedUsernameFromIdentifier(id)
-
-	out, err := srv.Cognito.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{UserPoolId: aws.String(srv.UserPoolID), Username: aws.String(username)})
-	if err != nil && derived != "" && shouldTryDerivedUsername(err) {
-		username = derived
-		out, err = srv.Cognito.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{UserPoolId: aws.String(srv.UserPoolID), Username: aws.String(username)})
-	}
+	user, err := srv.lookupUser(ctx, id)
 	if err != nil {
 		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
 		return
 	}
-	user := mapAdminUser(out)
 	writeJSON(w, http.StatusOK, user)
 }
 
+// lookupUser finds a user by Cognito username or email.
+func (srv Server) lookupUser(ctx context.Context, id string) (model.User, error) {
+	derived := derivedUsernameFromIdentifier(id)
+	out, err := srv.Cognito.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{UserPoolId: aws.String(srv.UserPoolID), Username: aws.String(id)})
+	if err != nil && derived != "" && shouldTryDerivedUsername(err) {
+		out, err = srv.Cognito.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{UserPoolId: aws.String(srv.UserPoolID), Username: aws.String(derived)})
+	}
+	if err != nil {
+		return model.User{}, err
+	}
+	return mapAdminUser(out), nil
+}
+
 func (srv Server) handleAdminCreateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -0,0 +1,222 @@
+package httpapi
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"user-service/internal/model"
+)
+
+const (
+	// tokenSecretPrefix marks personal API tokens so they're easy to spot
+	// in logs and secret scanners.
+	tokenSecretPrefix = "qrd_"
+	tokenDisplayLen   = len(tokenSecretPrefix) + 8
+	maxTokensPerUser  = 50
+	maxTokenNameLen   = 100
+	maxTokenLifetime  = 3650 // days
+)
+
+type createTokenInput struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// ExpiresInDays is the token's lifetime; 0 means it never expires.
+	ExpiresInDays int `json:"expiresInDays,omitempty"`
+}
+
+// createdToken is the only response that carries the secret.
+type createdToken struct {
+	model.APIToken
+	Token string `json:"token"`
+}
+
+type verifyTokenInput struct {
+	Token string `json:"token"`
+}
+
+type verifiedToken struct {
+	TokenID  string   `json:"tokenId"`
+	UserID   string   `json:"userId"`
+	UserType string   `json:"userType"`
+	Scopes   []string `json:"scopes"`
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTokenSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenSecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (srv Server) tokenCollectionHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodPost:
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	user, ok := srv.currentUser(w, r)
+	if !ok {
+		return
+	}
+	tokens, err := srv.Store.ListTokens(user.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "token_store_failed"})
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		for i := range tokens {
+			tokens[i] = tokens[i].NormalizeForResponse()
+		}
+		writeJSON(w, http.StatusOK, tokens)
+		return
+	}
+
+	var in createTokenInput
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	name := strings.TrimSpace(in.Name)
+	switch {
+	case name == "":
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "name_required"})
+		return
+	case len(name) > maxTokenNameLen:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "name_too_long"})
+		return
+	}
+	scopes, ok := normalizeScopes(in.Scopes)
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "scopes_invalid"})
+		return
+	}
+	if in.ExpiresInDays < 0 || in.ExpiresInDays > maxTokenLifetime {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "expires_invalid"})
+		return
+	}
+	if len(tokens) >= maxTokensPerUser {
+		writeJSON(w, http.StatusConflict, map[string]string{"error": "token_limit_reached"})
+		return
+	}
+
+	secret, err := newTokenSecret()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "token_create_failed"})
+		return
+	}
+	t := model.APIToken{UserID: user.ID, Name: name, Scopes: scopes, Prefix: secret[:tokenDisplayLen], Hash: hashToken(secret)}
+	if in.ExpiresInDays > 0 {
+		expires := time.Now().UTC().AddDate(0, 0, in.ExpiresInDays)
+		t.ExpiresAt = &expires
+	}
+	created, err := srv.Store.CreateToken(t)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "token_create_failed"})
+		return
+	}
+	writeJSON(w, http.StatusCreated, createdToken{APIToken: created.NormalizeForResponse(), Token: secret})
+}
+
+// normalizeScopes dedupes and validates; at least one scope is required.
+func normalizeScopes(in []string) ([]string, bool) {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		s = strings.TrimSpace(s)
+		if !model.ValidScope(s) {
+			return nil, false
+		}
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out, len(out) > 0
+}
+
+// tokenItemHandler serves DELETE /api/tokens/{id}, which revokes the token.
+func (srv Server) tokenItemHandler(w http.ResponseWriter, r *http.Request) {
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/tokens/"), "/")
+	if id == "" {
+		srv.tokenCollectionHandler(w, r)
+		return
+	}
+	if strings.Contains(id, "/") {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return
+	}
+	if r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	user, ok := srv.currentUser(w, r)
+	if !ok {
+		return
+	}
+	if err := srv.Store.DeleteToken(user.ID, id); err != nil {
+		writeWorkspaceStoreError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// tokenVerifyHandler is how qr-service and click-service authenticate Bearer
+// tokens. It requires the admin key and records the token as used.
+func (srv Server) tokenVerifyHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	requireAdmin(srv.AdminAPIKey, func(w http.ResponseWriter, r *http.Request) {
+		var in verifyTokenInput
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		secret := strings.TrimSpace(in.Token)
+		if !strings.HasPrefix(secret, tokenSecretPrefix) {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+			return
+		}
+		t, err := srv.Store.GetTokenByHash(hashToken(secret))
+		if err != nil {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+			return
+		}
+		now := time.Now().UTC()
+		if t.Expired(now) {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "token_expired"})
+			return
+		}
+
+		// The plan decides quotas downstream, so read it fresh from Cognito.
+		user, err := srv.lookupUser(r.Context(), t.UserID)
+		if err != nil {
+			if smithyErrorCode(err) == "UserNotFoundException" {
+				writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+				return
+			}
+			writeJSON(w, http.StatusBadGateway, map[string]string{"error": "user_lookup_failed"})
+			return
+		}
+		if err := srv.Store.TouchToken(t.ID, now); err != nil {
+			log.Printf("token touch failed id=%s err=%v", t.ID, err)
+		}
+		writeJSON(w, http.StatusOK, verifiedToken{TokenID: t.ID, UserID: t.UserID, UserType: normalizeUserType(user.UserType), Scopes: t.NormalizeForResponse().Scopes})
+	})(w, r)
+}
@@ -0,0 +1,130 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"user-service/internal/model"
+	"user-service/internal/store"
+)
+
+// tokenCognito is teamCognito where admin lookups by username succeed and
+// every user is on the basic plan, except "gone", who has been deleted.
+type tokenCognito struct {
+	teamCognito
+}
+
+func (tokenCognito) AdminGetUser(_ context.Context, in *cognitoidentityprovider.AdminGetUserInput, _ ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.AdminGetUserOutput, error) {
+	name := aws.ToString(in.Username)
+	if name == "gone" {
+		return nil, &types.UserNotFoundException{}
+	}
+	return &cognitoidentityprovider.AdminGetUserOutput{
+		Username: aws.String(name),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String(name + "@example.com")},
+			{Name: aws.String(cognitoUserTypeAttr), Value: aws.String("basic")},
+		},
+	}, nil
+}
+
+func verifyRequest(secret string) *http.Request {
+	req := jsonRequest(http.MethodPost, "/api/tokens/verify", map[string]string{"token": secret})
+	req.Header.Set("X-Admin-Key", "k")
+	return req
+}
+
+func TestTokens_CreateVerifyRevoke(t *testing.T) {
+	st := store.NewMemoryStore()
+	h := NewRouter(Server{Cognito: tokenCognito{}, AdminAPIKey: "k", Store: st})
+
+	w := serve(h, asUser(jsonRequest(http.MethodPost, "/api/tokens", map[string]any{"name": "CI", "scopes": []string{"qr:read", "qr:read", "clicks:read"}, "expiresInDays": 30}), "alice"))
+	var created createdToken
+	_ = json.NewDecoder(w.Body).Decode(&created)
+	if w.Code != http.StatusCreated || !strings.HasPrefix(created.Token, tokenSecretPrefix) || !strings.HasPrefix(created.Token, created.Prefix) {
+		t.Fatalf("create: unexpected %d %+v", w.Code, created)
+	}
+	if len(created.Scopes) != 2 || created.ExpiresAtIso == "" {
+		t.Fatalf("create: scopes/expiry not normalized: %+v", created.APIToken)
+	}
+	stored, _ := st.GetTokenByHash(hashToken(created.Token))
+	if stored.ID != created.ID || stored.Hash == created.Token {
+		t.Fatalf("secret must be stored hashed: %+v", stored)
+	}
+
+	// The list never includes the secret.
+	w = serve(h, asUser(jsonRequest(http.MethodGet, "/api/tokens", nil), "alice"))
+	if strings.Contains(w.Body.String(), created.Token) {
+		t.Fatalf("list leaked the secret: %s", w.Body.String())
+	}
+
+	if w := serve(h, jsonRequest(http.MethodPost, "/api/tokens/verify", map[string]string{"token": created.Token})); w.Code != http.StatusUnauthorized {
+		t.Fatalf("verify without admin key: expected %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+	w = serve(h, verifyRequest(created.Token))
+	var v verifiedToken
+	_ = json.NewDecoder(w.Body).Decode(&v)
+	if w.Code != http.StatusOK || v.UserID != "alice" || v.UserType != "basic" || v.TokenID != created.ID {
+		t.Fatalf("verify: unexpected %d %+v", w.Code, v)
+	}
+	w = serve(h, asUser(jsonRequest(http.MethodGet, "/api/tokens", nil), "alice"))
+	var listed []model.APIToken
+	_ = json.NewDecoder(w.Body).Decode(&listed)
+	if len(listed) != 1 || listed[0].LastUsedAtIso == "" {
+		t.Fatalf("last used not recorded: %+v", listed)
+	}
+
+	// Only the owner can revoke; revoked tokens stop verifying.
+	if w := serve(h, asUser(jsonRequest(http.MethodDelete, "/api/tokens/"+created.ID, nil), "bob")); w.Code != http.StatusNotFound {
+		t.Fatalf("revoke by other user: expected %d, got %d", http.StatusNotFound, w.Code)
+	}
+	if w := serve(h, asUser(jsonRequest(http.MethodDelete, "/api/tokens/"+created.ID, nil), "alice")); w.Code != http.StatusNoContent {
+		t.Fatalf("revoke: expected %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if w := serve(h, verifyRequest(created.Token)); w.Code != http.StatusUnauthorized {
+		t.Fatalf("verify revoked: expected %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestTokens_RejectsBadInputAndExpiredTokens(t *testing.T) {
+	st := store.NewMemoryStore()
+	h := NewRouter(Server{Cognito: tokenCognito{}, AdminAPIKey: "k", Store: st})
+
+	cases := []struct {
+		body map[string]any
+		want string
+	}{
+		{map[string]any{"scopes": []string{"qr:read"}}, "name_required"},
+		{map[string]any{"name": "x", "scopes": []string{}}, "scopes_invalid"},
+		{map[string]any{"name": "x", "scopes": []string{"admin"}}, "scopes_invalid"},
+		{map[string]any{"name": "x", "scopes": []string{"qr:read"}, "expiresInDays": -1}, "expires_invalid"},
+	}
+	for _, tc := range cases {
+		w := serve(h, asUser(jsonRequest(http.MethodPost, "/api/tokens", tc.body), "alice"))
+		var resp map[string]string
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+		if w.Code != http.StatusBadRequest || resp["error"] != tc.want {
+			t.Fatalf("%v: expected %s, got %d %v", tc.body, tc.want, w.Code, resp)
+		}
+	}
+
+	past := time.Now().Add(-time.Hour)
+	_, _ = st.CreateToken(model.APIToken{UserID: "alice", Name: "old", Scopes: []string{"qr:read"}, Hash: hashToken("qrd_expired"), ExpiresAt: &past})
+	_, _ = st.CreateToken(model.APIToken{UserID: "gone", Name: "orphan", Scopes: []string{"qr:read"}, Hash: hashToken("qrd_orphan")})
+	for secret, want := range map[string]string{"qrd_expired": "token_expired", "qrd_orphan": "invalid_token", "not-a-token": "invalid_token"} {
+		w := serve(h, verifyRequest(secret))
+		var resp map[string]string
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+		if w.Code != http.StatusUnauthorized || resp["error"] != want {
+			t.Fatalf("verify %s: expected %s, got %d %v", secret, want, w.Code, resp)
+		}
+	}
+}
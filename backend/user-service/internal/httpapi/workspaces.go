@@ -0,0 +1,363 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"user-service/internal/model"
+	"user-service/internal/store"
+)
+
+const maxWorkspaceNameLen = 100
+
+type workspaceInput struct {
+	Name string `json:"name"`
+}
+
+type addMemberInput struct {
+	// Email or UserID identifies the account to add.
+	Email  string     `json:"email,omitempty"`
+	UserID string     `json:"userId,omitempty"`
+	Role   model.Role `json:"role"`
+}
+
+type updateMemberInput struct {
+	Role model.Role `json:"role"`
+}
+
+// currentUser resolves the access_token cookie, writing 401 when it's
+// missing or rejected.
+func (srv Server) currentUser(w http.ResponseWriter, r *http.Request) (model.User, bool) {
+	access, _ := readCookie(r, "access_token")
+	if access == "" {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "not_authenticated"})
+		return model.User{}, false
+	}
+	user, err := getUserFromAccessToken(r.Context(), srv.Cognito, access)
+	if err != nil {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "not_authenticated"})
+		return model.User{}, false
+	}
+	return user, true
+}
+
+// workspaceRole returns the caller's role in the workspace. The admin key
+// acts as owner; that's how qr-service and click-service look up members.
+// Non-members get 404 so workspace IDs can't be probed.
+func (srv Server) workspaceRole(w http.ResponseWriter, r *http.Request, workspaceID string) (model.User, model.Role, bool) {
+	if srv.AdminAPIKey != "" && r.Header.Get("X-Admin-Key") == srv.AdminAPIKey {
+		if _, err := srv.Store.GetWorkspace(workspaceID); err != nil {
+			writeWorkspaceStoreError(w, err)
+			return model.User{}, "", false
+		}
+		return model.User{}, model.RoleOwner, true
+	}
+
+	user, ok := srv.currentUser(w, r)
+	if !ok {
+		return model.User{}, "", false
+	}
+	m, err := srv.Store.GetMember(workspaceID, user.ID)
+	if err != nil {
+		writeWorkspaceStoreError(w, err)
+		return model.User{}, "", false
+	}
+	return user, m.Role, true
+}
+
+func writeWorkspaceStoreError(w http.ResponseWriter, err error) {
+	if errors.Is(err, store.ErrNotFound) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+		return
+	}
+	writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "workspace_store_failed"})
+}
+
+func requireRole(w http.ResponseWriter, role, min model.Role) bool {
+	if !role.AtLeast(min) {
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "forbidden"})
+		return false
+	}
+	return true
+}
+
+func normalizeWorkspaceName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return "", "name_required"
+	case len(name) > maxWorkspaceNameLen:
+		return "", "name_too_long"
+	}
+	return name, ""
+}
+
+func (srv Server) workspaceCollectionHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		user, ok := srv.currentUser(w, r)
+		if !ok {
+			return
+		}
+		items, err := srv.Store.ListWorkspaces(user.ID)
+		if err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		for i := range items {
+			items[i] = items[i].NormalizeForResponse()
+		}
+		writeJSON(w, http.StatusOK, items)
+	case http.MethodPost:
+		user, ok := srv.currentUser(w, r)
+		if !ok {
+			return
+		}
+		var in workspaceInput
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		name, code := normalizeWorkspaceName(in.Name)
+		if code != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+			return
+		}
+		ws, err := srv.Store.CreateWorkspace(name, model.Member{UserID: user.ID, Email: user.Email})
+		if err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		ws.Role = model.RoleOwner
+		writeJSON(w, http.StatusCreated, ws.NormalizeForResponse())
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// workspaceItemHandler serves /api/workspaces/{id}, /{id}/members and
+// /{id}/members/{userId}.
+func (srv Server) workspaceItemHandler(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/workspaces/"), "/"), "/")
+	workspaceID := parts[0]
+	switch {
+	case workspaceID == "":
+		srv.workspaceCollectionHandler(w, r)
+	case len(parts) == 1:
+		srv.handleWorkspace(w, r, workspaceID)
+	case len(parts) == 2 && parts[1] == "members":
+		srv.handleMembers(w, r, workspaceID)
+	case len(parts) == 3 && parts[1] == "members" && parts[2] != "":
+		srv.handleMember(w, r, workspaceID, parts[2])
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found"})
+	}
+}
+
+func (srv Server) handleWorkspace(w http.ResponseWriter, r *http.Request, id string) {
+	switch r.Method {
+	case http.MethodGet, http.MethodPatch, http.MethodDelete:
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	_, role, ok := srv.workspaceRole(w, r, id)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		ws, err := srv.Store.GetWorkspace(id)
+		if err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		ws.Role = role
+		writeJSON(w, http.StatusOK, ws.NormalizeForResponse())
+	case http.MethodPatch:
+		if !requireRole(w, role, model.RoleAdmin) {
+			return
+		}
+		var in workspaceInput
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		name, code := normalizeWorkspaceName(in.Name)
+		if code != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+			return
+		}
+		ws, err := srv.Store.RenameWorkspace(id, name)
+		if err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		ws.Role = role
+		writeJSON(w, http.StatusOK, ws.NormalizeForResponse())
+	case http.MethodDelete:
+		if !requireRole(w, role, model.RoleOwner) {
+			return
+		}
+		if err := srv.Store.DeleteWorkspace(id); err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (srv Server) handleMembers(w http.ResponseWriter, r *http.Request, workspaceID string) {
+	switch r.Method {
+	case http.MethodGet, http.MethodPost:
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	_, role, ok := srv.workspaceRole(w, r, workspaceID)
+	if !ok {
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		members, err := srv.Store.ListMembers(workspaceID)
+		if err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		for i := range members {
+			members[i] = members[i].NormalizeForResponse()
+		}
+		writeJSON(w, http.StatusOK, members)
+		return
+	}
+
+	if !requireRole(w, role, model.RoleAdmin) {
+		return
+	}
+	var in addMemberInput
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+		return
+	}
+	if !in.Role.Valid() {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "role_invalid"})
+		return
+	}
+	// Only owners can make more owners.
+	if in.Role == model.RoleOwner && !requireRole(w, role, model.RoleOwner) {
+		return
+	}
+	identifier := strings.TrimSpace(in.UserID)
+	if identifier == "" {
+		identifier = strings.TrimSpace(in.Email)
+	}
+	if identifier == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "email_required"})
+		return
+	}
+	user, err := srv.lookupUser(r.Context(), identifier)
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "user_not_found"})
+		return
+	}
+	if _, err := srv.Store.GetMember(workspaceID, user.ID); err == nil {
+		writeJSON(w, http.StatusConflict, map[string]string{"error": "already_member"})
+		return
+	}
+	m, err := srv.Store.PutMember(model.Member{WorkspaceID: workspaceID, UserID: user.ID, Email: user.Email, Role: in.Role})
+	if err != nil {
+		writeWorkspaceStoreError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, m.NormalizeForResponse())
+}
+
+func (srv Server) handleMember(w http.ResponseWriter, r *http.Request, workspaceID, userID string) {
+	switch r.Method {
+	case http.MethodGet, http.MethodPatch, http.MethodDelete:
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	actor, role, ok := srv.workspaceRole(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	target, err := srv.Store.GetMember(workspaceID, userID)
+	if err != nil {
+		writeWorkspaceStoreError(w, err)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, target.NormalizeForResponse())
+	case http.MethodPatch:
+		var in updateMemberInput
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_json"})
+			return
+		}
+		if !in.Role.Valid() {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "role_invalid"})
+			return
+		}
+		minRole := model.RoleAdmin
+		if target.Role == model.RoleOwner || in.Role == model.RoleOwner {
+			minRole = model.RoleOwner
+		}
+		if !requireRole(w, role, minRole) {
+			return
+		}
+		if target.Role == model.RoleOwner && in.Role != model.RoleOwner && !srv.hasOtherOwner(w, workspaceID, userID) {
+			return
+		}
+		target.Role = in.Role
+		updated, err := srv.Store.PutMember(target)
+		if err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, updated.NormalizeForResponse())
+	case http.MethodDelete:
+		// Anyone may leave; removing others takes admin, or owner for owners.
+		if actor.ID != userID {
+			minRole := model.RoleAdmin
+			if target.Role == model.RoleOwner {
+				minRole = model.RoleOwner
+			}
+			if !requireRole(w, role, minRole) {
+				return
+			}
+		}
+		if target.Role == model.RoleOwner && !srv.hasOtherOwner(w, workspaceID, userID) {
+			return
+		}
+		if err := srv.Store.RemoveMember(workspaceID, userID); err != nil {
+			writeWorkspaceStoreError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// hasOtherOwner reports whether someone besides userID owns the workspace,
+// writing 409 last_owner when not, so a workspace is never left ownerless.
+func (srv Server) hasOtherOwner(w http.ResponseWriter, workspaceID, userID string) bool {
+	members, err := srv.Store.ListMembers(workspaceID)
+	if err != nil {
+		writeWorkspaceStoreError(w, err)
+		return false
+	}
+	for _, m := range members {
+		if m.Role == model.RoleOwner && m.UserID != userID {
+			return true
+		}
+	}
+	writeJSON(w, http.StatusConflict, map[string]string{"error": "last_owner"})
+	return false
+}
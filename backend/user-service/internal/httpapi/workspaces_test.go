@@ -0,0 +1,113 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"user-service/internal/cognito"
+	"user-service/internal/model"
+	"user-service/internal/store"
+)
+
+// teamCognito treats each access token as the username it belongs to, and
+// knows users by "<username>@example.com".
+type teamCognito struct {
+	cognito.API
+}
+
+func (teamCognito) GetUser(_ context.Context, in *cognitoidentityprovider.GetUserInput, _ ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.GetUserOutput, error) {
+	name := aws.ToString(in.AccessToken)
+	return &cognitoidentityprovider.GetUserOutput{
+		Username:       aws.String(name),
+		UserAttributes: []types.AttributeType{{Name: aws.String("email"), Value: aws.String(name + "@example.com")}},
+	}, nil
+}
+
+func (teamCognito) AdminGetUser(_ context.Context, in *cognitoidentityprovider.AdminGetUserInput, _ ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.AdminGetUserOutput, error) {
+	known := map[string]string{"bob@example.com": "bob", "carol@example.com": "carol"}
+	name, ok := known[aws.ToString(in.Username)]
+	if !ok {
+		return nil, &types.UserNotFoundException{}
+	}
+	return &cognitoidentityprovider.AdminGetUserOutput{
+		Username:       aws.String(name),
+		UserAttributes: []types.AttributeType{{Name: aws.String("email"), Value: aws.String(name + "@example.com")}},
+	}, nil
+}
+
+func asUser(req *http.Request, user string) *http.Request {
+	req.AddCookie(&http.Cookie{Name: "access_token", Value: user})
+	return req
+}
+
+func serve(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestWorkspaces_MembersAndRoles(t *testing.T) {
+	h := NewRouter(Server{Cognito: teamCognito{}, AdminAPIKey: "k", Store: store.NewMemoryStore()})
+
+	w := serve(h, asUser(jsonRequest(http.MethodPost, "/api/workspaces", map[string]string{"name": "Marketing"}), "alice"))
+	var ws model.Workspace
+	_ = json.NewDecoder(w.Body).Decode(&ws)
+	if w.Code != http.StatusCreated || ws.Role != model.RoleOwner {
+		t.Fatalf("create: unexpected %d %+v", w.Code, ws)
+	}
+	base := "/api/workspaces/" + ws.ID
+
+	w = serve(h, asUser(jsonRequest(http.MethodPost, base+"/members", map[string]string{"email": "bob@example.com", "role": "editor"}), "alice"))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("add bob: expected %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	// Non-members can't see the workspace; editors can't manage members.
+	if w := serve(h, asUser(jsonRequest(http.MethodGet, base, nil), "carol")); w.Code != http.StatusNotFound {
+		t.Fatalf("outsider: expected %d, got %d", http.StatusNotFound, w.Code)
+	}
+	if w := serve(h, asUser(jsonRequest(http.MethodPost, base+"/members", map[string]string{"email": "carol@example.com", "role": "viewer"}), "bob")); w.Code != http.StatusForbidden {
+		t.Fatalf("editor adding member: expected %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	// Services look up roles with the admin key.
+	req := jsonRequest(http.MethodGet, base+"/members/bob", nil)
+	req.Header.Set("X-Admin-Key", "k")
+	var m model.Member
+	w = serve(h, req)
+	_ = json.NewDecoder(w.Body).Decode(&m)
+	if w.Code != http.StatusOK || m.Role != model.RoleEditor {
+		t.Fatalf("lookup: unexpected %d %+v", w.Code, m)
+	}
+
+	// The last owner can't leave or be demoted.
+	if w := serve(h, asUser(jsonRequest(http.MethodDelete, base+"/members/alice", nil), "alice")); w.Code != http.StatusConflict {
+		t.Fatalf("last owner leaving: expected %d, got %d", http.StatusConflict, w.Code)
+	}
+	if w := serve(h, asUser(jsonRequest(http.MethodPatch, base+"/members/bob", map[string]string{"role": "owner"}), "alice")); w.Code != http.StatusOK {
+		t.Fatalf("promote bob: expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if w := serve(h, asUser(jsonRequest(http.MethodDelete, base+"/members/alice", nil), "alice")); w.Code != http.StatusNoContent {
+		t.Fatalf("alice leaving: expected %d, got %d", http.StatusNoContent, w.Code)
+	}
+
+	w = serve(h, asUser(jsonRequest(http.MethodGet, "/api/workspaces", nil), "bob"))
+	var list []model.Workspace
+	_ = json.NewDecoder(w.Body).Decode(&list)
+	if len(list) != 1 || list[0].Role != model.RoleOwner {
+		t.Fatalf("bob's workspaces: unexpected %+v", list)
+	}
+}
+
+func TestRole_AtLeast(t *testing.T) {
+	if !model.RoleAdmin.AtLeast(model.RoleEditor) || model.RoleViewer.AtLeast(model.RoleEditor) || model.Role("bogus").AtLeast(model.RoleViewer) {
+		t.Fatal("unexpected role ordering")
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+// Scopes a personal API token can carry.
+const (
+	ScopeQrRead     = "qr:read"
+	ScopeQrWrite    = "qr:write"
+	ScopeClicksRead = "clicks:read"
+)
+
+var validScopes = map[string]bool{ScopeQrRead: true, ScopeQrWrite: true, ScopeClicksRead: true}
+
+func ValidScope(s string) bool {
+	return validScopes[s]
+}
+
+// APIToken is a personal access token for programmatic use. Only a hash of
+// the secret is stored; the secret itself is shown once, on creation.
+type APIToken struct {
+	ID     string   `json:"id"`
+	UserID string   `json:"userId"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// Prefix is the start of the secret, to tell tokens apart in lists.
+	Prefix string `json:"prefix"`
+	Hash   string `json:"-"`
+
+	CreatedAt     time.Time  `json:"-"`
+	CreatedAtIso  string     `json:"createdAtIso"`
+	LastUsedAt    *time.Time `json:"-"`
+	LastUsedAtIso string     `json:"lastUsedAtIso,omitempty"`
+	// ExpiresAt is nil for tokens that never expire.
+	ExpiresAt    *time.Time `json:"-"`
+	ExpiresAtIso string     `json:"expiresAtIso,omitempty"`
+}
+
+func (t APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+func (t APIToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (t APIToken) NormalizeForResponse() APIToken {
+	t.CreatedAtIso = t.CreatedAt.UTC().Format(time.RFC3339)
+	if t.LastUsedAt != nil {
+		t.LastUsedAtIso = t.LastUsedAt.UTC().Format(time.RFC3339)
+	}
+	if t.ExpiresAt != nil {
+		t.ExpiresAtIso = t.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	if t.Scopes == nil {
+		t.Scopes = []string{}
+	}
+	return t
+}
@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// Role is a member's level of access to a workspace's QR codes. Each role
+// includes everything the roles below it can do.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"  // delete the workspace, manage owners
+	RoleAdmin  Role = "admin"  // manage members and settings
+	RoleEditor Role = "editor" // create and change codes
+	RoleViewer Role = "viewer" // read codes and stats
+)
+
+var roleRank = map[Role]int{RoleViewer: 1, RoleEditor: 2, RoleAdmin: 3, RoleOwner: 4}
+
+func (r Role) Valid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// AtLeast reports whether r grants everything min does.
+func (r Role) AtLeast(min Role) bool {
+	return roleRank[r] >= roleRank[min] && r.Valid()
+}
+
+type Workspace struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	CreatedBy    string    `json:"createdBy"`
+	CreatedAt    time.Time `json:"-"`
+	CreatedAtIso string    `json:"createdAtIso"`
+
+	// Role is the caller's role, set on responses.
+	Role Role `json:"role,omitempty"`
+}
+
+func (w Workspace) NormalizeForResponse() Workspace {
+	w.CreatedAtIso = w.CreatedAt.UTC().Format(time.RFC3339)
+	return w
+}
+
+type Member struct {
+	WorkspaceID string    `json:"workspaceId"`
+	UserID      string    `json:"userId"`
+	Email       string    `json:"email,omitempty"`
+	Role        Role      `json:"role"`
+	AddedAt     time.Time `json:"-"`
+	AddedAtIso  string    `json:"addedAtIso"`
+}
+
+func (m Member) NormalizeForResponse() Member {
+	m.AddedAtIso = m.AddedAt.UTC().Format(time.RFC3339)
+	return m
+}
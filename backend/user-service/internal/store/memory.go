@@ -0,0 +1,223 @@
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"user-service/internal/model"
+)
+
+type MemoryStore struct {
+	mu         sync.RWMutex
+	workspaces map[string]model.Workspace
+	// members is keyed by workspace ID, then user ID.
+	members map[string]map[string]model.Member
+	tokens  map[string]model.APIToken
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		workspaces: make(map[string]model.Workspace),
+		members:    make(map[string]map[string]model.Member),
+		tokens:     make(map[string]model.APIToken),
+	}
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *MemoryStore) CreateWorkspace(name string, owner model.Member) (model.Workspace, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	ws := model.Workspace{ID: newID(), Name: name, CreatedBy: owner.UserID, CreatedAt: now}
+	s.workspaces[ws.ID] = ws
+
+	owner.WorkspaceID, owner.Role, owner.AddedAt = ws.ID, model.RoleOwner, now
+	s.members[ws.ID] = map[string]model.Member{owner.UserID: owner}
+	return ws, nil
+}
+
+func (s *MemoryStore) GetWorkspace(id string) (model.Workspace, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ws, ok := s.workspaces[id]
+	if !ok {
+		return model.Workspace{}, ErrNotFound
+	}
+	return ws, nil
+}
+
+func (s *MemoryStore) ListWorkspaces(userID string) ([]model.Workspace, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := make([]model.Workspace, 0)
+	for id, members := range s.members {
+		if m, ok := members[userID]; ok {
+			ws := s.workspaces[id]
+			ws.Role = m.Role
+			items = append(items, ws)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].CreatedAt.After(items[j].CreatedAt)
+	})
+	return items, nil
+}
+
+func (s *MemoryStore) RenameWorkspace(id, name string) (model.Workspace, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspaces[id]
+	if !ok {
+		return model.Workspace{}, ErrNotFound
+	}
+	ws.Name = name
+	s.workspaces[id] = ws
+	return ws, nil
+}
+
+func (s *MemoryStore) DeleteWorkspace(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.workspaces[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.workspaces, id)
+	delete(s.members, id)
+	return nil
+}
+
+func (s *MemoryStore) ListMembers(workspaceID string) ([]model.Member, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.workspaces[workspaceID]; !ok {
+		return nil, ErrNotFound
+	}
+	items := make([]model.Member, 0, len(s.members[workspaceID]))
+	for _, m := range s.members[workspaceID] {
+		items = append(items, m)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].AddedAt.Before(items[j].AddedAt)
+	})
+	return items, nil
+}
+
+func (s *MemoryStore) GetMember(workspaceID, userID string) (model.Member, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m, ok := s.members[workspaceID][userID]
+	if !ok {
+		return model.Member{}, ErrNotFound
+	}
+	return m, nil
+}
+
+func (s *MemoryStore) PutMember(m model.Member) (model.Member, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, ok := s.members[m.WorkspaceID]
+	if !ok {
+		return model.Member{}, ErrNotFound
+	}
+	if current, ok := members[m.UserID]; ok {
+		m.AddedAt = current.AddedAt
+		if m.Email == "" {
+			m.Email = current.Email
+		}
+	} else {
+		m.AddedAt = time.Now().UTC()
+	}
+	members[m.UserID] = m
+	return m, nil
+}
+
+func (s *MemoryStore) RemoveMember(workspaceID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.members[workspaceID][userID]; !ok {
+		return ErrNotFound
+	}
+	delete(s.members[workspaceID], userID)
+	return nil
+}
+
+func (s *MemoryStore) CreateToken(t model.APIToken) (model.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t.ID, t.CreatedAt = newID(), time.Now().UTC()
+	t.Scopes = append([]string(nil), t.Scopes...)
+	s.tokens[t.ID] = t
+	return t, nil
+}
+
+func (s *MemoryStore) ListTokens(userID string) ([]model.APIToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := make([]model.APIToken, 0)
+	for _, t := range s.tokens {
+		if t.UserID == userID {
+			items = append(items, t)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].CreatedAt.After(items[j].CreatedAt)
+	})
+	return items, nil
+}
+
+func (s *MemoryStore) GetTokenByHash(hash string) (model.APIToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, t := range s.tokens {
+		if t.Hash == hash {
+			return t, nil
+		}
+	}
+	return model.APIToken{}, ErrNotFound
+}
+
+func (s *MemoryStore) DeleteToken(userID, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tokens[id]
+	if !ok || t.UserID != userID {
+		return ErrNotFound
+	}
+	delete(s.tokens, id)
+	return nil
+}
+
+func (s *MemoryStore) TouchToken(id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tokens[id]
+	if !ok {
+		return ErrNotFound
+	}
+	at = at.UTC()
+	t.LastUsedAt = &at
+	s.tokens[id] = t
+	return nil
+}
@@ -0,0 +1,301 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"user-service/internal/model"
+)
+
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+type workspaceRow struct {
+	ID        string    `gorm:"primaryKey"`
+	Name      string    `gorm:"not null"`
+	CreatedBy string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+func (workspaceRow) TableName() string { return "workspaces" }
+
+func (r workspaceRow) toModel() model.Workspace {
+	return model.Workspace{ID: r.ID, Name: r.Name, CreatedBy: r.CreatedBy, CreatedAt: r.CreatedAt}
+}
+
+type memberRow struct {
+	WorkspaceID string    `gorm:"primaryKey"`
+	UserID      string    `gorm:"primaryKey;index:workspace_members_user_id_idx"`
+	Email       string    `gorm:"not null;default:''"`
+	Role        string    `gorm:"not null"`
+	AddedAt     time.Time `gorm:"not null"`
+}
+
+func (memberRow) TableName() string { return "workspace_members" }
+
+func (r memberRow) toModel() model.Member {
+	return model.Member{WorkspaceID: r.WorkspaceID, UserID: r.UserID, Email: r.Email, Role: model.Role(r.Role), AddedAt: r.AddedAt}
+}
+
+type tokenRow struct {
+	ID         string    `gorm:"primaryKey"`
+	UserID     string    `gorm:"not null;index:api_tokens_user_id_idx"`
+	Name       string    `gorm:"not null"`
+	Scopes     string    `gorm:"not null;default:''"`
+	Prefix     string    `gorm:"not null"`
+	Hash       string    `gorm:"not null;uniqueIndex:api_tokens_hash_idx"`
+	CreatedAt  time.Time `gorm:"not null"`
+	LastUsedAt *time.Time
+	ExpiresAt  *time.Time
+}
+
+func (tokenRow) TableName() string { return "api_tokens" }
+
+func (r tokenRow) toModel() model.APIToken {
+	var scopes []string
+	if r.Scopes != "" {
+		scopes = strings.Split(r.Scopes, ",")
+	}
+	return model.APIToken{
+		ID: r.ID, UserID: r.UserID, Name: r.Name, Scopes: scopes, Prefix: r.Prefix, Hash: r.Hash,
+		CreatedAt: r.CreatedAt, LastUsedAt: r.LastUsedAt, ExpiresAt: r.ExpiresAt,
+	}
+}
+
+func NewPostgresStore(ctx context.Context, databaseURL string) (*PostgresStore, error) {
+	gdb, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(10)
+	sqlDB.SetMaxIdleConns(5)
+	sqlDB.SetConnMaxLifetime(30 * time.Minute)
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+
+	if err := gdb.WithContext(ctx).AutoMigrate(&workspaceRow{}, &memberRow{}, &tokenRow{}); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+	return &PostgresStore{db: gdb}, nil
+}
+
+func (s *PostgresStore) Close() error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+func (s *PostgresStore) CreateWorkspace(name string, owner model.Member) (model.Workspace, error) {
+	now := time.Now().UTC()
+	ws := workspaceRow{ID: newID(), Name: name, CreatedBy: owner.UserID, CreatedAt: now}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&ws).Error; err != nil {
+			return err
+		}
+		return tx.Create(&memberRow{WorkspaceID: ws.ID, UserID: owner.UserID, Email: owner.Email, Role: string(model.RoleOwner), AddedAt: now}).Error
+	})
+	if err != nil {
+		return model.Workspace{}, err
+	}
+	return ws.toModel(), nil
+}
+
+func (s *PostgresStore) GetWorkspace(id string) (model.Workspace, error) {
+	var r workspaceRow
+	if err := s.db.First(&r, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.Workspace{}, ErrNotFound
+		}
+		return model.Workspace{}, err
+	}
+	return r.toModel(), nil
+}
+
+func (s *PostgresStore) ListWorkspaces(userID string) ([]model.Workspace, error) {
+	var rows []struct {
+		ID        string
+		Name      string
+		CreatedBy string
+		CreatedAt time.Time
+		Role      string
+	}
+	err := s.db.Table("workspaces").
+		Select("workspaces.id, workspaces.name, workspaces.created_by, workspaces.created_at, workspace_members.role").
+		Joins("JOIN workspace_members ON workspace_members.workspace_id = workspaces.id").
+		Where("workspace_members.user_id = ?", userID).
+		Order("workspaces.created_at desc").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.Workspace, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, model.Workspace{ID: r.ID, Name: r.Name, CreatedBy: r.CreatedBy, CreatedAt: r.CreatedAt, Role: model.Role(r.Role)})
+	}
+	return items, nil
+}
+
+func (s *PostgresStore) RenameWorkspace(id, name string) (model.Workspace, error) {
+	res := s.db.Model(&workspaceRow{}).Where("id = ?", id).Update("name", name)
+	if res.Error != nil {
+		return model.Workspace{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return model.Workspace{}, ErrNotFound
+	}
+	return s.GetWorkspace(id)
+}
+
+func (s *PostgresStore) DeleteWorkspace(id string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Delete(&workspaceRow{}, "id = ?", id)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return tx.Delete(&memberRow{}, "workspace_id = ?", id).Error
+	})
+}
+
+func (s *PostgresStore) ListMembers(workspaceID string) ([]model.Member, error) {
+	if _, err := s.GetWorkspace(workspaceID); err != nil {
+		return nil, err
+	}
+	var rows []memberRow
+	if err := s.db.Where("workspace_id = ?", workspaceID).Order("added_at").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	items := make([]model.Member, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, r.toModel())
+	}
+	return items, nil
+}
+
+func (s *PostgresStore) GetMember(workspaceID, userID string) (model.Member, error) {
+	var r memberRow
+	if err := s.db.First(&r, "workspace_id = ? AND user_id = ?", workspaceID, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.Member{}, ErrNotFound
+		}
+		return model.Member{}, err
+	}
+	return r.toModel(), nil
+}
+
+func (s *PostgresStore) PutMember(m model.Member) (model.Member, error) {
+	if _, err := s.GetWorkspace(m.WorkspaceID); err != nil {
+		return model.Member{}, err
+	}
+	current, err := s.GetMember(m.WorkspaceID, m.UserID)
+	switch {
+	case err == nil:
+		updates := map[string]any{"role": string(m.Role)}
+		if m.Email != "" {
+			updates["email"] = m.Email
+		}
+		if err := s.db.Model(&memberRow{}).Where("workspace_id = ? AND user_id = ?", m.WorkspaceID, m.UserID).Updates(updates).Error; err != nil {
+			return model.Member{}, err
+		}
+		current.Role = m.Role
+		if m.Email != "" {
+			current.Email = m.Email
+		}
+		return current, nil
+	case errors.Is(err, ErrNotFound):
+		r := memberRow{WorkspaceID: m.WorkspaceID, UserID: m.UserID, Email: m.Email, Role: string(m.Role), AddedAt: time.Now().UTC()}
+		if err := s.db.Create(&r).Error; err != nil {
+			return model.Member{}, err
+		}
+		return r.toModel(), nil
+	default:
+		return model.Member{}, err
+	}
+}
+
+func (s *PostgresStore) RemoveMember(workspaceID, userID string) error {
+	res := s.db.Delete(&memberRow{}, "workspace_id = ? AND user_id = ?", workspaceID, userID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) CreateToken(t model.APIToken) (model.APIToken, error) {
+	r := tokenRow{
+		ID: newID(), UserID: t.UserID, Name: t.Name, Scopes: strings.Join(t.Scopes, ","), Prefix: t.Prefix, Hash: t.Hash,
+		CreatedAt: time.Now().UTC(), ExpiresAt: t.ExpiresAt,
+	}
+	if err := s.db.Create(&r).Error; err != nil {
+		return model.APIToken{}, err
+	}
+	return r.toModel(), nil
+}
+
+func (s *PostgresStore) ListTokens(userID string) ([]model.APIToken, error) {
+	var rows []tokenRow
+	if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	items := make([]model.APIToken, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, r.toModel())
+	}
+	return items, nil
+}
+
+func (s *PostgresStore) GetTokenByHash(hash string) (model.APIToken, error) {
+	var r tokenRow
+	if err := s.db.First(&r, "hash = ?", hash).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.APIToken{}, ErrNotFound
+		}
+		return model.APIToken{}, err
+	}
+	return r.toModel(), nil
+}
+
+func (s *PostgresStore) DeleteToken(userID, id string) error {
+	res := s.db.Delete(&tokenRow{}, "id = ? AND user_id = ?", id, userID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) TouchToken(id string, at time.Time) error {
+	res := s.db.Model(&tokenRow{}).Where("id = ?", id).Update("last_used_at", at.UTC())
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Package store persists workspaces, their members and personal API tokens.
+// Accounts themselves live in Cognito and are referenced by Cognito username.
+package store
+
+import (
+	"errors"
+	"time"
+
+	"user-service/internal/model"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Store interface {
+	// CreateWorkspace creates the workspace with owner as its first member.
+	CreateWorkspace(name string, owner model.Member) (model.Workspace, error)
+	GetWorkspace(id string) (model.Workspace, error)
+	// ListWorkspaces returns the user's workspaces, newest first, with Role set.
+	ListWorkspaces(userID string) ([]model.Workspace, error)
+	RenameWorkspace(id, name string) (model.Workspace, error)
+	// DeleteWorkspace removes the workspace and all its members.
+	DeleteWorkspace(id string) error
+
+	ListMembers(workspaceID string) ([]model.Member, error)
+	GetMember(workspaceID, userID string) (model.Member, error)
+	// PutMember adds the member or changes their role.
+	PutMember(m model.Member) (model.Member, error)
+	RemoveMember(workspaceID, userID string) error
+
+	CreateToken(t model.APIToken) (model.APIToken, error)
+	// ListTokens returns the user's tokens, newest first.
+	ListTokens(userID string) ([]model.APIToken, error)
+	// GetTokenByHash finds a token by the SHA-256 hash of its secret.
+	GetTokenByHash(hash string) (model.APIToken, error)
+	// DeleteToken revokes one of the user's tokens.
+	DeleteToken(userID, id string) error
+	TouchToken(id string, at time.Time) error
+}
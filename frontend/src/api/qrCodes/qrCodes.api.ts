@@ -11,6 +11,7 @@ export const qrCodesApi = {
     return requestJson<QrCode[]>({
       method: 'GET',
       path: '/api/qr-codes',
+      credentials: 'include',
       query: params ? { limit: params.limit, cursor: params.cursor } : undefined,
       headers: userType ? { 'X-User-Type': userType } : undefined,
     })
@@ -20,6 +21,7 @@ export const qrCodesApi = {
     return requestJson<QrCode>({
       method: 'GET',
       path: `/api/qr-codes/${encodeURIComponent(id)}`,
+      credentials: 'include',
       headers: userType ? { 'X-User-Type': userType } : undefined,
     })
   },
@@ -28,6 +30,7 @@ export const qrCodesApi = {
     return requestJson<QrCode>({
       method: 'POST',
       path: '/api/qr-codes',
+      credentials: 'include',
       body: input,
       headers: userType ? { 'X-User-Type': userType } : undefined,
     })
@@ -37,6 +40,7 @@ export const qrCodesApi = {
     return requestJson<QrCode>({
       method: 'PATCH',
       path: `/api/qr-codes/${encodeURIComponent(id)}`,
+      credentials: 'include',
       body: patch,
       headers: userType ? { 'X-User-Type': userType } : undefined,
     })
@@ -46,6 +50,7 @@ export const qrCodesApi = {
     return requestJson<void>({
       method: 'DELETE',
       path: `/api/qr-codes/${encodeURIComponent(id)}`,
+      credentials: 'include',
       headers: userType ? { 'X-User-Type': userType } : undefined,
     })
   },
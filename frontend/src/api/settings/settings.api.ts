@@ -6,6 +6,7 @@ export const settingsApi = {
     return requestJson<UserSettings>({
       method: 'GET',
       path: '/api/settings',
+      credentials: 'include',
       headers: { 'X-User-Type': userType },
     })
   },
@@ -14,6 +15,7 @@ export const settingsApi = {
     return requestJson<UserSettings>({
       method: 'PUT',
       path: '/api/settings',
+      credentials: 'include',
       headers: { 'X-User-Type': userType },
       body: settings,
     })